@@ -0,0 +1,357 @@
+package binary
+
+import (
+	"bytes"
+	"debug/elf"
+	"debug/gosym"
+	"encoding/binary"
+	"io"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/xerrors"
+
+	dio "github.com/aquasecurity/go-dep-parser/pkg/io"
+)
+
+var buildInfoMagic = []byte("\xff Go buildinf:")
+
+const (
+	buildInfoHeaderSize = 32
+	ptrSizeOffset       = 14
+	flagsOffset         = 15
+	versPtrOffset       = 16
+
+	flagsEndianBig   = 0x1
+	flagsVersionInl  = 0x2
+	modInfoFrameSize = 16
+)
+
+// readPtrFunc reads data at the virtual address. It is nil when the addresses cannot be resolved.
+type readPtrFunc func(addr, size uint64) ([]byte, bool)
+
+const (
+	// scanChunkSize is the size of data read at once to find the magic numbers.
+	scanChunkSize = 1 << 20
+	// maxCandidates limits the offsets tried per magic number in case of false positives.
+	maxCandidates = 16
+	// maxBuildInfoSize is the size read from the build info magic. The module info is usually a few KB.
+	maxBuildInfoSize = 1 << 20
+	// maxPclntabSize is the size read from the pclntab magic.
+	maxPclntabSize = 64 << 20
+	// maxPclntabAttempts limits the pclntab candidates read with maxPclntabSize in total.
+	maxPclntabAttempts = 4
+	// pclntabHeaderSize is the size of the pclntab header checked before reading the whole table.
+	pclntabHeaderSize = 8
+)
+
+// recoverBuildInfo recovers the build information from binaries which debug/buildinfo cannot read,
+// such as stripped or packed binaries with altered headers. It tries the following in order.
+//  1. The ".go.buildinfo" section of ELF without relying on the program headers
+//  2. The build info magic in the raw data, which is available only since Go 1.18
+//  3. Module paths of the source files in pclntab. Only the dependencies are recovered.
+//
+// The raw data is scanned in chunks, and only the data around the magic numbers is read into memory.
+func recoverBuildInfo(r dio.ReadSeekerAt) (*BuildInfo, error) {
+	if f, err := elf.NewFile(r); err == nil {
+		if bi, err := elfBuildInfo(f); err == nil {
+			return bi, nil
+		}
+	}
+
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, xerrors.Errorf("seek error: %w", err)
+	}
+
+	// The build info magic comes first, and then the pclntab magics from the newest.
+	patterns := [][]byte{buildInfoMagic}
+	for _, magic := range pclntabMagics {
+		patterns = append(patterns, magic, []byte{magic[3], magic[2], magic[1], magic[0]})
+	}
+	offsets, err := scanPatterns(r, size, scanChunkSize, patterns)
+	if err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+
+	for _, off := range offsets[0] {
+		data, err := readAt(r, off, maxBuildInfoSize, size)
+		if err != nil {
+			return nil, xerrors.Errorf("read error: %w", err)
+		}
+		if bi, err := decodeBuildInfo(data, nil); err == nil {
+			return bi, nil
+		}
+	}
+
+	// Only the candidates with a valid header are read in full, up to maxPclntabAttempts.
+	var attempts int
+	for _, offs := range offsets[1:] {
+		for _, off := range offs {
+			if attempts >= maxPclntabAttempts {
+				return nil, xerrors.New("build info not found")
+			}
+			header, err := readAt(r, off, pclntabHeaderSize, size)
+			if err != nil {
+				return nil, xerrors.Errorf("read error: %w", err)
+			}
+			if !validPclntabHeader(header) {
+				continue
+			}
+			attempts++
+
+			data, err := readAt(r, off, maxPclntabSize, size)
+			if err != nil {
+				return nil, xerrors.Errorf("read error: %w", err)
+			}
+			if deps := pclntabModules(data); len(deps) > 0 {
+				return &BuildInfo{
+					Deps:     deps,
+					Settings: map[string]string{},
+				}, nil
+			}
+		}
+	}
+	return nil, xerrors.New("build info not found")
+}
+
+// scanPatterns returns the offsets of the patterns in the order of appearance, up to maxCandidates per pattern.
+// The chunks overlap so that the patterns across the chunk boundaries are found.
+func scanPatterns(r io.ReaderAt, size, chunkSize int64, patterns [][]byte) ([][]int64, error) {
+	var overlap int64
+	for _, p := range patterns {
+		if n := int64(len(p)) - 1; n > overlap {
+			overlap = n
+		}
+	}
+
+	offsets := make([][]int64, len(patterns))
+	buf := make([]byte, chunkSize+overlap)
+	for off := int64(0); off < size; off += chunkSize {
+		n, err := r.ReadAt(buf, off)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		chunk := buf[:n]
+		for i, p := range patterns {
+			for start := 0; len(offsets[i]) < maxCandidates; {
+				j := bytes.Index(chunk[start:], p)
+				// Patterns starting in the overlap are found in the next chunk.
+				if j < 0 || int64(start+j) >= chunkSize {
+					break
+				}
+				offsets[i] = append(offsets[i], off+int64(start+j))
+				start += j + 1
+			}
+		}
+	}
+	return offsets, nil
+}
+
+// readAt reads up to n bytes at the offset.
+func readAt(r io.ReaderAt, off, n, size int64) ([]byte, error) {
+	if off+n > size {
+		n = size - off
+	}
+	b := make([]byte, n)
+	if _, err := r.ReadAt(b, off); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return b, nil
+}
+
+func elfBuildInfo(f *elf.File) (*BuildInfo, error) {
+	s := f.Section(".go.buildinfo")
+	if s == nil {
+		return nil, xerrors.New("no .go.buildinfo section")
+	}
+	data, err := s.Data()
+	if err != nil {
+		return nil, xerrors.Errorf("section read error: %w", err)
+	}
+
+	// Resolve the pointers with the section headers instead of the program headers.
+	readPtr := func(addr, size uint64) ([]byte, bool) {
+		for _, sec := range f.Sections {
+			if sec.Type == elf.SHT_NOBITS || addr < sec.Addr || addr+size > sec.Addr+sec.Size {
+				continue
+			}
+			b := make([]byte, size)
+			if _, err := sec.ReadAt(b, int64(addr-sec.Addr)); err != nil {
+				return nil, false
+			}
+			return b, true
+		}
+		return nil, false
+	}
+	return decodeBuildInfo(data, readPtr)
+}
+
+// decodeBuildInfo decodes the blob starting with the magic.
+// ref. https://github.com/golang/go/blob/master/src/debug/buildinfo/buildinfo.go
+func decodeBuildInfo(data []byte, readPtr readPtrFunc) (*BuildInfo, error) {
+	if len(data) < buildInfoHeaderSize || !bytes.HasPrefix(data, buildInfoMagic) {
+		return nil, xerrors.New("invalid header")
+	}
+
+	var vers, mod string
+	flags := data[flagsOffset]
+	if flags&flagsVersionInl != 0 {
+		// Since Go 1.18, the strings follow the header with varint lengths.
+		rest := data[buildInfoHeaderSize:]
+		var ok bool
+		if vers, rest, ok = decodeString(rest); !ok {
+			return nil, xerrors.New("invalid version")
+		}
+		if mod, _, ok = decodeString(rest); !ok {
+			return nil, xerrors.New("invalid module info")
+		}
+	} else {
+		// Before Go 1.18, the header has pointers to the strings.
+		if readPtr == nil {
+			return nil, xerrors.New("pointers cannot be resolved")
+		}
+		var bo binary.ByteOrder = binary.LittleEndian
+		if flags&flagsEndianBig != 0 {
+			bo = binary.BigEndian
+		}
+		ptrSize := int(data[ptrSizeOffset])
+		var ptr func([]byte) uint64
+		switch ptrSize {
+		case 4:
+			ptr = func(b []byte) uint64 { return uint64(bo.Uint32(b)) }
+		case 8:
+			ptr = bo.Uint64
+		default:
+			return nil, xerrors.Errorf("invalid pointer size: %d", ptrSize)
+		}
+		readString := func(addr uint64) string {
+			hdr, ok := readPtr(addr, uint64(2*ptrSize))
+			if !ok {
+				return ""
+			}
+			b, ok := readPtr(ptr(hdr), ptr(hdr[ptrSize:]))
+			if !ok {
+				return ""
+			}
+			return string(b)
+		}
+		vers = readString(ptr(data[versPtrOffset:]))
+		mod = readString(ptr(data[versPtrOffset+ptrSize:]))
+	}
+	if !strings.HasPrefix(vers, "go") {
+		return nil, xerrors.Errorf("invalid version: %q", vers)
+	}
+
+	// Strip the sentinel strings around the module info.
+	if len(mod) >= 2*modInfoFrameSize+1 && mod[len(mod)-modInfoFrameSize-1] == '\n' {
+		mod = mod[modInfoFrameSize : len(mod)-modInfoFrameSize]
+	} else {
+		mod = ""
+	}
+
+	info, err := debug.ParseBuildInfo(mod)
+	if err != nil {
+		return nil, xerrors.Errorf("module info parse error: %w", err)
+	}
+	info.GoVersion = vers
+	return newBuildInfo(info), nil
+}
+
+func decodeString(data []byte) (string, []byte, bool) {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || length > uint64(len(data)-n) {
+		return "", nil, false
+	}
+	data = data[n:]
+	return string(data[:length]), data[length:], true
+}
+
+// pclntabMagics are the magic numbers of pclntab in little endian.
+var pclntabMagics = [][]byte{
+	{0xf1, 0xff, 0xff, 0xff}, // Go 1.20
+	{0xf0, 0xff, 0xff, 0xff}, // Go 1.18
+	{0xfa, 0xff, 0xff, 0xff}, // Go 1.16
+	{0xfb, 0xff, 0xff, 0xff}, // Go 1.2
+}
+
+// pclntabModules recovers the modules from the source file paths in pclntab starting at the head of the data.
+// e.g. "/root/go/pkg/mod/github.com/davecgh/go-spew@v1.1.1/spew/spew.go" and
+// "github.com/davecgh/go-spew@v1.1.1/spew/spew.go" built with -trimpath
+func pclntabModules(data []byte) []Module {
+	if files := pclntabFiles(data); len(files) > 0 {
+		return modulesFromFiles(files)
+	}
+	return nil
+}
+
+// pclntabFiles returns the source files in the pclntab. It returns nil for false positives of the magic.
+func pclntabFiles(data []byte) (files []string) {
+	if !validPclntabHeader(data) {
+		return nil
+	}
+
+	// gosym may panic with corrupted data.
+	defer func() {
+		if recover() != nil {
+			files = nil
+		}
+	}()
+	table, err := gosym.NewTable(nil, gosym.NewLineTable(data, 0))
+	if err != nil {
+		return nil
+	}
+	for file := range table.Files {
+		files = append(files, file)
+	}
+	return files
+}
+
+// validPclntabHeader checks the header: magic, two zero bytes, the instruction size quantum and the pointer size.
+func validPclntabHeader(data []byte) bool {
+	return len(data) >= pclntabHeaderSize && data[4] == 0 && data[5] == 0 &&
+		(data[6] == 1 || data[6] == 2 || data[6] == 4) && (data[7] == 4 || data[7] == 8)
+}
+
+func modulesFromFiles(files []string) []Module {
+	uniq := map[string]Module{}
+	for _, file := range files {
+		if i := strings.LastIndex(file, "/pkg/mod/"); i >= 0 {
+			file = file[i+len("/pkg/mod/"):]
+		}
+		escapedPath, rest, ok := strings.Cut(file, "@")
+		if !ok || strings.HasPrefix(escapedPath, "/") {
+			continue
+		}
+		escapedVersion, _, ok := strings.Cut(rest, "/")
+		if !ok {
+			continue
+		}
+		path, err := module.UnescapePath(escapedPath)
+		if err != nil || path == "golang.org/toolchain" {
+			continue
+		}
+		version, err := module.UnescapeVersion(escapedVersion)
+		if err != nil {
+			continue
+		}
+		uniq[path+"@"+version] = Module{
+			Path:    path,
+			Version: version,
+		}
+	}
+
+	var mods []Module
+	for _, m := range uniq {
+		mods = append(mods, m)
+	}
+	sort.Slice(mods, func(i, j int) bool {
+		if mods[i].Path != mods[j].Path {
+			return mods[i].Path < mods[j].Path
+		}
+		return mods[i].Version < mods[j].Version
+	})
+	return mods
+}
@@ -0,0 +1,53 @@
+package binary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_scanPatterns(t *testing.T) {
+	// "magic" is across the boundaries of the chunks with the size of 4.
+	data := "xxmagicxxxxfoomagic"
+	patterns := [][]byte{[]byte("magic"), []byte("foo"), []byte("bar")}
+
+	for _, chunkSize := range []int64{4, 1 << 10} {
+		got, err := scanPatterns(strings.NewReader(data), int64(len(data)), chunkSize, patterns)
+		require.NoError(t, err)
+		assert.Equal(t, [][]int64{{2, 14}, {11}, nil}, got)
+	}
+}
+
+func Test_validPclntabHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		header []byte
+		want   bool
+	}{
+		{
+			name:   "amd64",
+			header: []byte{0xf1, 0xff, 0xff, 0xff, 0x00, 0x00, 0x01, 0x08},
+			want:   true,
+		},
+		{
+			name:   "arm",
+			header: []byte{0xfb, 0xff, 0xff, 0xff, 0x00, 0x00, 0x04, 0x04},
+			want:   true,
+		},
+		{
+			name:   "false positive",
+			header: []byte{0xf1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		},
+		{
+			name:   "too short",
+			header: []byte{0xf1, 0xff, 0xff, 0xff, 0x00, 0x00},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validPclntabHeader(tt.header))
+		})
+	}
+}
@@ -2,11 +2,13 @@ package binary
 
 import (
 	"debug/buildinfo"
+	"runtime/debug"
 	"strings"
 
 	"golang.org/x/xerrors"
 
 	dio "github.com/aquasecurity/go-dep-parser/pkg/io"
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
 )
 
@@ -29,25 +31,93 @@ func convertError(err error) error {
 	return err
 }
 
+// BuildInfo is the build information embedded in a Go binary.
+type BuildInfo struct {
+	// GoVersion is the version of the Go toolchain. e.g. "go1.19.2"
+	GoVersion string
+	// Path is the package path of the main package. e.g. "github.com/aquasecurity/trivy/cmd/trivy"
+	Path string
+	Main Module
+	Deps []Module
+	// Settings are the build settings.
+	// e.g. "vcs.revision", "vcs.time", "vcs.modified", "-ldflags", "CGO_ENABLED", "GOOS" and "GOARCH"
+	Settings map[string]string
+}
+
+// Module is a module in the build information.
+type Module struct {
+	Path    string
+	Version string
+	Sum     string
+	// Replace is the replacement module. Path and Version are still the original ones.
+	Replace *Module
+}
+
+// Library returns the library actually built into the binary, i.e. the replacement if any.
+func (m Module) Library() types.Library {
+	if m.Replace != nil {
+		return m.Replace.Library()
+	}
+	return types.Library{
+		Name:    m.Path,
+		Version: m.Version,
+	}
+}
+
+// ParseBuildInfo reads the build information of a Go binary.
+// The build information is recovered from the raw data when the binary is stripped or packed.
+func ParseBuildInfo(r dio.ReadSeekerAt) (*BuildInfo, error) {
+	info, err := buildinfo.Read(r)
+	if err != nil {
+		bi, recoverErr := recoverBuildInfo(r)
+		if recoverErr != nil {
+			log.Logger.Debugf("Unable to recover the build info: %s", recoverErr)
+			return nil, convertError(err)
+		}
+		return bi, nil
+	}
+	return newBuildInfo(info), nil
+}
+
+func newBuildInfo(info *debug.BuildInfo) *BuildInfo {
+	bi := &BuildInfo{
+		GoVersion: info.GoVersion,
+		Path:      info.Path,
+		Main:      newModule(&info.Main),
+		Settings:  map[string]string{},
+	}
+	for _, dep := range info.Deps {
+		bi.Deps = append(bi.Deps, newModule(dep))
+	}
+	for _, setting := range info.Settings {
+		bi.Settings[setting.Key] = setting.Value
+	}
+	return bi
+}
+
+func newModule(m *debug.Module) Module {
+	mod := Module{
+		Path:    m.Path,
+		Version: m.Version,
+		Sum:     m.Sum,
+	}
+	if m.Replace != nil {
+		replace := newModule(m.Replace)
+		mod.Replace = &replace
+	}
+	return mod
+}
+
 // Parse scans file to try to report the Go and module versions.
 func Parse(r dio.ReadSeekerAt) ([]types.Library, error) {
-	info, err := buildinfo.Read(r)
+	info, err := ParseBuildInfo(r)
 	if err != nil {
-		return nil, convertError(err)
+		return nil, err
 	}
 
 	libs := make([]types.Library, 0, len(info.Deps))
-
 	for _, dep := range info.Deps {
-		mod := dep
-		if dep.Replace != nil {
-			mod = dep.Replace
-		}
-
-		libs = append(libs, types.Library{
-			Name:    mod.Path,
-			Version: mod.Version,
-		})
+		libs = append(libs, dep.Library())
 	}
 
 	return libs, nil
@@ -1,6 +1,7 @@
 package binary_test
 
 import (
+	"bytes"
 	"os"
 	"testing"
 
@@ -72,6 +73,20 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "with replace directive and build settings",
+			inputFile: "testdata/buildinfo.elf",
+			want: []types.Library{
+				{
+					Name:    "github.com/davecgh/go-spew",
+					Version: "v1.1.1",
+				},
+				{
+					Name:    "golang.org/x/xerrors",
+					Version: "v0.0.0-20220907171357-04be3eba64a2",
+				},
+			},
+		},
 		{
 			name:      "with replace directive",
 			inputFile: "testdata/replace.elf",
@@ -109,3 +124,197 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBuildInfo(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputFile string
+		want      *binary.BuildInfo
+		wantErr   string
+	}{
+		{
+			name:      "with replace directive and build settings",
+			inputFile: "testdata/buildinfo.elf",
+			want: &binary.BuildInfo{
+				GoVersion: "go1.27.1",
+				Path:      "github.com/aquasecurity/test",
+				Main: binary.Module{
+					Path:    "github.com/aquasecurity/test",
+					Version: "v0.0.0-20221001000000-09e0237ae54f",
+				},
+				Deps: []binary.Module{
+					{
+						Path:    "github.com/davecgh/go-spew",
+						Version: "v1.1.1",
+						Sum:     "h1:vj9j/u1bqnvCEfJOwUhtlOARqs3+rkHYY13jYWTU97c=",
+					},
+					{
+						Path:    "golang.org/x/xerrors",
+						Version: "v0.0.0-20200804184101-5ec99f83aff1",
+						Replace: &binary.Module{
+							Path:    "golang.org/x/xerrors",
+							Version: "v0.0.0-20220907171357-04be3eba64a2",
+							Sum:     "h1:H2TDz8ibqkAF6YGhCdN3jS9O0/s90v0rJh3X/OLHEUk=",
+						},
+					},
+				},
+				Settings: map[string]string{
+					"-buildmode":     "exe",
+					"-compiler":      "gc",
+					"-ldflags":       "-s -w -X main.version=1.0.0",
+					"DefaultGODEBUG": "containermaxprocs=0,cryptocustomrand=1,decoratemappings=0,gotestjsonbuildtext=1,httpcookiemaxnum=0,httplaxcontentlength=1,httpmuxgo121=1,httpservecontentkeepheaders=1,multipathtcp=0,netedns0=0,panicnil=1,randseednop=0,rsa1024min=0,tlsmlkem=0,tlssecpmlkem=0,tlssha1=1,tracebacklabels=0,updatemaxprocs=0,urlmaxqueryparams=0,urlstrictcolons=0,winreadlinkvolume=0,winsymlink=0,x509negativeserial=1,x509rsacrt=0,x509sha256skid=0,x509sslcertoverrideplatform=0,x509usepolicies=0",
+					"CGO_ENABLED":    "0",
+					"GOARCH":         "amd64",
+					"GOOS":           "linux",
+					"GOAMD64":        "v1",
+					"vcs":            "git",
+					"vcs.revision":   "09e0237ae54f4a62b7d5194401783b1ebd7ab870",
+					"vcs.time":       "2022-10-01T00:00:00Z",
+					"vcs.modified":   "false",
+				},
+			},
+		},
+		{
+			name:      "without build settings",
+			inputFile: "testdata/test.elf",
+			want: &binary.BuildInfo{
+				GoVersion: "go1.15.2",
+				Path:      "github.com/aquasecurity/test",
+				Main: binary.Module{
+					Path:    "github.com/aquasecurity/test",
+					Version: "(devel)",
+				},
+				Deps: []binary.Module{
+					{
+						Path:    "github.com/aquasecurity/go-pep440-version",
+						Version: "v0.0.0-20210121094942-22b2f8951d46",
+						Sum:     "h1:vmXNl+HDfqqXgr0uY1UgK1GAhps8nbAAtqHNBcgyf+4=",
+					},
+					{
+						Path:    "github.com/aquasecurity/go-version",
+						Version: "v0.0.0-20210121072130-637058cfe492",
+						Sum:     "h1:rcEG5HI490FF0a7zuvxOxen52ddygCfNVjP0XOCMl+M=",
+					},
+					{
+						Path:    "golang.org/x/xerrors",
+						Version: "v0.0.0-20200804184101-5ec99f83aff1",
+						Sum:     "h1:go1bK/D/BFZV2I8cIQd1NKEZ+0owSTG1fDTci4IqFcE=",
+					},
+				},
+				Settings: map[string]string{},
+			},
+		},
+		{
+			name:      "sad path",
+			inputFile: "testdata/dummy",
+			wantErr:   "unrecognized executable format",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.inputFile)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := binary.ParseBuildInfo(f)
+			if tt.wantErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseBuildInfo_Recover(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputFile string
+		alter     func(b []byte) []byte
+		// wantDeps is compared when only the dependencies are recovered.
+		// Otherwise, the build info must be the same as the original binary.
+		wantDeps []binary.Module
+	}{
+		{
+			name:      "altered ELF header",
+			inputFile: "testdata/buildinfo.elf",
+			alter:     clearELFMagic,
+		},
+		{
+			name:      "altered program headers before Go 1.18",
+			inputFile: "testdata/test.elf",
+			alter: func(b []byte) []byte {
+				// e_phnum
+				b[56], b[57] = 0, 0
+				return b
+			},
+		},
+		{
+			name:      "pclntab",
+			inputFile: "testdata/buildinfo.elf",
+			alter: func(b []byte) []byte {
+				return bytes.ReplaceAll(clearELFMagic(b), []byte("\xff Go buildinf:"), make([]byte, 14))
+			},
+			wantDeps: []binary.Module{
+				{
+					Path:    "github.com/davecgh/go-spew",
+					Version: "v1.1.1",
+				},
+				{
+					Path:    "golang.org/x/xerrors",
+					Version: "v0.0.0-20220907171357-04be3eba64a2",
+				},
+			},
+		},
+		{
+			name:      "pclntab before Go 1.16",
+			inputFile: "testdata/test.elf",
+			alter:     clearELFMagic,
+			wantDeps: []binary.Module{
+				{
+					Path:    "github.com/aquasecurity/go-pep440-version",
+					Version: "v0.0.0-20210121094942-22b2f8951d46",
+				},
+				{
+					Path:    "github.com/aquasecurity/go-version",
+					Version: "v0.0.0-20210121072130-637058cfe492",
+				},
+				{
+					Path:    "golang.org/x/xerrors",
+					Version: "v0.0.0-20200804184101-5ec99f83aff1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := os.ReadFile(tt.inputFile)
+			require.NoError(t, err)
+
+			got, err := binary.ParseBuildInfo(bytes.NewReader(tt.alter(b)))
+			require.NoError(t, err)
+
+			if tt.wantDeps != nil {
+				assert.Equal(t, tt.wantDeps, got.Deps)
+				assert.Empty(t, got.GoVersion)
+				return
+			}
+
+			f, err := os.Open(tt.inputFile)
+			require.NoError(t, err)
+			defer f.Close()
+
+			want, err := binary.ParseBuildInfo(f)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func clearELFMagic(b []byte) []byte {
+	copy(b, "\x00\x00\x00\x00")
+	return b
+}
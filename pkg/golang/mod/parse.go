@@ -12,8 +12,47 @@ import (
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
 )
 
+type options struct {
+	goSum            io.Reader
+	unusedSumHandler func(UnusedSum)
+	replaces         []*modfile.Replace
+}
+
+type Option func(*options)
+
+// WithGoSum passes go.sum next to go.mod. Go modules older than 1.17 don't list all the indirect dependencies
+// in go.mod, and the missing ones are taken from go.sum.
+func WithGoSum(r io.Reader) Option {
+	return func(opts *options) {
+		opts.goSum = r
+	}
+}
+
+// WithUnusedSumHandler sets the function called for each go.sum entry which is no longer required.
+// They are logged by default.
+func WithUnusedSumHandler(handler func(UnusedSum)) Option {
+	return func(opts *options) {
+		opts.unusedSumHandler = handler
+	}
+}
+
+// WithReplaces adds replace directives taking precedence over the ones in go.mod
+// for the same module paths. e.g. replace directives in go.work
+func WithReplaces(replaces []*modfile.Replace) Option {
+	return func(opts *options) {
+		opts.replaces = append(opts.replaces, replaces...)
+	}
+}
+
 // Parse parses a go.mod file
-func Parse(r io.Reader) ([]types.Library, error) {
+func Parse(r io.Reader, opts ...Option) ([]types.Library, error) {
+	o := options{
+		unusedSumHandler: logUnusedSum,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	libs := map[string]types.Library{}
 
 	goModData, err := io.ReadAll(r)
@@ -43,7 +82,10 @@ func Parse(r io.Reader) ([]types.Library, error) {
 		}
 	}
 
-	for _, replace := range modFileParsed.Replace {
+	replaced := map[string]struct{}{}
+	for _, replace := range mergeReplaces(modFileParsed.Replace, o.replaces) {
+		replaced[replace.Old.Path] = struct{}{}
+
 		// Check if replaced path is actually in our libs.
 		old, ok := libs[replace.Old.Path]
 		if !ok {
@@ -74,9 +116,35 @@ func Parse(r io.Reader) ([]types.Library, error) {
 		}
 	}
 
+	if o.goSum != nil {
+		entries, err := parseSum(o.goSum)
+		if err != nil {
+			return nil, xerrors.Errorf("go.sum parse error: %w", err)
+		}
+		mergeSum(libs, replaced, entries, !skipIndirect, o.unusedSumHandler)
+	}
+
 	return maps.Values(libs), nil
 }
 
+// mergeReplaces overrides the replace directives of go.mod.
+func mergeReplaces(replaces, overrides []*modfile.Replace) []*modfile.Replace {
+	if len(overrides) == 0 {
+		return replaces
+	}
+	overridden := map[string]struct{}{}
+	for _, replace := range overrides {
+		overridden[replace.Old.Path] = struct{}{}
+	}
+	var merged []*modfile.Replace
+	for _, replace := range replaces {
+		if _, ok := overridden[replace.Old.Path]; !ok {
+			merged = append(merged, replace)
+		}
+	}
+	return append(merged, overrides...)
+}
+
 // Check if the Go version is less than 1.17
 func lessThan117(ver string) bool {
 	ss := strings.Split(ver, ".")
@@ -2,6 +2,7 @@ package mod
 
 import (
 	"os"
+	"path/filepath"
 	"sort"
 	"testing"
 
@@ -83,3 +84,55 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseWithGoSum(t *testing.T) {
+	tests := []struct {
+		name       string
+		dir        string
+		want       []types.Library
+		wantUnused []UnusedSum
+	}{
+		{
+			// go.sum has the test dependencies of go-dep-parser, which are kept by "go mod tidy".
+			name: "normal",
+			dir:  "testdata/normal",
+			want: GoModNormal,
+		},
+		{
+			name: "go 1.16",
+			dir:  "testdata/go116",
+			want: GoMod116WithSum,
+		},
+		{
+			name:       "go 1.16 with outdated go.sum",
+			dir:        "testdata/go116-upgraded",
+			want:       GoMod116Upgraded,
+			wantUnused: GoMod116UpgradedUnusedSums,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goMod, err := os.Open(filepath.Join(tt.dir, "go.mod"))
+			require.NoError(t, err)
+			defer goMod.Close()
+
+			goSum, err := os.Open(filepath.Join(tt.dir, "go.sum"))
+			require.NoError(t, err)
+			defer goSum.Close()
+
+			var gotUnused []UnusedSum
+			got, err := Parse(goMod, WithGoSum(goSum), WithUnusedSumHandler(func(s UnusedSum) {
+				gotUnused = append(gotUnused, s)
+			}))
+			require.NoError(t, err)
+
+			sort.Slice(got, func(i, j int) bool {
+				return got[i].Name < got[j].Name
+			})
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantUnused, gotUnused)
+		})
+	}
+}
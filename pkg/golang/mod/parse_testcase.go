@@ -57,4 +57,25 @@ var (
 	GoModNoGoVersion = []types.Library{
 		{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.0-20211224170007-df43bca6b6ff", Indirect: false},
 	}
+
+	// execute go mod tidy in go116 folder and parse it with go.sum
+	GoMod116WithSum = []types.Library{
+		{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.0-20211224170007-df43bca6b6ff", Indirect: false},
+		{Name: "github.com/davecgh/go-spew", Version: "1.1.1", Indirect: true},
+		{Name: "github.com/pmezard/go-difflib", Version: "1.0.0", Indirect: true},
+		{Name: "github.com/stretchr/testify", Version: "1.7.0", Indirect: true},
+		{Name: "golang.org/x/xerrors", Version: "0.0.0-20200804184101-5ec99f83aff1", Indirect: true},
+		{Name: "gopkg.in/yaml.v3", Version: "3.0.0-20210107192922-496545a6307b", Indirect: true},
+	}
+
+	// upgrade go-dep-parser in go116 folder without go mod tidy
+	GoMod116Upgraded = []types.Library{
+		{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.0-20220406074731-71021a481237", Indirect: false},
+		{Name: "golang.org/x/xerrors", Version: "0.0.0-20200804184101-5ec99f83aff1", Indirect: true},
+		{Name: "gopkg.in/yaml.v3", Version: "3.0.0-20210107192922-496545a6307b", Indirect: true},
+	}
+
+	GoMod116UpgradedUnusedSums = []UnusedSum{
+		{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.0-20211224170007-df43bca6b6ff", Location: types.Location{StartLine: 1, EndLine: 1}},
+	}
 )
@@ -0,0 +1,98 @@
+package mod
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"golang.org/x/mod/semver"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// UnusedSum is a go.sum entry of a module version superseded by another version in the build list of go.mod.
+// e.g. the old version left after an upgrade without "go mod tidy"
+type UnusedSum struct {
+	Name     string
+	Version  string
+	Location types.Location
+}
+
+// sumEntry is a go.sum line with the hash of the module content.
+// Lines with the hash of go.mod are not used as they are recorded for all the versions in the module graph.
+type sumEntry struct {
+	name    string
+	version string
+	line    int
+}
+
+func parseSum(r io.Reader) ([]sumEntry, error) {
+	var entries []sumEntry
+	scanner := bufio.NewScanner(r)
+	var lineNumber int
+	for scanner.Scan() {
+		lineNumber++
+		s := strings.Fields(scanner.Text())
+		if len(s) < 2 || strings.HasSuffix(s[1], "/go.mod") {
+			continue
+		}
+		entries = append(entries, sumEntry{
+			name:    s[0],
+			version: s[1],
+			line:    lineNumber,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+	return entries, nil
+}
+
+// mergeSum completes the libraries with go.sum and reports the entries which are no longer required.
+// go.mod lists all the indirect dependencies since Go 1.17, so modules only in go.sum are added only for older modules.
+// The newest version is selected for them as Minimal Version Selection does.
+func mergeSum(libs map[string]types.Library, replaced map[string]struct{}, entries []sumEntry, complete bool,
+	handle func(UnusedSum)) {
+	if !complete {
+		selected := map[string]string{}
+		for _, e := range entries {
+			if _, ok := libs[e.name]; ok {
+				continue
+			} else if _, ok = replaced[e.name]; ok {
+				continue
+			}
+			if v, ok := selected[e.name]; !ok || semver.Compare(e.version, v) > 0 {
+				selected[e.name] = e.version
+			}
+		}
+		for name, version := range selected {
+			libs[name] = types.Library{
+				Name:     name,
+				Version:  version[1:],
+				Indirect: true,
+			}
+		}
+	}
+
+	// Only versions superseded by other versions in the build list are reported, as "go mod tidy" keeps
+	// the entries of modules outside the build list. e.g. test dependencies of dependencies
+	for _, e := range entries {
+		if lib, ok := libs[e.name]; !ok || "v"+lib.Version == e.version {
+			continue
+		}
+		handle(UnusedSum{
+			Name:    e.name,
+			Version: e.version[1:],
+			Location: types.Location{
+				StartLine: e.line,
+				EndLine:   e.line,
+			},
+		})
+	}
+}
+
+func logUnusedSum(s UnusedSum) {
+	log.Logger.Debugf("go.sum line %d: %s@%s is no longer required", s.Location.StartLine, s.Name, s.Version)
+}
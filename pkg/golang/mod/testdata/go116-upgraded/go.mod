@@ -0,0 +1,5 @@
+module github.com/org/repo
+
+go 1.16
+
+require github.com/aquasecurity/go-dep-parser v0.0.0-20220406074731-71021a481237
@@ -5,17 +5,20 @@ import (
 	"io"
 	"strings"
 
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"golang.org/x/exp/maps"
 	"golang.org/x/xerrors"
 )
 
 // Parse parses a go.sum file
 func Parse(r io.Reader) ([]types.Library, error) {
-	var libs []types.Library
-	uniqueLibs := make(map[string]string)
+	uniqueLibs := make(map[string]types.Library)
 
 	scanner := bufio.NewScanner(r)
+	var lineNumber int
 	for scanner.Scan() {
+		lineNumber++
 		line := strings.TrimSpace(scanner.Text())
 		s := strings.Fields(line)
 		if len(s) < 2 {
@@ -24,18 +27,46 @@ func Parse(r io.Reader) ([]types.Library, error) {
 
 		// go.sum records and sorts all non-major versions
 		// with the latest version as last entry
-		uniqueLibs[s[0]] = strings.TrimSuffix(strings.TrimPrefix(s[1], "v"), "/go.mod")
+		version := strings.TrimSuffix(strings.TrimPrefix(s[1], "v"), "/go.mod")
+		loc := types.Location{
+			StartLine: lineNumber,
+			EndLine:   lineNumber,
+		}
+
+		// A version has two lines, one for the module and one for go.mod.
+		lib, ok := uniqueLibs[s[0]]
+		if ok && lib.Version == version {
+			lib.Locations = append(lib.Locations, loc)
+		} else {
+			lib = types.Library{
+				Name:      s[0],
+				Version:   version,
+				Locations: types.Locations{loc},
+			}
+		}
+
+		// The hash of the module, not go.mod
+		// e.g. github.com/google/uuid v1.3.0 h1:t6JiXgmwXMjEs8VusXIJk2BXHsn+wx8BZdTaoZ5fu7I=
+		if !strings.HasSuffix(s[1], "/go.mod") && len(s) > 2 {
+			if d, err := h1Digest(s[2]); err != nil {
+				log.Logger.Debugf("Invalid hash of %s@%s: %s", s[0], version, err)
+			} else {
+				lib.Digests = []types.Digest{d}
+			}
+		}
+		uniqueLibs[s[0]] = lib
 	}
 	if err := scanner.Err(); err != nil {
 		return nil, xerrors.Errorf("scan error: %w", err)
 	}
 
-	for k, v := range uniqueLibs {
-		libs = append(libs, types.Library{
-			Name:    k,
-			Version: v,
-		})
-	}
+	return maps.Values(uniqueLibs), nil
+}
 
-	return libs, nil
+func h1Digest(hash string) (types.Digest, error) {
+	algorithm, value, ok := strings.Cut(hash, ":")
+	if !ok || algorithm != string(types.H1) {
+		return "", xerrors.Errorf("unsupported hash: %s", hash)
+	}
+	return types.NewDigestFromBase64(types.H1, value)
 }
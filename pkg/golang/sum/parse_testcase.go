@@ -11,13 +11,13 @@ var (
 	// go get golang.org/x/xerrors
 	// go list -m all | awk 'NR>1 {sub(/^v/, "", $2); printf("{\""$1"\", \""$2"\", },\n")}'
 	GoModNormal = []types.Library{
-		{Name: "golang.org/x/xerrors", Version: "0.0.0-20200804184101-5ec99f83aff1"},
+		{Name: "golang.org/x/xerrors", Version: "0.0.0-20200804184101-5ec99f83aff1", Locations: types.Locations{{StartLine: 1, EndLine: 1}, {StartLine: 2, EndLine: 2}}, Digests: []types.Digest{"h1:828d5b2bf0ff045655d88f1c21077534a119fb4a304931b57c34dc8b822a15c1"}},
 	}
 
 	// https://github.com/uudashr/gopkgs/blob/616744904701ef01d868da4b66aad0e6856c361d/v2/go.sum
 	GoModEmptyLine = []types.Library{
-		{Name: "github.com/karrick/godirwalk", Version: "1.12.0"},
-		{Name: "github.com/pkg/errors", Version: "0.8.1"},
+		{Name: "github.com/karrick/godirwalk", Version: "1.12.0", Locations: types.Locations{{StartLine: 1, EndLine: 1}, {StartLine: 2, EndLine: 2}}, Digests: []types.Digest{"h1:9e44b8c71b2389932f5656b3774985ca2c03e0147d7f79ba2d71a13364d4c776"}},
+		{Name: "github.com/pkg/errors", Version: "0.8.1", Locations: types.Locations{{StartLine: 3, EndLine: 3}, {StartLine: 4, EndLine: 4}}, Digests: []types.Digest{"h1:894454ad11b13d434f772e7f1d14a6f988faa2427a52d2c834dd10f4ce3e8772"}},
 	}
 
 	// docker run --name gomod --rm -it golang:1.15 bash
@@ -31,19 +31,19 @@ var (
 	// go get github.com/BurntSushi/toml
 	// go list -m all | awk 'NR>1 {sub(/^v/, "", $2); printf("{\""$1"\", \""$2"\", },\n")}'
 	GoModMany = []types.Library{
-		{Name: "github.com/BurntSushi/toml", Version: "0.3.1"},
-		{Name: "github.com/cpuguy83/go-md2man/v2", Version: "2.0.0-20190314233015-f79a8a8ca69d"},
-		{Name: "github.com/davecgh/go-spew", Version: "1.1.0"},
-		{Name: "github.com/pmezard/go-difflib", Version: "1.0.0"},
-		{Name: "github.com/russross/blackfriday/v2", Version: "2.0.1"},
-		{Name: "github.com/shurcooL/sanitized_anchor_name", Version: "1.0.0"},
-		{Name: "github.com/stretchr/objx", Version: "0.1.0"},
-		{Name: "github.com/stretchr/testify", Version: "1.7.0"},
-		{Name: "github.com/urfave/cli", Version: "1.22.5"},
-		{Name: "golang.org/x/xerrors", Version: "0.0.0-20200804184101-5ec99f83aff1"},
-		{Name: "gopkg.in/check.v1", Version: "0.0.0-20161208181325-20d25e280405"},
-		{Name: "gopkg.in/yaml.v2", Version: "2.2.2"},
-		{Name: "gopkg.in/yaml.v3", Version: "3.0.0-20200313102051-9f266ea9e77c"},
+		{Name: "github.com/BurntSushi/toml", Version: "0.3.1", Locations: types.Locations{{StartLine: 1, EndLine: 1}, {StartLine: 2, EndLine: 2}}, Digests: []types.Digest{"h1:597918625e98af7a817f52bbf440672f899a9343a29817c1d1751ff55976f0e4"}},
+		{Name: "github.com/cpuguy83/go-md2man/v2", Version: "2.0.0-20190314233015-f79a8a8ca69d", Locations: types.Locations{{StartLine: 3, EndLine: 3}, {StartLine: 4, EndLine: 4}}, Digests: []types.Digest{"h1:53eb3dd144d2620a6d64cc10876691af72ee6b32c921af8f83729cd729526156"}},
+		{Name: "github.com/davecgh/go-spew", Version: "1.1.0", Locations: types.Locations{{StartLine: 5, EndLine: 5}, {StartLine: 6, EndLine: 6}}, Digests: []types.Digest{"h1:643463550d791a6842ddf890f278bcf8ec246503b80c0473420ae75d4d4b8b3f"}},
+		{Name: "github.com/pmezard/go-difflib", Version: "1.0.0", Locations: types.Locations{{StartLine: 7, EndLine: 7}, {StartLine: 8, EndLine: 8}}, Digests: []types.Digest{"h1:e030700c4d0d1b24280476cb4183f04943e808c591e41133224fdfd6565b0103"}},
+		{Name: "github.com/russross/blackfriday/v2", Version: "2.0.1", Locations: types.Locations{{StartLine: 9, EndLine: 9}, {StartLine: 10, EndLine: 10}}, Digests: []types.Digest{"h1:94fa9502d7be1ee1cd7e127fd0b0bdf04496473f1a7f2f6d33fd112bc9bda3e4"}},
+		{Name: "github.com/shurcooL/sanitized_anchor_name", Version: "1.0.0", Locations: types.Locations{{StartLine: 11, EndLine: 11}, {StartLine: 12, EndLine: 12}}, Digests: []types.Digest{"h1:3dd9a808eeb0bdbb3eef2ac9c8c391b78fc1998e486322704bf90e896c7c987a"}},
+		{Name: "github.com/stretchr/objx", Version: "0.1.0", Locations: types.Locations{{StartLine: 13, EndLine: 13}, {StartLine: 14, EndLine: 14}}, Digests: []types.Digest{"h1:e06e2fd9d3b7559c22c46211a10e4b7dba32ea75210b26336aa9c800f3e162ce"}},
+		{Name: "github.com/stretchr/testify", Version: "1.7.0", Locations: types.Locations{{StartLine: 15, EndLine: 15}, {StartLine: 16, EndLine: 16}}, Digests: []types.Digest{"h1:9f07370c47879a62c07e866e71547cf35b804a4d0c7e3c3cc5827df6d6f909c6"}},
+		{Name: "github.com/urfave/cli", Version: "1.22.5", Locations: types.Locations{{StartLine: 17, EndLine: 17}, {StartLine: 18, EndLine: 18}}, Digests: []types.Digest{"h1:94dabdb001d72b6a9f748f16f86448b63084908fb6a11e1df8c107cb508a5e85"}},
+		{Name: "golang.org/x/xerrors", Version: "0.0.0-20200804184101-5ec99f83aff1", Locations: types.Locations{{StartLine: 19, EndLine: 19}, {StartLine: 20, EndLine: 20}}, Digests: []types.Digest{"h1:828d5b2bf0ff045655d88f1c21077534a119fb4a304931b57c34dc8b822a15c1"}},
+		{Name: "gopkg.in/check.v1", Version: "0.0.0-20161208181325-20d25e280405", Locations: types.Locations{{StartLine: 21, EndLine: 21}}},
+		{Name: "gopkg.in/yaml.v2", Version: "2.2.2", Locations: types.Locations{{StartLine: 22, EndLine: 22}}},
+		{Name: "gopkg.in/yaml.v3", Version: "3.0.0-20200313102051-9f266ea9e77c", Locations: types.Locations{{StartLine: 23, EndLine: 23}, {StartLine: 24, EndLine: 24}}, Digests: []types.Digest{"h1:7545301e4d90102a3feafa80e38aed859f227b641730d78a4531c2358da75efa"}},
 	}
 
 	// docker run --name gomod --rm -it golang:1.15 bash
@@ -54,341 +54,341 @@ var (
 	// go get github.com/aquasecurity/trivy
 	// go list -m all | awk 'NR>1 {sub(/^v/, "", $2); printf("{\""$1"\", \""$2"\", },\n")}'
 	GoModTrivy = []types.Library{
-		{Name: "cloud.google.com/go", Version: "0.65.0"},
-		{Name: "cloud.google.com/go/bigquery", Version: "1.8.0"},
-		{Name: "cloud.google.com/go/datastore", Version: "1.1.0"},
-		{Name: "cloud.google.com/go/pubsub", Version: "1.3.1"},
-		{Name: "cloud.google.com/go/storage", Version: "1.10.0"},
-		{Name: "dmitri.shuralyov.com/gpu/mtl", Version: "0.0.0-20190408044501-666a987793e9"},
-		{Name: "github.com/Azure/azure-sdk-for-go", Version: "38.0.0+incompatible"},
-		{Name: "github.com/Azure/go-ansiterm", Version: "0.0.0-20170929234023-d6e3b3328b78"},
-		{Name: "github.com/Azure/go-autorest/autorest", Version: "0.9.3"},
-		{Name: "github.com/Azure/go-autorest/autorest/adal", Version: "0.8.1"},
-		{Name: "github.com/Azure/go-autorest/autorest/date", Version: "0.2.0"},
-		{Name: "github.com/Azure/go-autorest/autorest/mocks", Version: "0.3.0"},
-		{Name: "github.com/Azure/go-autorest/autorest/to", Version: "0.3.0"},
-		{Name: "github.com/Azure/go-autorest/autorest/validation", Version: "0.1.0"},
-		{Name: "github.com/Azure/go-autorest/logger", Version: "0.1.0"},
-		{Name: "github.com/Azure/go-autorest/tracing", Version: "0.5.0"},
-		{Name: "github.com/BurntSushi/toml", Version: "0.3.1"},
-		{Name: "github.com/BurntSushi/xgb", Version: "0.0.0-20160522181843-27f122750802"},
-		{Name: "github.com/GoogleCloudPlatform/docker-credential-gcr", Version: "1.5.0"},
-		{Name: "github.com/GoogleCloudPlatform/k8s-cloud-provider", Version: "0.0.0-20190822182118-27a4ced34534"},
-		{Name: "github.com/Microsoft/go-winio", Version: "0.4.15-0.20190919025122-fc70bd9a86b5"},
-		{Name: "github.com/Microsoft/hcsshim", Version: "0.8.6"},
-		{Name: "github.com/NYTimes/gziphandler", Version: "0.0.0-20170623195520-56545f4a5d46"},
-		{Name: "github.com/OneOfOne/xxhash", Version: "1.2.7"},
-		{Name: "github.com/PuerkitoBio/purell", Version: "1.1.1"},
-		{Name: "github.com/PuerkitoBio/urlesc", Version: "0.0.0-20170810143723-de5bf2ad4578"},
-		{Name: "github.com/VividCortex/ewma", Version: "1.1.1"},
-		{Name: "github.com/alcortesm/tgz", Version: "0.0.0-20161220082320-9c5fe88206d7"},
-		{Name: "github.com/alecthomas/template", Version: "0.0.0-20160405071501-a0175ee3bccc"},
-		{Name: "github.com/alecthomas/units", Version: "0.0.0-20151022065526-2efee857e7cf"},
-		{Name: "github.com/alicebob/gopher-json", Version: "0.0.0-20200520072559-a9ecdc9d1d3a"},
-		{Name: "github.com/alicebob/miniredis/v2", Version: "2.14.1"},
-		{Name: "github.com/anmitsu/go-shlex", Version: "0.0.0-20161002113705-648efa622239"},
-		{Name: "github.com/aquasecurity/bolt-fixtures", Version: "0.0.0-20200903104109-d34e7f983986"},
-		{Name: "github.com/aquasecurity/fanal", Version: "0.0.0-20210119051230-28c249da7cfd"},
-		{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.0-20201028043324-889d4a92b8e0"},
-		{Name: "github.com/aquasecurity/go-gem-version", Version: "0.0.0-20201115065557-8eed6fe000ce"},
-		{Name: "github.com/aquasecurity/go-npm-version", Version: "0.0.0-20201110091526-0b796d180798"},
-		{Name: "github.com/aquasecurity/go-pep440-version", Version: "0.0.0-20210121094942-22b2f8951d46"},
-		{Name: "github.com/aquasecurity/go-version", Version: "0.0.0-20210121072130-637058cfe492"},
-		{Name: "github.com/aquasecurity/testdocker", Version: "0.0.0-20210106133225-0b17fe083674"},
-		{Name: "github.com/aquasecurity/trivy", Version: "0.16.0"},
-		{Name: "github.com/aquasecurity/trivy-db", Version: "0.0.0-20210105160501-c5bf4e153277"},
-		{Name: "github.com/aquasecurity/vuln-list-update", Version: "0.0.0-20191016075347-3d158c2bf9a2"},
-		{Name: "github.com/araddon/dateparse", Version: "0.0.0-20190426192744-0d74ffceef83"},
-		{Name: "github.com/armon/consul-api", Version: "0.0.0-20180202201655-eb2c6b5be1b6"},
-		{Name: "github.com/armon/go-socks5", Version: "0.0.0-20160902184237-e75332964ef5"},
-		{Name: "github.com/aws/aws-sdk-go", Version: "1.27.1"},
-		{Name: "github.com/beorn7/perks", Version: "1.0.0"},
-		{Name: "github.com/bgentry/speakeasy", Version: "0.1.0"},
-		{Name: "github.com/blang/semver", Version: "3.5.0+incompatible"},
-		{Name: "github.com/briandowns/spinner", Version: "1.12.0"},
-		{Name: "github.com/caarlos0/env/v6", Version: "6.0.0"},
-		{Name: "github.com/cenkalti/backoff", Version: "2.2.1+incompatible"},
-		{Name: "github.com/census-instrumentation/opencensus-proto", Version: "0.2.1"},
-		{Name: "github.com/cespare/xxhash/v2", Version: "2.1.1"},
-		{Name: "github.com/cheggaaa/pb/v3", Version: "3.0.3"},
-		{Name: "github.com/chzyer/logex", Version: "1.1.10"},
-		{Name: "github.com/chzyer/readline", Version: "0.0.0-20180603132655-2972be24d48e"},
-		{Name: "github.com/chzyer/test", Version: "0.0.0-20180213035817-a1ea475d72b1"},
-		{Name: "github.com/client9/misspell", Version: "0.3.4"},
-		{Name: "github.com/cncf/udpa/go", Version: "0.0.0-20191209042840-269d4d468f6f"},
-		{Name: "github.com/cockroachdb/datadriven", Version: "0.0.0-20190809214429-80d97fb3cbaa"},
-		{Name: "github.com/containerd/containerd", Version: "1.3.3"},
-		{Name: "github.com/containerd/continuity", Version: "0.0.0-20190426062206-aaeac12a7ffc"},
-		{Name: "github.com/coreos/etcd", Version: "3.3.10+incompatible"},
-		{Name: "github.com/coreos/go-etcd", Version: "2.0.0+incompatible"},
-		{Name: "github.com/coreos/go-oidc", Version: "2.1.0+incompatible"},
-		{Name: "github.com/coreos/go-semver", Version: "0.3.0"},
-		{Name: "github.com/coreos/go-systemd", Version: "0.0.0-20190321100706-95778dfbb74e"},
-		{Name: "github.com/coreos/pkg", Version: "0.0.0-20180108230652-97fdf19511ea"},
-		{Name: "github.com/cpuguy83/go-md2man", Version: "1.0.10"},
-		{Name: "github.com/cpuguy83/go-md2man/v2", Version: "2.0.0"},
-		{Name: "github.com/creack/pty", Version: "1.1.9"},
-		{Name: "github.com/davecgh/go-spew", Version: "1.1.1"},
-		{Name: "github.com/deckarep/golang-set", Version: "1.7.1"},
-		{Name: "github.com/dgrijalva/jwt-go", Version: "3.2.0+incompatible"},
-		{Name: "github.com/dgryski/go-rendezvous", Version: "0.0.0-20200823014737-9f7001d12a5f"},
-		{Name: "github.com/dnaeon/go-vcr", Version: "1.0.1"},
-		{Name: "github.com/docker/cli", Version: "0.0.0-20191017083524-a8ff7f821017"},
-		{Name: "github.com/docker/distribution", Version: "2.7.1+incompatible"},
-		{Name: "github.com/docker/docker", Version: "1.4.2-0.20190924003213-a8608b5b67c7"},
-		{Name: "github.com/docker/docker-credential-helpers", Version: "0.6.3"},
-		{Name: "github.com/docker/go-connections", Version: "0.4.0"},
-		{Name: "github.com/docker/go-units", Version: "0.4.0"},
-		{Name: "github.com/docker/spdystream", Version: "0.0.0-20160310174837-449fdfce4d96"},
-		{Name: "github.com/dustin/go-humanize", Version: "1.0.0"},
-		{Name: "github.com/elazarl/goproxy", Version: "0.0.0-20200809112317-0581fc3aee2d"},
-		{Name: "github.com/elazarl/goproxy/ext", Version: "0.0.0-20200809112317-0581fc3aee2d"},
-		{Name: "github.com/emicklei/go-restful", Version: "2.9.5+incompatible"},
-		{Name: "github.com/emirpasic/gods", Version: "1.12.0"},
-		{Name: "github.com/envoyproxy/go-control-plane", Version: "0.9.4"},
-		{Name: "github.com/envoyproxy/protoc-gen-validate", Version: "0.1.0"},
-		{Name: "github.com/evanphx/json-patch", Version: "4.2.0+incompatible"},
-		{Name: "github.com/fatih/color", Version: "1.10.0"},
-		{Name: "github.com/flynn/go-shlex", Version: "0.0.0-20150515145356-3f9db97f8568"},
-		{Name: "github.com/fsnotify/fsnotify", Version: "1.4.9"},
-		{Name: "github.com/ghodss/yaml", Version: "1.0.0"},
-		{Name: "github.com/gin-contrib/sse", Version: "0.1.0"},
-		{Name: "github.com/gin-gonic/gin", Version: "1.5.0"},
-		{Name: "github.com/gliderlabs/ssh", Version: "0.2.2"},
-		{Name: "github.com/go-git/gcfg", Version: "1.5.0"},
-		{Name: "github.com/go-git/go-billy/v5", Version: "5.0.0"},
-		{Name: "github.com/go-git/go-git-fixtures/v4", Version: "4.0.1"},
-		{Name: "github.com/go-git/go-git/v5", Version: "5.0.0"},
-		{Name: "github.com/go-gl/glfw", Version: "0.0.0-20190409004039-e6da0acd62b1"},
-		{Name: "github.com/go-gl/glfw/v3.3/glfw", Version: "0.0.0-20200222043503-6f7a984d4dc4"},
-		{Name: "github.com/go-kit/kit", Version: "0.8.0"},
-		{Name: "github.com/go-logfmt/logfmt", Version: "0.3.0"},
-		{Name: "github.com/go-logr/logr", Version: "0.1.0"},
-		{Name: "github.com/go-openapi/jsonpointer", Version: "0.19.3"},
-		{Name: "github.com/go-openapi/jsonreference", Version: "0.19.3"},
-		{Name: "github.com/go-openapi/spec", Version: "0.19.3"},
-		{Name: "github.com/go-openapi/swag", Version: "0.19.5"},
-		{Name: "github.com/go-playground/locales", Version: "0.13.0"},
-		{Name: "github.com/go-playground/universal-translator", Version: "0.17.0"},
-		{Name: "github.com/go-redis/redis", Version: "6.15.7+incompatible"},
-		{Name: "github.com/go-redis/redis/v8", Version: "8.4.0"},
-		{Name: "github.com/go-restruct/restruct", Version: "0.0.0-20191227155143-5734170a48a1"},
-		{Name: "github.com/go-sql-driver/mysql", Version: "1.5.0"},
-		{Name: "github.com/go-stack/stack", Version: "1.8.0"},
-		{Name: "github.com/gobwas/glob", Version: "0.2.3"},
-		{Name: "github.com/goccy/go-yaml", Version: "1.8.2"},
-		{Name: "github.com/gogo/protobuf", Version: "1.3.1"},
-		{Name: "github.com/golang/glog", Version: "0.0.0-20160126235308-23def4e6c14b"},
-		{Name: "github.com/golang/groupcache", Version: "0.0.0-20200121045136-8c9f03a8e57e"},
-		{Name: "github.com/golang/mock", Version: "1.4.4"},
-		{Name: "github.com/golang/protobuf", Version: "1.4.2"},
-		{Name: "github.com/google/btree", Version: "1.0.0"},
-		{Name: "github.com/google/go-cmp", Version: "0.5.3"},
-		{Name: "github.com/google/go-containerregistry", Version: "0.0.0-20200331213917-3d03ed9b1ca2"},
-		{Name: "github.com/google/go-github/v28", Version: "28.1.1"},
-		{Name: "github.com/google/go-querystring", Version: "1.0.0"},
-		{Name: "github.com/google/gofuzz", Version: "1.0.0"},
-		{Name: "github.com/google/martian", Version: "2.1.0+incompatible"},
-		{Name: "github.com/google/martian/v3", Version: "3.0.0"},
-		{Name: "github.com/google/pprof", Version: "0.0.0-20200708004538-1a94d8640e99"},
-		{Name: "github.com/google/renameio", Version: "0.1.0"},
-		{Name: "github.com/google/subcommands", Version: "1.0.1"},
-		{Name: "github.com/google/uuid", Version: "1.1.1"},
-		{Name: "github.com/google/wire", Version: "0.3.0"},
-		{Name: "github.com/googleapis/gax-go/v2", Version: "2.0.5"},
-		{Name: "github.com/googleapis/gnostic", Version: "0.2.2"},
-		{Name: "github.com/gophercloud/gophercloud", Version: "0.1.0"},
-		{Name: "github.com/gopherjs/gopherjs", Version: "0.0.0-20200217142428-fce0ec30dd00"},
-		{Name: "github.com/gorilla/context", Version: "1.1.1"},
-		{Name: "github.com/gorilla/mux", Version: "1.7.4"},
-		{Name: "github.com/gorilla/websocket", Version: "1.4.0"},
-		{Name: "github.com/gregjones/httpcache", Version: "0.0.0-20180305231024-9cad4c3443a7"},
-		{Name: "github.com/grpc-ecosystem/go-grpc-middleware", Version: "1.0.1-0.20190118093823-f849b5445de4"},
-		{Name: "github.com/grpc-ecosystem/go-grpc-prometheus", Version: "1.2.0"},
-		{Name: "github.com/grpc-ecosystem/grpc-gateway", Version: "1.9.5"},
-		{Name: "github.com/hashicorp/errwrap", Version: "1.0.0"},
-		{Name: "github.com/hashicorp/go-multierror", Version: "1.1.0"},
-		{Name: "github.com/hashicorp/go-version", Version: "1.2.1"},
-		{Name: "github.com/hashicorp/golang-lru", Version: "0.5.3"},
-		{Name: "github.com/hashicorp/hcl", Version: "1.0.0"},
-		{Name: "github.com/hpcloud/tail", Version: "1.0.0"},
-		{Name: "github.com/ianlancetaylor/demangle", Version: "0.0.0-20181102032728-5e5cf60278f6"},
-		{Name: "github.com/imdario/mergo", Version: "0.3.5"},
-		{Name: "github.com/inconshreveable/mousetrap", Version: "1.0.0"},
-		{Name: "github.com/jbenet/go-context", Version: "0.0.0-20150711004518-d14ea06fba99"},
-		{Name: "github.com/jessevdk/go-flags", Version: "1.4.0"},
-		{Name: "github.com/jmespath/go-jmespath", Version: "0.0.0-20180206201540-c2b33e8439af"},
-		{Name: "github.com/joefitzgerald/rainbow-reporter", Version: "0.1.0"},
-		{Name: "github.com/jonboulle/clockwork", Version: "0.1.0"},
-		{Name: "github.com/json-iterator/go", Version: "1.1.8"},
-		{Name: "github.com/jstemmer/go-junit-report", Version: "0.9.1"},
-		{Name: "github.com/jtolds/gls", Version: "4.20.0+incompatible"},
-		{Name: "github.com/julienschmidt/httprouter", Version: "1.2.0"},
-		{Name: "github.com/kevinburke/ssh_config", Version: "0.0.0-20190725054713-01f96b0aa0cd"},
-		{Name: "github.com/kisielk/errcheck", Version: "1.2.0"},
-		{Name: "github.com/kisielk/gotool", Version: "1.0.0"},
-		{Name: "github.com/knqyf263/go-apk-version", Version: "0.0.0-20200609155635-041fdbb8563f"},
-		{Name: "github.com/knqyf263/go-deb-version", Version: "0.0.0-20190517075300-09fca494f03d"},
-		{Name: "github.com/knqyf263/go-rpm-version", Version: "0.0.0-20170716094938-74609b86c936"},
-		{Name: "github.com/knqyf263/go-rpmdb", Version: "0.0.0-20201215100354-a9e3110d8ee1"},
-		{Name: "github.com/knqyf263/nested", Version: "0.0.1"},
-		{Name: "github.com/konsorten/go-windows-terminal-sequences", Version: "1.0.2"},
-		{Name: "github.com/kr/logfmt", Version: "0.0.0-20140226030751-b84e30acd515"},
-		{Name: "github.com/kr/pretty", Version: "0.1.0"},
-		{Name: "github.com/kr/pty", Version: "1.1.5"},
-		{Name: "github.com/kr/text", Version: "0.2.0"},
-		{Name: "github.com/kylelemons/godebug", Version: "1.1.0"},
-		{Name: "github.com/leodido/go-urn", Version: "1.2.0"},
-		{Name: "github.com/magiconair/properties", Version: "1.8.0"},
-		{Name: "github.com/mailru/easyjson", Version: "0.7.0"},
-		{Name: "github.com/mattn/go-colorable", Version: "0.1.8"},
-		{Name: "github.com/mattn/go-isatty", Version: "0.0.12"},
-		{Name: "github.com/mattn/go-jsonpointer", Version: "0.0.0-20180225143300-37667080efed"},
-		{Name: "github.com/mattn/go-runewidth", Version: "0.0.9"},
-		{Name: "github.com/matttproud/golang_protobuf_extensions", Version: "1.0.1"},
-		{Name: "github.com/maxbrunsfeld/counterfeiter/v6", Version: "6.2.2"},
-		{Name: "github.com/mitchellh/go-homedir", Version: "1.1.0"},
-		{Name: "github.com/mitchellh/mapstructure", Version: "1.1.2"},
-		{Name: "github.com/modern-go/concurrent", Version: "0.0.0-20180306012644-bacd9c7ef1dd"},
-		{Name: "github.com/modern-go/reflect2", Version: "1.0.1"},
-		{Name: "github.com/morikuni/aec", Version: "1.0.0"},
-		{Name: "github.com/munnerz/goautoneg", Version: "0.0.0-20191010083416-a7dc8b61c822"},
-		{Name: "github.com/mwitkow/go-conntrack", Version: "0.0.0-20161129095857-cc309e4a2223"},
-		{Name: "github.com/mxk/go-flowrate", Version: "0.0.0-20140419014527-cca7078d478f"},
-		{Name: "github.com/niemeyer/pretty", Version: "0.0.0-20200227124842-a10e7caefd8e"},
-		{Name: "github.com/nxadm/tail", Version: "1.4.4"},
-		{Name: "github.com/olekukonko/tablewriter", Version: "0.0.2-0.20190607075207-195002e6e56a"},
-		{Name: "github.com/onsi/ginkgo", Version: "1.14.2"},
-		{Name: "github.com/onsi/gomega", Version: "1.10.3"},
-		{Name: "github.com/open-policy-agent/opa", Version: "0.21.1"},
-		{Name: "github.com/opencontainers/go-digest", Version: "1.0.0-rc1"},
-		{Name: "github.com/opencontainers/image-spec", Version: "1.0.2-0.20190823105129-775207bd45b6"},
-		{Name: "github.com/opencontainers/runc", Version: "0.1.1"},
-		{Name: "github.com/parnurzeal/gorequest", Version: "0.2.16"},
-		{Name: "github.com/pelletier/go-toml", Version: "1.2.0"},
-		{Name: "github.com/peterbourgon/diskv", Version: "2.0.1+incompatible"},
-		{Name: "github.com/peterh/liner", Version: "0.0.0-20170211195444-bf27d3ba8e1d"},
-		{Name: "github.com/pkg/errors", Version: "0.9.1"},
-		{Name: "github.com/pmezard/go-difflib", Version: "1.0.0"},
-		{Name: "github.com/pquerna/cachecontrol", Version: "0.0.0-20171018203845-0dec1b30a021"},
-		{Name: "github.com/prometheus/client_golang", Version: "1.0.0"},
-		{Name: "github.com/prometheus/client_model", Version: "0.0.0-20190812154241-14fe0d1b01d4"},
-		{Name: "github.com/prometheus/common", Version: "0.4.1"},
-		{Name: "github.com/prometheus/procfs", Version: "0.0.2"},
-		{Name: "github.com/rcrowley/go-metrics", Version: "0.0.0-20181016184325-3113b8401b8a"},
-		{Name: "github.com/remyoudompheng/bigfft", Version: "0.0.0-20170806203942-52369c62f446"},
-		{Name: "github.com/rogpeppe/fastuuid", Version: "0.0.0-20150106093220-6724a57986af"},
-		{Name: "github.com/rogpeppe/go-charset", Version: "0.0.0-20180617210344-2471d30d28b4"},
-		{Name: "github.com/rogpeppe/go-internal", Version: "1.3.0"},
-		{Name: "github.com/rubiojr/go-vhd", Version: "0.0.0-20160810183302-0bfd3b39853c"},
-		{Name: "github.com/russross/blackfriday", Version: "1.5.2"},
-		{Name: "github.com/russross/blackfriday/v2", Version: "2.0.1"},
-		{Name: "github.com/saracen/walker", Version: "0.0.0-20191201085201-324a081bae7e"},
-		{Name: "github.com/satori/go.uuid", Version: "1.2.0"},
-		{Name: "github.com/sclevine/spec", Version: "1.2.0"},
-		{Name: "github.com/sergi/go-diff", Version: "1.1.0"},
-		{Name: "github.com/shurcooL/sanitized_anchor_name", Version: "1.0.0"},
-		{Name: "github.com/simplereach/timeutils", Version: "1.2.0"},
-		{Name: "github.com/sirupsen/logrus", Version: "1.5.0"},
-		{Name: "github.com/smartystreets/assertions", Version: "1.2.0"},
-		{Name: "github.com/smartystreets/goconvey", Version: "1.6.4"},
-		{Name: "github.com/soheilhy/cmux", Version: "0.1.4"},
-		{Name: "github.com/sosedoff/gitkit", Version: "0.2.0"},
-		{Name: "github.com/spf13/afero", Version: "1.2.2"},
-		{Name: "github.com/spf13/cast", Version: "1.3.0"},
-		{Name: "github.com/spf13/cobra", Version: "0.0.5"},
-		{Name: "github.com/spf13/jwalterweatherman", Version: "1.0.0"},
-		{Name: "github.com/spf13/pflag", Version: "1.0.5"},
-		{Name: "github.com/spf13/viper", Version: "1.3.2"},
-		{Name: "github.com/stretchr/objx", Version: "0.3.0"},
-		{Name: "github.com/stretchr/testify", Version: "1.6.1"},
-		{Name: "github.com/testcontainers/testcontainers-go", Version: "0.3.1"},
-		{Name: "github.com/tmc/grpc-websocket-proxy", Version: "0.0.0-20170815181823-89b8d40f7ca8"},
-		{Name: "github.com/twitchtv/twirp", Version: "5.10.1+incompatible"},
-		{Name: "github.com/ugorji/go", Version: "1.1.7"},
-		{Name: "github.com/ugorji/go/codec", Version: "1.1.7"},
-		{Name: "github.com/urfave/cli", Version: "1.22.5"},
-		{Name: "github.com/urfave/cli/v2", Version: "2.3.0"},
-		{Name: "github.com/vdemeester/k8s-pkg-credentialprovider", Version: "1.17.4"},
-		{Name: "github.com/vmware/govmomi", Version: "0.20.3"},
-		{Name: "github.com/xanzy/ssh-agent", Version: "0.2.1"},
-		{Name: "github.com/xiang90/probing", Version: "0.0.0-20190116061207-43a291ad63a2"},
-		{Name: "github.com/xordataexchange/crypt", Version: "0.0.3-0.20170626215501-b2862e3d0a77"},
-		{Name: "github.com/yashtewari/glob-intersection", Version: "0.0.0-20180916065949-5c77d914dd0b"},
-		{Name: "github.com/yuin/goldmark", Version: "1.1.32"},
-		{Name: "github.com/yuin/gopher-lua", Version: "0.0.0-20191220021717-ab39c6098bdb"},
-		{Name: "go.etcd.io/bbolt", Version: "1.3.5"},
-		{Name: "go.etcd.io/etcd", Version: "0.0.0-20191023171146-3cf2f69b5738"},
-		{Name: "go.opencensus.io", Version: "0.22.4"},
-		{Name: "go.opentelemetry.io/otel", Version: "0.14.0"},
-		{Name: "go.uber.org/atomic", Version: "1.5.1"},
-		{Name: "go.uber.org/multierr", Version: "1.4.0"},
-		{Name: "go.uber.org/tools", Version: "0.0.0-20190618225709-2cfd321de3ee"},
-		{Name: "go.uber.org/zap", Version: "1.13.0"},
-		{Name: "golang.org/x/crypto", Version: "0.0.0-20201002170205-7f63de1d35b0"},
-		{Name: "golang.org/x/exp", Version: "0.0.0-20200224162631-6cc2880d07d6"},
-		{Name: "golang.org/x/image", Version: "0.0.0-20190802002840-cff245a6509b"},
-		{Name: "golang.org/x/lint", Version: "0.0.0-20200302205851-738671d3881b"},
-		{Name: "golang.org/x/mobile", Version: "0.0.0-20190719004257-d2bd2a29d028"},
-		{Name: "golang.org/x/mod", Version: "0.3.0"},
-		{Name: "golang.org/x/net", Version: "0.0.0-20201006153459-a7d1128ccaa0"},
-		{Name: "golang.org/x/oauth2", Version: "0.0.0-20201208152858-08078c50e5b5"},
-		{Name: "golang.org/x/sync", Version: "0.0.0-20200625203802-6e8e738ad208"},
-		{Name: "golang.org/x/sys", Version: "0.0.0-20201006155630-ac719f4daadf"},
-		{Name: "golang.org/x/text", Version: "0.3.3"},
-		{Name: "golang.org/x/time", Version: "0.0.0-20191024005414-555d28b269f0"},
-		{Name: "golang.org/x/tools", Version: "0.0.0-20200825202427-b303f430e36d"},
-		{Name: "golang.org/x/xerrors", Version: "0.0.0-20200804184101-5ec99f83aff1"},
-		{Name: "gonum.org/v1/gonum", Version: "0.0.0-20190331200053-3d26580ed485"},
-		{Name: "gonum.org/v1/netlib", Version: "0.0.0-20190331212654-76723241ea4e"},
-		{Name: "google.golang.org/api", Version: "0.30.0"},
-		{Name: "google.golang.org/appengine", Version: "1.6.6"},
-		{Name: "google.golang.org/genproto", Version: "0.0.0-20200825200019-8632dd797987"},
-		{Name: "google.golang.org/grpc", Version: "1.31.0"},
-		{Name: "google.golang.org/protobuf", Version: "1.25.0"},
-		{Name: "gopkg.in/alecthomas/kingpin.v2", Version: "2.2.6"},
-		{Name: "gopkg.in/check.v1", Version: "1.0.0-20200902074654-038fdea0a05b"},
-		{Name: "gopkg.in/cheggaaa/pb.v1", Version: "1.0.28"},
-		{Name: "gopkg.in/errgo.v2", Version: "2.1.0"},
-		{Name: "gopkg.in/fsnotify.v1", Version: "1.4.7"},
-		{Name: "gopkg.in/gcfg.v1", Version: "1.2.0"},
-		{Name: "gopkg.in/go-playground/assert.v1", Version: "1.2.1"},
-		{Name: "gopkg.in/go-playground/validator.v9", Version: "9.31.0"},
-		{Name: "gopkg.in/inf.v0", Version: "0.9.1"},
-		{Name: "gopkg.in/mgo.v2", Version: "2.0.0-20180705113604-9856a29383ce"},
-		{Name: "gopkg.in/natefinch/lumberjack.v2", Version: "2.0.0"},
-		{Name: "gopkg.in/resty.v1", Version: "1.12.0"},
-		{Name: "gopkg.in/square/go-jose.v2", Version: "2.2.2"},
-		{Name: "gopkg.in/tomb.v1", Version: "1.0.0-20141024135613-dd632973f1e7"},
-		{Name: "gopkg.in/warnings.v0", Version: "0.1.2"},
-		{Name: "gopkg.in/yaml.v2", Version: "2.4.0"},
-		{Name: "gopkg.in/yaml.v3", Version: "3.0.0-20200615113413-eeeca48fe776"},
-		{Name: "gotest.tools", Version: "2.2.0+incompatible"},
-		{Name: "honnef.co/go/tools", Version: "0.0.1-2020.1.4"},
-		{Name: "k8s.io/api", Version: "0.17.4"},
-		{Name: "k8s.io/apimachinery", Version: "0.17.4"},
-		{Name: "k8s.io/apiserver", Version: "0.17.4"},
-		{Name: "k8s.io/client-go", Version: "0.17.4"},
-		{Name: "k8s.io/cloud-provider", Version: "0.17.4"},
-		{Name: "k8s.io/code-generator", Version: "0.17.2"},
-		{Name: "k8s.io/component-base", Version: "0.17.4"},
-		{Name: "k8s.io/csi-translation-lib", Version: "0.17.4"},
-		{Name: "k8s.io/gengo", Version: "0.0.0-20190822140433-26a664648505"},
-		{Name: "k8s.io/klog", Version: "1.0.0"},
-		{Name: "k8s.io/klog/v2", Version: "2.0.0"},
-		{Name: "k8s.io/kube-openapi", Version: "0.0.0-20191107075043-30be4d16710a"},
-		{Name: "k8s.io/legacy-cloud-providers", Version: "0.17.4"},
-		{Name: "k8s.io/utils", Version: "0.0.0-20201110183641-67b214c5f920"},
-		{Name: "modernc.org/cc", Version: "1.0.0"},
-		{Name: "modernc.org/golex", Version: "1.0.0"},
-		{Name: "modernc.org/mathutil", Version: "1.0.0"},
-		{Name: "modernc.org/strutil", Version: "1.0.0"},
-		{Name: "modernc.org/xc", Version: "1.0.0"},
-		{Name: "moul.io/http2curl", Version: "1.0.0"},
-		{Name: "rsc.io/binaryregexp", Version: "0.2.0"},
-		{Name: "rsc.io/quote/v3", Version: "3.1.0"},
-		{Name: "rsc.io/sampler", Version: "1.3.0"},
-		{Name: "sigs.k8s.io/structured-merge-diff", Version: "1.0.1-0.20191108220359-b1b620dd3f06"},
-		{Name: "sigs.k8s.io/yaml", Version: "1.1.0"},
+		{Name: "cloud.google.com/go", Version: "0.65.0", Locations: types.Locations{{StartLine: 15, EndLine: 15}}},
+		{Name: "cloud.google.com/go/bigquery", Version: "1.8.0", Locations: types.Locations{{StartLine: 21, EndLine: 21}}},
+		{Name: "cloud.google.com/go/datastore", Version: "1.1.0", Locations: types.Locations{{StartLine: 23, EndLine: 23}}},
+		{Name: "cloud.google.com/go/pubsub", Version: "1.3.1", Locations: types.Locations{{StartLine: 27, EndLine: 27}}},
+		{Name: "cloud.google.com/go/storage", Version: "1.10.0", Locations: types.Locations{{StartLine: 32, EndLine: 32}}},
+		{Name: "dmitri.shuralyov.com/gpu/mtl", Version: "0.0.0-20190408044501-666a987793e9", Locations: types.Locations{{StartLine: 33, EndLine: 33}}},
+		{Name: "github.com/Azure/azure-sdk-for-go", Version: "38.0.0+incompatible", Locations: types.Locations{{StartLine: 35, EndLine: 35}}},
+		{Name: "github.com/Azure/go-ansiterm", Version: "0.0.0-20170929234023-d6e3b3328b78", Locations: types.Locations{{StartLine: 36, EndLine: 36}}},
+		{Name: "github.com/Azure/go-autorest/autorest", Version: "0.9.3", Locations: types.Locations{{StartLine: 38, EndLine: 38}}},
+		{Name: "github.com/Azure/go-autorest/autorest/adal", Version: "0.8.1", Locations: types.Locations{{StartLine: 41, EndLine: 41}}},
+		{Name: "github.com/Azure/go-autorest/autorest/date", Version: "0.2.0", Locations: types.Locations{{StartLine: 43, EndLine: 43}}},
+		{Name: "github.com/Azure/go-autorest/autorest/mocks", Version: "0.3.0", Locations: types.Locations{{StartLine: 46, EndLine: 46}}},
+		{Name: "github.com/Azure/go-autorest/autorest/to", Version: "0.3.0", Locations: types.Locations{{StartLine: 48, EndLine: 48}}},
+		{Name: "github.com/Azure/go-autorest/autorest/validation", Version: "0.1.0", Locations: types.Locations{{StartLine: 49, EndLine: 49}}},
+		{Name: "github.com/Azure/go-autorest/logger", Version: "0.1.0", Locations: types.Locations{{StartLine: 50, EndLine: 50}}},
+		{Name: "github.com/Azure/go-autorest/tracing", Version: "0.5.0", Locations: types.Locations{{StartLine: 51, EndLine: 51}}},
+		{Name: "github.com/BurntSushi/toml", Version: "0.3.1", Locations: types.Locations{{StartLine: 52, EndLine: 52}}},
+		{Name: "github.com/BurntSushi/xgb", Version: "0.0.0-20160522181843-27f122750802", Locations: types.Locations{{StartLine: 53, EndLine: 53}}},
+		{Name: "github.com/GoogleCloudPlatform/docker-credential-gcr", Version: "1.5.0", Locations: types.Locations{{StartLine: 54, EndLine: 54}}},
+		{Name: "github.com/GoogleCloudPlatform/k8s-cloud-provider", Version: "0.0.0-20190822182118-27a4ced34534", Locations: types.Locations{{StartLine: 55, EndLine: 55}}},
+		{Name: "github.com/Microsoft/go-winio", Version: "0.4.15-0.20190919025122-fc70bd9a86b5", Locations: types.Locations{{StartLine: 58, EndLine: 58}}},
+		{Name: "github.com/Microsoft/hcsshim", Version: "0.8.6", Locations: types.Locations{{StartLine: 59, EndLine: 59}}},
+		{Name: "github.com/NYTimes/gziphandler", Version: "0.0.0-20170623195520-56545f4a5d46", Locations: types.Locations{{StartLine: 60, EndLine: 60}}},
+		{Name: "github.com/OneOfOne/xxhash", Version: "1.2.7", Locations: types.Locations{{StartLine: 61, EndLine: 61}}},
+		{Name: "github.com/PuerkitoBio/purell", Version: "1.1.1", Locations: types.Locations{{StartLine: 63, EndLine: 63}}},
+		{Name: "github.com/PuerkitoBio/urlesc", Version: "0.0.0-20170810143723-de5bf2ad4578", Locations: types.Locations{{StartLine: 65, EndLine: 65}}},
+		{Name: "github.com/VividCortex/ewma", Version: "1.1.1", Locations: types.Locations{{StartLine: 66, EndLine: 66}}},
+		{Name: "github.com/alcortesm/tgz", Version: "0.0.0-20161220082320-9c5fe88206d7", Locations: types.Locations{{StartLine: 67, EndLine: 67}}},
+		{Name: "github.com/alecthomas/template", Version: "0.0.0-20160405071501-a0175ee3bccc", Locations: types.Locations{{StartLine: 68, EndLine: 68}}},
+		{Name: "github.com/alecthomas/units", Version: "0.0.0-20151022065526-2efee857e7cf", Locations: types.Locations{{StartLine: 69, EndLine: 69}}},
+		{Name: "github.com/alicebob/gopher-json", Version: "0.0.0-20200520072559-a9ecdc9d1d3a", Locations: types.Locations{{StartLine: 70, EndLine: 70}}},
+		{Name: "github.com/alicebob/miniredis/v2", Version: "2.14.1", Locations: types.Locations{{StartLine: 71, EndLine: 71}}},
+		{Name: "github.com/anmitsu/go-shlex", Version: "0.0.0-20161002113705-648efa622239", Locations: types.Locations{{StartLine: 72, EndLine: 72}}},
+		{Name: "github.com/aquasecurity/bolt-fixtures", Version: "0.0.0-20200903104109-d34e7f983986", Locations: types.Locations{{StartLine: 73, EndLine: 73}}},
+		{Name: "github.com/aquasecurity/fanal", Version: "0.0.0-20210119051230-28c249da7cfd", Locations: types.Locations{{StartLine: 74, EndLine: 74}}},
+		{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.0-20201028043324-889d4a92b8e0", Locations: types.Locations{{StartLine: 75, EndLine: 75}}},
+		{Name: "github.com/aquasecurity/go-gem-version", Version: "0.0.0-20201115065557-8eed6fe000ce", Locations: types.Locations{{StartLine: 76, EndLine: 76}}},
+		{Name: "github.com/aquasecurity/go-npm-version", Version: "0.0.0-20201110091526-0b796d180798", Locations: types.Locations{{StartLine: 77, EndLine: 77}}},
+		{Name: "github.com/aquasecurity/go-pep440-version", Version: "0.0.0-20210121094942-22b2f8951d46", Locations: types.Locations{{StartLine: 78, EndLine: 78}}},
+		{Name: "github.com/aquasecurity/go-version", Version: "0.0.0-20210121072130-637058cfe492", Locations: types.Locations{{StartLine: 80, EndLine: 80}}},
+		{Name: "github.com/aquasecurity/testdocker", Version: "0.0.0-20210106133225-0b17fe083674", Locations: types.Locations{{StartLine: 81, EndLine: 81}}},
+		{Name: "github.com/aquasecurity/trivy", Version: "0.16.0", Locations: types.Locations{{StartLine: 82, EndLine: 82}, {StartLine: 83, EndLine: 83}}, Digests: []types.Digest{"h1:972cea6064364d8c5c8c5c0a070e9a5373f01cbbdcd3ee954de721fb4fcb9ea4"}},
+		{Name: "github.com/aquasecurity/trivy-db", Version: "0.0.0-20210105160501-c5bf4e153277", Locations: types.Locations{{StartLine: 84, EndLine: 84}}},
+		{Name: "github.com/aquasecurity/vuln-list-update", Version: "0.0.0-20191016075347-3d158c2bf9a2", Locations: types.Locations{{StartLine: 85, EndLine: 85}}},
+		{Name: "github.com/araddon/dateparse", Version: "0.0.0-20190426192744-0d74ffceef83", Locations: types.Locations{{StartLine: 86, EndLine: 86}}},
+		{Name: "github.com/armon/consul-api", Version: "0.0.0-20180202201655-eb2c6b5be1b6", Locations: types.Locations{{StartLine: 87, EndLine: 87}}},
+		{Name: "github.com/armon/go-socks5", Version: "0.0.0-20160902184237-e75332964ef5", Locations: types.Locations{{StartLine: 88, EndLine: 88}}},
+		{Name: "github.com/aws/aws-sdk-go", Version: "1.27.1", Locations: types.Locations{{StartLine: 90, EndLine: 90}}},
+		{Name: "github.com/beorn7/perks", Version: "1.0.0", Locations: types.Locations{{StartLine: 92, EndLine: 92}}},
+		{Name: "github.com/bgentry/speakeasy", Version: "0.1.0", Locations: types.Locations{{StartLine: 93, EndLine: 93}}},
+		{Name: "github.com/blang/semver", Version: "3.5.0+incompatible", Locations: types.Locations{{StartLine: 94, EndLine: 94}}},
+		{Name: "github.com/briandowns/spinner", Version: "1.12.0", Locations: types.Locations{{StartLine: 95, EndLine: 95}}},
+		{Name: "github.com/caarlos0/env/v6", Version: "6.0.0", Locations: types.Locations{{StartLine: 96, EndLine: 96}}},
+		{Name: "github.com/cenkalti/backoff", Version: "2.2.1+incompatible", Locations: types.Locations{{StartLine: 97, EndLine: 97}}},
+		{Name: "github.com/census-instrumentation/opencensus-proto", Version: "0.2.1", Locations: types.Locations{{StartLine: 98, EndLine: 98}}},
+		{Name: "github.com/cespare/xxhash/v2", Version: "2.1.1", Locations: types.Locations{{StartLine: 99, EndLine: 99}}},
+		{Name: "github.com/cheggaaa/pb/v3", Version: "3.0.3", Locations: types.Locations{{StartLine: 100, EndLine: 100}}},
+		{Name: "github.com/chzyer/logex", Version: "1.1.10", Locations: types.Locations{{StartLine: 101, EndLine: 101}}},
+		{Name: "github.com/chzyer/readline", Version: "0.0.0-20180603132655-2972be24d48e", Locations: types.Locations{{StartLine: 102, EndLine: 102}}},
+		{Name: "github.com/chzyer/test", Version: "0.0.0-20180213035817-a1ea475d72b1", Locations: types.Locations{{StartLine: 103, EndLine: 103}}},
+		{Name: "github.com/client9/misspell", Version: "0.3.4", Locations: types.Locations{{StartLine: 104, EndLine: 104}}},
+		{Name: "github.com/cncf/udpa/go", Version: "0.0.0-20191209042840-269d4d468f6f", Locations: types.Locations{{StartLine: 105, EndLine: 105}}},
+		{Name: "github.com/cockroachdb/datadriven", Version: "0.0.0-20190809214429-80d97fb3cbaa", Locations: types.Locations{{StartLine: 106, EndLine: 106}}},
+		{Name: "github.com/containerd/containerd", Version: "1.3.3", Locations: types.Locations{{StartLine: 108, EndLine: 108}}},
+		{Name: "github.com/containerd/continuity", Version: "0.0.0-20190426062206-aaeac12a7ffc", Locations: types.Locations{{StartLine: 109, EndLine: 109}}},
+		{Name: "github.com/coreos/etcd", Version: "3.3.10+incompatible", Locations: types.Locations{{StartLine: 110, EndLine: 110}}},
+		{Name: "github.com/coreos/go-etcd", Version: "2.0.0+incompatible", Locations: types.Locations{{StartLine: 111, EndLine: 111}}},
+		{Name: "github.com/coreos/go-oidc", Version: "2.1.0+incompatible", Locations: types.Locations{{StartLine: 112, EndLine: 112}}},
+		{Name: "github.com/coreos/go-semver", Version: "0.3.0", Locations: types.Locations{{StartLine: 114, EndLine: 114}}},
+		{Name: "github.com/coreos/go-systemd", Version: "0.0.0-20190321100706-95778dfbb74e", Locations: types.Locations{{StartLine: 116, EndLine: 116}}},
+		{Name: "github.com/coreos/pkg", Version: "0.0.0-20180108230652-97fdf19511ea", Locations: types.Locations{{StartLine: 118, EndLine: 118}}},
+		{Name: "github.com/cpuguy83/go-md2man", Version: "1.0.10", Locations: types.Locations{{StartLine: 119, EndLine: 119}}},
+		{Name: "github.com/cpuguy83/go-md2man/v2", Version: "2.0.0", Locations: types.Locations{{StartLine: 121, EndLine: 121}}},
+		{Name: "github.com/creack/pty", Version: "1.1.9", Locations: types.Locations{{StartLine: 123, EndLine: 123}}},
+		{Name: "github.com/davecgh/go-spew", Version: "1.1.1", Locations: types.Locations{{StartLine: 126, EndLine: 126}}},
+		{Name: "github.com/deckarep/golang-set", Version: "1.7.1", Locations: types.Locations{{StartLine: 127, EndLine: 127}}},
+		{Name: "github.com/dgrijalva/jwt-go", Version: "3.2.0+incompatible", Locations: types.Locations{{StartLine: 128, EndLine: 128}}},
+		{Name: "github.com/dgryski/go-rendezvous", Version: "0.0.0-20200823014737-9f7001d12a5f", Locations: types.Locations{{StartLine: 129, EndLine: 129}}},
+		{Name: "github.com/dnaeon/go-vcr", Version: "1.0.1", Locations: types.Locations{{StartLine: 130, EndLine: 130}}},
+		{Name: "github.com/docker/cli", Version: "0.0.0-20191017083524-a8ff7f821017", Locations: types.Locations{{StartLine: 131, EndLine: 131}}},
+		{Name: "github.com/docker/distribution", Version: "2.7.1+incompatible", Locations: types.Locations{{StartLine: 133, EndLine: 133}}},
+		{Name: "github.com/docker/docker", Version: "1.4.2-0.20190924003213-a8608b5b67c7", Locations: types.Locations{{StartLine: 136, EndLine: 136}}},
+		{Name: "github.com/docker/docker-credential-helpers", Version: "0.6.3", Locations: types.Locations{{StartLine: 137, EndLine: 137}}},
+		{Name: "github.com/docker/go-connections", Version: "0.4.0", Locations: types.Locations{{StartLine: 138, EndLine: 138}}},
+		{Name: "github.com/docker/go-units", Version: "0.4.0", Locations: types.Locations{{StartLine: 140, EndLine: 140}}},
+		{Name: "github.com/docker/spdystream", Version: "0.0.0-20160310174837-449fdfce4d96", Locations: types.Locations{{StartLine: 141, EndLine: 141}}},
+		{Name: "github.com/dustin/go-humanize", Version: "1.0.0", Locations: types.Locations{{StartLine: 143, EndLine: 143}}},
+		{Name: "github.com/elazarl/goproxy", Version: "0.0.0-20200809112317-0581fc3aee2d", Locations: types.Locations{{StartLine: 146, EndLine: 146}}},
+		{Name: "github.com/elazarl/goproxy/ext", Version: "0.0.0-20200809112317-0581fc3aee2d", Locations: types.Locations{{StartLine: 149, EndLine: 149}}},
+		{Name: "github.com/emicklei/go-restful", Version: "2.9.5+incompatible", Locations: types.Locations{{StartLine: 151, EndLine: 151}}},
+		{Name: "github.com/emirpasic/gods", Version: "1.12.0", Locations: types.Locations{{StartLine: 152, EndLine: 152}}},
+		{Name: "github.com/envoyproxy/go-control-plane", Version: "0.9.4", Locations: types.Locations{{StartLine: 155, EndLine: 155}}},
+		{Name: "github.com/envoyproxy/protoc-gen-validate", Version: "0.1.0", Locations: types.Locations{{StartLine: 156, EndLine: 156}}},
+		{Name: "github.com/evanphx/json-patch", Version: "4.2.0+incompatible", Locations: types.Locations{{StartLine: 157, EndLine: 157}}},
+		{Name: "github.com/fatih/color", Version: "1.10.0", Locations: types.Locations{{StartLine: 160, EndLine: 160}}},
+		{Name: "github.com/flynn/go-shlex", Version: "0.0.0-20150515145356-3f9db97f8568", Locations: types.Locations{{StartLine: 161, EndLine: 161}}},
+		{Name: "github.com/fsnotify/fsnotify", Version: "1.4.9", Locations: types.Locations{{StartLine: 163, EndLine: 163}}},
+		{Name: "github.com/ghodss/yaml", Version: "1.0.0", Locations: types.Locations{{StartLine: 166, EndLine: 166}}},
+		{Name: "github.com/gin-contrib/sse", Version: "0.1.0", Locations: types.Locations{{StartLine: 167, EndLine: 167}}},
+		{Name: "github.com/gin-gonic/gin", Version: "1.5.0", Locations: types.Locations{{StartLine: 168, EndLine: 168}}},
+		{Name: "github.com/gliderlabs/ssh", Version: "0.2.2", Locations: types.Locations{{StartLine: 169, EndLine: 169}}},
+		{Name: "github.com/go-git/gcfg", Version: "1.5.0", Locations: types.Locations{{StartLine: 170, EndLine: 170}}},
+		{Name: "github.com/go-git/go-billy/v5", Version: "5.0.0", Locations: types.Locations{{StartLine: 171, EndLine: 171}}},
+		{Name: "github.com/go-git/go-git-fixtures/v4", Version: "4.0.1", Locations: types.Locations{{StartLine: 172, EndLine: 172}}},
+		{Name: "github.com/go-git/go-git/v5", Version: "5.0.0", Locations: types.Locations{{StartLine: 173, EndLine: 173}}},
+		{Name: "github.com/go-gl/glfw", Version: "0.0.0-20190409004039-e6da0acd62b1", Locations: types.Locations{{StartLine: 174, EndLine: 174}}},
+		{Name: "github.com/go-gl/glfw/v3.3/glfw", Version: "0.0.0-20200222043503-6f7a984d4dc4", Locations: types.Locations{{StartLine: 176, EndLine: 176}}},
+		{Name: "github.com/go-kit/kit", Version: "0.8.0", Locations: types.Locations{{StartLine: 177, EndLine: 177}}},
+		{Name: "github.com/go-logfmt/logfmt", Version: "0.3.0", Locations: types.Locations{{StartLine: 178, EndLine: 178}}},
+		{Name: "github.com/go-logr/logr", Version: "0.1.0", Locations: types.Locations{{StartLine: 179, EndLine: 179}}},
+		{Name: "github.com/go-openapi/jsonpointer", Version: "0.19.3", Locations: types.Locations{{StartLine: 182, EndLine: 182}}},
+		{Name: "github.com/go-openapi/jsonreference", Version: "0.19.3", Locations: types.Locations{{StartLine: 185, EndLine: 185}}},
+		{Name: "github.com/go-openapi/spec", Version: "0.19.3", Locations: types.Locations{{StartLine: 187, EndLine: 187}}},
+		{Name: "github.com/go-openapi/swag", Version: "0.19.5", Locations: types.Locations{{StartLine: 190, EndLine: 190}}},
+		{Name: "github.com/go-playground/locales", Version: "0.13.0", Locations: types.Locations{{StartLine: 192, EndLine: 192}}},
+		{Name: "github.com/go-playground/universal-translator", Version: "0.17.0", Locations: types.Locations{{StartLine: 194, EndLine: 194}}},
+		{Name: "github.com/go-redis/redis", Version: "6.15.7+incompatible", Locations: types.Locations{{StartLine: 195, EndLine: 195}}},
+		{Name: "github.com/go-redis/redis/v8", Version: "8.4.0", Locations: types.Locations{{StartLine: 196, EndLine: 196}}},
+		{Name: "github.com/go-restruct/restruct", Version: "0.0.0-20191227155143-5734170a48a1", Locations: types.Locations{{StartLine: 197, EndLine: 197}}},
+		{Name: "github.com/go-sql-driver/mysql", Version: "1.5.0", Locations: types.Locations{{StartLine: 198, EndLine: 198}}},
+		{Name: "github.com/go-stack/stack", Version: "1.8.0", Locations: types.Locations{{StartLine: 199, EndLine: 199}}},
+		{Name: "github.com/gobwas/glob", Version: "0.2.3", Locations: types.Locations{{StartLine: 200, EndLine: 200}}},
+		{Name: "github.com/goccy/go-yaml", Version: "1.8.2", Locations: types.Locations{{StartLine: 202, EndLine: 202}}},
+		{Name: "github.com/gogo/protobuf", Version: "1.3.1", Locations: types.Locations{{StartLine: 208, EndLine: 208}}},
+		{Name: "github.com/golang/glog", Version: "0.0.0-20160126235308-23def4e6c14b", Locations: types.Locations{{StartLine: 209, EndLine: 209}}},
+		{Name: "github.com/golang/groupcache", Version: "0.0.0-20200121045136-8c9f03a8e57e", Locations: types.Locations{{StartLine: 213, EndLine: 213}}},
+		{Name: "github.com/golang/mock", Version: "1.4.4", Locations: types.Locations{{StartLine: 220, EndLine: 220}}},
+		{Name: "github.com/golang/protobuf", Version: "1.4.2", Locations: types.Locations{{StartLine: 235, EndLine: 235}}},
+		{Name: "github.com/google/btree", Version: "1.0.0", Locations: types.Locations{{StartLine: 237, EndLine: 237}}},
+		{Name: "github.com/google/go-cmp", Version: "0.5.3", Locations: types.Locations{{StartLine: 245, EndLine: 245}}},
+		{Name: "github.com/google/go-containerregistry", Version: "0.0.0-20200331213917-3d03ed9b1ca2", Locations: types.Locations{{StartLine: 246, EndLine: 246}}},
+		{Name: "github.com/google/go-github/v28", Version: "28.1.1", Locations: types.Locations{{StartLine: 247, EndLine: 247}}},
+		{Name: "github.com/google/go-querystring", Version: "1.0.0", Locations: types.Locations{{StartLine: 248, EndLine: 248}}},
+		{Name: "github.com/google/gofuzz", Version: "1.0.0", Locations: types.Locations{{StartLine: 250, EndLine: 250}}},
+		{Name: "github.com/google/martian", Version: "2.1.0+incompatible", Locations: types.Locations{{StartLine: 251, EndLine: 251}}},
+		{Name: "github.com/google/martian/v3", Version: "3.0.0", Locations: types.Locations{{StartLine: 252, EndLine: 252}}},
+		{Name: "github.com/google/pprof", Version: "0.0.0-20200708004538-1a94d8640e99", Locations: types.Locations{{StartLine: 259, EndLine: 259}}},
+		{Name: "github.com/google/renameio", Version: "0.1.0", Locations: types.Locations{{StartLine: 260, EndLine: 260}}},
+		{Name: "github.com/google/subcommands", Version: "1.0.1", Locations: types.Locations{{StartLine: 261, EndLine: 261}}},
+		{Name: "github.com/google/uuid", Version: "1.1.1", Locations: types.Locations{{StartLine: 263, EndLine: 263}}},
+		{Name: "github.com/google/wire", Version: "0.3.0", Locations: types.Locations{{StartLine: 264, EndLine: 264}}},
+		{Name: "github.com/googleapis/gax-go/v2", Version: "2.0.5", Locations: types.Locations{{StartLine: 266, EndLine: 266}}},
+		{Name: "github.com/googleapis/gnostic", Version: "0.2.2", Locations: types.Locations{{StartLine: 268, EndLine: 268}}},
+		{Name: "github.com/gophercloud/gophercloud", Version: "0.1.0", Locations: types.Locations{{StartLine: 269, EndLine: 269}}},
+		{Name: "github.com/gopherjs/gopherjs", Version: "0.0.0-20200217142428-fce0ec30dd00", Locations: types.Locations{{StartLine: 271, EndLine: 271}}},
+		{Name: "github.com/gorilla/context", Version: "1.1.1", Locations: types.Locations{{StartLine: 272, EndLine: 272}}},
+		{Name: "github.com/gorilla/mux", Version: "1.7.4", Locations: types.Locations{{StartLine: 276, EndLine: 276}}},
+		{Name: "github.com/gorilla/websocket", Version: "1.4.0", Locations: types.Locations{{StartLine: 278, EndLine: 278}}},
+		{Name: "github.com/gregjones/httpcache", Version: "0.0.0-20180305231024-9cad4c3443a7", Locations: types.Locations{{StartLine: 279, EndLine: 279}}},
+		{Name: "github.com/grpc-ecosystem/go-grpc-middleware", Version: "1.0.1-0.20190118093823-f849b5445de4", Locations: types.Locations{{StartLine: 280, EndLine: 280}}},
+		{Name: "github.com/grpc-ecosystem/go-grpc-prometheus", Version: "1.2.0", Locations: types.Locations{{StartLine: 281, EndLine: 281}}},
+		{Name: "github.com/grpc-ecosystem/grpc-gateway", Version: "1.9.5", Locations: types.Locations{{StartLine: 282, EndLine: 282}}},
+		{Name: "github.com/hashicorp/errwrap", Version: "1.0.0", Locations: types.Locations{{StartLine: 283, EndLine: 283}}},
+		{Name: "github.com/hashicorp/go-multierror", Version: "1.1.0", Locations: types.Locations{{StartLine: 284, EndLine: 284}}},
+		{Name: "github.com/hashicorp/go-version", Version: "1.2.1", Locations: types.Locations{{StartLine: 286, EndLine: 286}}},
+		{Name: "github.com/hashicorp/golang-lru", Version: "0.5.3", Locations: types.Locations{{StartLine: 289, EndLine: 289}}},
+		{Name: "github.com/hashicorp/hcl", Version: "1.0.0", Locations: types.Locations{{StartLine: 290, EndLine: 290}}},
+		{Name: "github.com/hpcloud/tail", Version: "1.0.0", Locations: types.Locations{{StartLine: 291, EndLine: 291}}},
+		{Name: "github.com/ianlancetaylor/demangle", Version: "0.0.0-20181102032728-5e5cf60278f6", Locations: types.Locations{{StartLine: 292, EndLine: 292}}},
+		{Name: "github.com/imdario/mergo", Version: "0.3.5", Locations: types.Locations{{StartLine: 293, EndLine: 293}}},
+		{Name: "github.com/inconshreveable/mousetrap", Version: "1.0.0", Locations: types.Locations{{StartLine: 294, EndLine: 294}}},
+		{Name: "github.com/jbenet/go-context", Version: "0.0.0-20150711004518-d14ea06fba99", Locations: types.Locations{{StartLine: 295, EndLine: 295}}},
+		{Name: "github.com/jessevdk/go-flags", Version: "1.4.0", Locations: types.Locations{{StartLine: 296, EndLine: 296}}},
+		{Name: "github.com/jmespath/go-jmespath", Version: "0.0.0-20180206201540-c2b33e8439af", Locations: types.Locations{{StartLine: 297, EndLine: 297}}},
+		{Name: "github.com/joefitzgerald/rainbow-reporter", Version: "0.1.0", Locations: types.Locations{{StartLine: 298, EndLine: 298}}},
+		{Name: "github.com/jonboulle/clockwork", Version: "0.1.0", Locations: types.Locations{{StartLine: 299, EndLine: 299}}},
+		{Name: "github.com/json-iterator/go", Version: "1.1.8", Locations: types.Locations{{StartLine: 303, EndLine: 303}}},
+		{Name: "github.com/jstemmer/go-junit-report", Version: "0.9.1", Locations: types.Locations{{StartLine: 305, EndLine: 305}}},
+		{Name: "github.com/jtolds/gls", Version: "4.20.0+incompatible", Locations: types.Locations{{StartLine: 306, EndLine: 306}}},
+		{Name: "github.com/julienschmidt/httprouter", Version: "1.2.0", Locations: types.Locations{{StartLine: 307, EndLine: 307}}},
+		{Name: "github.com/kevinburke/ssh_config", Version: "0.0.0-20190725054713-01f96b0aa0cd", Locations: types.Locations{{StartLine: 308, EndLine: 308}}},
+		{Name: "github.com/kisielk/errcheck", Version: "1.2.0", Locations: types.Locations{{StartLine: 310, EndLine: 310}}},
+		{Name: "github.com/kisielk/gotool", Version: "1.0.0", Locations: types.Locations{{StartLine: 311, EndLine: 311}}},
+		{Name: "github.com/knqyf263/go-apk-version", Version: "0.0.0-20200609155635-041fdbb8563f", Locations: types.Locations{{StartLine: 312, EndLine: 312}}},
+		{Name: "github.com/knqyf263/go-deb-version", Version: "0.0.0-20190517075300-09fca494f03d", Locations: types.Locations{{StartLine: 313, EndLine: 313}}},
+		{Name: "github.com/knqyf263/go-rpm-version", Version: "0.0.0-20170716094938-74609b86c936", Locations: types.Locations{{StartLine: 314, EndLine: 314}}},
+		{Name: "github.com/knqyf263/go-rpmdb", Version: "0.0.0-20201215100354-a9e3110d8ee1", Locations: types.Locations{{StartLine: 315, EndLine: 315}}},
+		{Name: "github.com/knqyf263/nested", Version: "0.0.1", Locations: types.Locations{{StartLine: 316, EndLine: 316}}},
+		{Name: "github.com/konsorten/go-windows-terminal-sequences", Version: "1.0.2", Locations: types.Locations{{StartLine: 318, EndLine: 318}}},
+		{Name: "github.com/kr/logfmt", Version: "0.0.0-20140226030751-b84e30acd515", Locations: types.Locations{{StartLine: 319, EndLine: 319}}},
+		{Name: "github.com/kr/pretty", Version: "0.1.0", Locations: types.Locations{{StartLine: 320, EndLine: 320}}},
+		{Name: "github.com/kr/pty", Version: "1.1.5", Locations: types.Locations{{StartLine: 322, EndLine: 322}}},
+		{Name: "github.com/kr/text", Version: "0.2.0", Locations: types.Locations{{StartLine: 324, EndLine: 324}}},
+		{Name: "github.com/kylelemons/godebug", Version: "1.1.0", Locations: types.Locations{{StartLine: 326, EndLine: 326}}},
+		{Name: "github.com/leodido/go-urn", Version: "1.2.0", Locations: types.Locations{{StartLine: 328, EndLine: 328}}},
+		{Name: "github.com/magiconair/properties", Version: "1.8.0", Locations: types.Locations{{StartLine: 329, EndLine: 329}}},
+		{Name: "github.com/mailru/easyjson", Version: "0.7.0", Locations: types.Locations{{StartLine: 333, EndLine: 333}}},
+		{Name: "github.com/mattn/go-colorable", Version: "0.1.8", Locations: types.Locations{{StartLine: 339, EndLine: 339}}},
+		{Name: "github.com/mattn/go-isatty", Version: "0.0.12", Locations: types.Locations{{StartLine: 346, EndLine: 346}}},
+		{Name: "github.com/mattn/go-jsonpointer", Version: "0.0.0-20180225143300-37667080efed", Locations: types.Locations{{StartLine: 347, EndLine: 347}}},
+		{Name: "github.com/mattn/go-runewidth", Version: "0.0.9", Locations: types.Locations{{StartLine: 352, EndLine: 352}}},
+		{Name: "github.com/matttproud/golang_protobuf_extensions", Version: "1.0.1", Locations: types.Locations{{StartLine: 353, EndLine: 353}}},
+		{Name: "github.com/maxbrunsfeld/counterfeiter/v6", Version: "6.2.2", Locations: types.Locations{{StartLine: 354, EndLine: 354}}},
+		{Name: "github.com/mitchellh/go-homedir", Version: "1.1.0", Locations: types.Locations{{StartLine: 355, EndLine: 355}}},
+		{Name: "github.com/mitchellh/mapstructure", Version: "1.1.2", Locations: types.Locations{{StartLine: 356, EndLine: 356}}},
+		{Name: "github.com/modern-go/concurrent", Version: "0.0.0-20180306012644-bacd9c7ef1dd", Locations: types.Locations{{StartLine: 358, EndLine: 358}}},
+		{Name: "github.com/modern-go/reflect2", Version: "1.0.1", Locations: types.Locations{{StartLine: 361, EndLine: 361}}},
+		{Name: "github.com/morikuni/aec", Version: "1.0.0", Locations: types.Locations{{StartLine: 363, EndLine: 363}}},
+		{Name: "github.com/munnerz/goautoneg", Version: "0.0.0-20191010083416-a7dc8b61c822", Locations: types.Locations{{StartLine: 365, EndLine: 365}}},
+		{Name: "github.com/mwitkow/go-conntrack", Version: "0.0.0-20161129095857-cc309e4a2223", Locations: types.Locations{{StartLine: 366, EndLine: 366}}},
+		{Name: "github.com/mxk/go-flowrate", Version: "0.0.0-20140419014527-cca7078d478f", Locations: types.Locations{{StartLine: 367, EndLine: 367}}},
+		{Name: "github.com/niemeyer/pretty", Version: "0.0.0-20200227124842-a10e7caefd8e", Locations: types.Locations{{StartLine: 368, EndLine: 368}}},
+		{Name: "github.com/nxadm/tail", Version: "1.4.4", Locations: types.Locations{{StartLine: 369, EndLine: 369}}},
+		{Name: "github.com/olekukonko/tablewriter", Version: "0.0.2-0.20190607075207-195002e6e56a", Locations: types.Locations{{StartLine: 372, EndLine: 372}}},
+		{Name: "github.com/onsi/ginkgo", Version: "1.14.2", Locations: types.Locations{{StartLine: 378, EndLine: 378}}},
+		{Name: "github.com/onsi/gomega", Version: "1.10.3", Locations: types.Locations{{StartLine: 384, EndLine: 384}}},
+		{Name: "github.com/open-policy-agent/opa", Version: "0.21.1", Locations: types.Locations{{StartLine: 385, EndLine: 385}}},
+		{Name: "github.com/opencontainers/go-digest", Version: "1.0.0-rc1", Locations: types.Locations{{StartLine: 386, EndLine: 386}}},
+		{Name: "github.com/opencontainers/image-spec", Version: "1.0.2-0.20190823105129-775207bd45b6", Locations: types.Locations{{StartLine: 388, EndLine: 388}}},
+		{Name: "github.com/opencontainers/runc", Version: "0.1.1", Locations: types.Locations{{StartLine: 389, EndLine: 389}}},
+		{Name: "github.com/parnurzeal/gorequest", Version: "0.2.16", Locations: types.Locations{{StartLine: 390, EndLine: 390}}},
+		{Name: "github.com/pelletier/go-toml", Version: "1.2.0", Locations: types.Locations{{StartLine: 391, EndLine: 391}}},
+		{Name: "github.com/peterbourgon/diskv", Version: "2.0.1+incompatible", Locations: types.Locations{{StartLine: 392, EndLine: 392}}},
+		{Name: "github.com/peterh/liner", Version: "0.0.0-20170211195444-bf27d3ba8e1d", Locations: types.Locations{{StartLine: 393, EndLine: 393}}},
+		{Name: "github.com/pkg/errors", Version: "0.9.1", Locations: types.Locations{{StartLine: 397, EndLine: 397}}},
+		{Name: "github.com/pmezard/go-difflib", Version: "1.0.0", Locations: types.Locations{{StartLine: 399, EndLine: 399}}},
+		{Name: "github.com/pquerna/cachecontrol", Version: "0.0.0-20171018203845-0dec1b30a021", Locations: types.Locations{{StartLine: 400, EndLine: 400}}},
+		{Name: "github.com/prometheus/client_golang", Version: "1.0.0", Locations: types.Locations{{StartLine: 403, EndLine: 403}}},
+		{Name: "github.com/prometheus/client_model", Version: "0.0.0-20190812154241-14fe0d1b01d4", Locations: types.Locations{{StartLine: 406, EndLine: 406}}},
+		{Name: "github.com/prometheus/common", Version: "0.4.1", Locations: types.Locations{{StartLine: 408, EndLine: 408}}},
+		{Name: "github.com/prometheus/procfs", Version: "0.0.2", Locations: types.Locations{{StartLine: 410, EndLine: 410}}},
+		{Name: "github.com/rcrowley/go-metrics", Version: "0.0.0-20181016184325-3113b8401b8a", Locations: types.Locations{{StartLine: 411, EndLine: 411}}},
+		{Name: "github.com/remyoudompheng/bigfft", Version: "0.0.0-20170806203942-52369c62f446", Locations: types.Locations{{StartLine: 412, EndLine: 412}}},
+		{Name: "github.com/rogpeppe/fastuuid", Version: "0.0.0-20150106093220-6724a57986af", Locations: types.Locations{{StartLine: 413, EndLine: 413}}},
+		{Name: "github.com/rogpeppe/go-charset", Version: "0.0.0-20180617210344-2471d30d28b4", Locations: types.Locations{{StartLine: 414, EndLine: 414}}},
+		{Name: "github.com/rogpeppe/go-internal", Version: "1.3.0", Locations: types.Locations{{StartLine: 415, EndLine: 415}}},
+		{Name: "github.com/rubiojr/go-vhd", Version: "0.0.0-20160810183302-0bfd3b39853c", Locations: types.Locations{{StartLine: 416, EndLine: 416}}},
+		{Name: "github.com/russross/blackfriday", Version: "1.5.2", Locations: types.Locations{{StartLine: 417, EndLine: 417}}},
+		{Name: "github.com/russross/blackfriday/v2", Version: "2.0.1", Locations: types.Locations{{StartLine: 418, EndLine: 418}}},
+		{Name: "github.com/saracen/walker", Version: "0.0.0-20191201085201-324a081bae7e", Locations: types.Locations{{StartLine: 419, EndLine: 419}}},
+		{Name: "github.com/satori/go.uuid", Version: "1.2.0", Locations: types.Locations{{StartLine: 420, EndLine: 420}}},
+		{Name: "github.com/sclevine/spec", Version: "1.2.0", Locations: types.Locations{{StartLine: 421, EndLine: 421}}},
+		{Name: "github.com/sergi/go-diff", Version: "1.1.0", Locations: types.Locations{{StartLine: 422, EndLine: 422}}},
+		{Name: "github.com/shurcooL/sanitized_anchor_name", Version: "1.0.0", Locations: types.Locations{{StartLine: 423, EndLine: 423}}},
+		{Name: "github.com/simplereach/timeutils", Version: "1.2.0", Locations: types.Locations{{StartLine: 424, EndLine: 424}}},
+		{Name: "github.com/sirupsen/logrus", Version: "1.5.0", Locations: types.Locations{{StartLine: 428, EndLine: 428}}},
+		{Name: "github.com/smartystreets/assertions", Version: "1.2.0", Locations: types.Locations{{StartLine: 430, EndLine: 430}}},
+		{Name: "github.com/smartystreets/goconvey", Version: "1.6.4", Locations: types.Locations{{StartLine: 432, EndLine: 432}}},
+		{Name: "github.com/soheilhy/cmux", Version: "0.1.4", Locations: types.Locations{{StartLine: 433, EndLine: 433}}},
+		{Name: "github.com/sosedoff/gitkit", Version: "0.2.0", Locations: types.Locations{{StartLine: 434, EndLine: 434}}},
+		{Name: "github.com/spf13/afero", Version: "1.2.2", Locations: types.Locations{{StartLine: 436, EndLine: 436}}},
+		{Name: "github.com/spf13/cast", Version: "1.3.0", Locations: types.Locations{{StartLine: 437, EndLine: 437}}},
+		{Name: "github.com/spf13/cobra", Version: "0.0.5", Locations: types.Locations{{StartLine: 440, EndLine: 440}}},
+		{Name: "github.com/spf13/jwalterweatherman", Version: "1.0.0", Locations: types.Locations{{StartLine: 441, EndLine: 441}}},
+		{Name: "github.com/spf13/pflag", Version: "1.0.5", Locations: types.Locations{{StartLine: 446, EndLine: 446}}},
+		{Name: "github.com/spf13/viper", Version: "1.3.2", Locations: types.Locations{{StartLine: 447, EndLine: 447}}},
+		{Name: "github.com/stretchr/objx", Version: "0.3.0", Locations: types.Locations{{StartLine: 451, EndLine: 451}}},
+		{Name: "github.com/stretchr/testify", Version: "1.6.1", Locations: types.Locations{{StartLine: 457, EndLine: 457}}},
+		{Name: "github.com/testcontainers/testcontainers-go", Version: "0.3.1", Locations: types.Locations{{StartLine: 458, EndLine: 458}}},
+		{Name: "github.com/tmc/grpc-websocket-proxy", Version: "0.0.0-20170815181823-89b8d40f7ca8", Locations: types.Locations{{StartLine: 459, EndLine: 459}}},
+		{Name: "github.com/twitchtv/twirp", Version: "5.10.1+incompatible", Locations: types.Locations{{StartLine: 460, EndLine: 460}}},
+		{Name: "github.com/ugorji/go", Version: "1.1.7", Locations: types.Locations{{StartLine: 461, EndLine: 461}}},
+		{Name: "github.com/ugorji/go/codec", Version: "1.1.7", Locations: types.Locations{{StartLine: 463, EndLine: 463}}},
+		{Name: "github.com/urfave/cli", Version: "1.22.5", Locations: types.Locations{{StartLine: 465, EndLine: 465}}},
+		{Name: "github.com/urfave/cli/v2", Version: "2.3.0", Locations: types.Locations{{StartLine: 467, EndLine: 467}}},
+		{Name: "github.com/vdemeester/k8s-pkg-credentialprovider", Version: "1.17.4", Locations: types.Locations{{StartLine: 468, EndLine: 468}}},
+		{Name: "github.com/vmware/govmomi", Version: "0.20.3", Locations: types.Locations{{StartLine: 469, EndLine: 469}}},
+		{Name: "github.com/xanzy/ssh-agent", Version: "0.2.1", Locations: types.Locations{{StartLine: 470, EndLine: 470}}},
+		{Name: "github.com/xiang90/probing", Version: "0.0.0-20190116061207-43a291ad63a2", Locations: types.Locations{{StartLine: 471, EndLine: 471}}},
+		{Name: "github.com/xordataexchange/crypt", Version: "0.0.3-0.20170626215501-b2862e3d0a77", Locations: types.Locations{{StartLine: 472, EndLine: 472}}},
+		{Name: "github.com/yashtewari/glob-intersection", Version: "0.0.0-20180916065949-5c77d914dd0b", Locations: types.Locations{{StartLine: 473, EndLine: 473}}},
+		{Name: "github.com/yuin/goldmark", Version: "1.1.32", Locations: types.Locations{{StartLine: 476, EndLine: 476}}},
+		{Name: "github.com/yuin/gopher-lua", Version: "0.0.0-20191220021717-ab39c6098bdb", Locations: types.Locations{{StartLine: 477, EndLine: 477}}},
+		{Name: "go.etcd.io/bbolt", Version: "1.3.5", Locations: types.Locations{{StartLine: 479, EndLine: 479}}},
+		{Name: "go.etcd.io/etcd", Version: "0.0.0-20191023171146-3cf2f69b5738", Locations: types.Locations{{StartLine: 480, EndLine: 480}}},
+		{Name: "go.opencensus.io", Version: "0.22.4", Locations: types.Locations{{StartLine: 485, EndLine: 485}}},
+		{Name: "go.opentelemetry.io/otel", Version: "0.14.0", Locations: types.Locations{{StartLine: 486, EndLine: 486}}},
+		{Name: "go.uber.org/atomic", Version: "1.5.1", Locations: types.Locations{{StartLine: 489, EndLine: 489}}},
+		{Name: "go.uber.org/multierr", Version: "1.4.0", Locations: types.Locations{{StartLine: 492, EndLine: 492}}},
+		{Name: "go.uber.org/tools", Version: "0.0.0-20190618225709-2cfd321de3ee", Locations: types.Locations{{StartLine: 493, EndLine: 493}}},
+		{Name: "go.uber.org/zap", Version: "1.13.0", Locations: types.Locations{{StartLine: 495, EndLine: 495}}},
+		{Name: "golang.org/x/crypto", Version: "0.0.0-20201002170205-7f63de1d35b0", Locations: types.Locations{{StartLine: 509, EndLine: 509}}},
+		{Name: "golang.org/x/exp", Version: "0.0.0-20200224162631-6cc2880d07d6", Locations: types.Locations{{StartLine: 521, EndLine: 521}}},
+		{Name: "golang.org/x/image", Version: "0.0.0-20190802002840-cff245a6509b", Locations: types.Locations{{StartLine: 523, EndLine: 523}}},
+		{Name: "golang.org/x/lint", Version: "0.0.0-20200302205851-738671d3881b", Locations: types.Locations{{StartLine: 534, EndLine: 534}}},
+		{Name: "golang.org/x/mobile", Version: "0.0.0-20190719004257-d2bd2a29d028", Locations: types.Locations{{StartLine: 536, EndLine: 536}}},
+		{Name: "golang.org/x/mod", Version: "0.3.0", Locations: types.Locations{{StartLine: 542, EndLine: 542}}},
+		{Name: "golang.org/x/net", Version: "0.0.0-20201006153459-a7d1128ccaa0", Locations: types.Locations{{StartLine: 578, EndLine: 578}}},
+		{Name: "golang.org/x/oauth2", Version: "0.0.0-20201208152858-08078c50e5b5", Locations: types.Locations{{StartLine: 584, EndLine: 584}}},
+		{Name: "golang.org/x/sync", Version: "0.0.0-20200625203802-6e8e738ad208", Locations: types.Locations{{StartLine: 592, EndLine: 592}}},
+		{Name: "golang.org/x/sys", Version: "0.0.0-20201006155630-ac719f4daadf", Locations: types.Locations{{StartLine: 646, EndLine: 646}}},
+		{Name: "golang.org/x/text", Version: "0.3.3", Locations: types.Locations{{StartLine: 652, EndLine: 652}}},
+		{Name: "golang.org/x/time", Version: "0.0.0-20191024005414-555d28b269f0", Locations: types.Locations{{StartLine: 656, EndLine: 656}}},
+		{Name: "golang.org/x/tools", Version: "0.0.0-20200825202427-b303f430e36d", Locations: types.Locations{{StartLine: 711, EndLine: 711}}},
+		{Name: "golang.org/x/xerrors", Version: "0.0.0-20200804184101-5ec99f83aff1", Locations: types.Locations{{StartLine: 715, EndLine: 715}}},
+		{Name: "gonum.org/v1/gonum", Version: "0.0.0-20190331200053-3d26580ed485", Locations: types.Locations{{StartLine: 716, EndLine: 716}}},
+		{Name: "gonum.org/v1/netlib", Version: "0.0.0-20190331212654-76723241ea4e", Locations: types.Locations{{StartLine: 718, EndLine: 718}}},
+		{Name: "google.golang.org/api", Version: "0.30.0", Locations: types.Locations{{StartLine: 735, EndLine: 735}}},
+		{Name: "google.golang.org/appengine", Version: "1.6.6", Locations: types.Locations{{StartLine: 741, EndLine: 741}}},
+		{Name: "google.golang.org/genproto", Version: "0.0.0-20200825200019-8632dd797987", Locations: types.Locations{{StartLine: 771, EndLine: 771}}},
+		{Name: "google.golang.org/grpc", Version: "1.31.0", Locations: types.Locations{{StartLine: 786, EndLine: 786}}},
+		{Name: "google.golang.org/protobuf", Version: "1.25.0", Locations: types.Locations{{StartLine: 796, EndLine: 796}}},
+		{Name: "gopkg.in/alecthomas/kingpin.v2", Version: "2.2.6", Locations: types.Locations{{StartLine: 797, EndLine: 797}}},
+		{Name: "gopkg.in/check.v1", Version: "1.0.0-20200902074654-038fdea0a05b", Locations: types.Locations{{StartLine: 802, EndLine: 802}}},
+		{Name: "gopkg.in/cheggaaa/pb.v1", Version: "1.0.28", Locations: types.Locations{{StartLine: 804, EndLine: 804}}},
+		{Name: "gopkg.in/errgo.v2", Version: "2.1.0", Locations: types.Locations{{StartLine: 805, EndLine: 805}}},
+		{Name: "gopkg.in/fsnotify.v1", Version: "1.4.7", Locations: types.Locations{{StartLine: 806, EndLine: 806}}},
+		{Name: "gopkg.in/gcfg.v1", Version: "1.2.0", Locations: types.Locations{{StartLine: 807, EndLine: 807}}},
+		{Name: "gopkg.in/go-playground/assert.v1", Version: "1.2.1", Locations: types.Locations{{StartLine: 808, EndLine: 808}}},
+		{Name: "gopkg.in/go-playground/validator.v9", Version: "9.31.0", Locations: types.Locations{{StartLine: 811, EndLine: 811}}},
+		{Name: "gopkg.in/inf.v0", Version: "0.9.1", Locations: types.Locations{{StartLine: 812, EndLine: 812}}},
+		{Name: "gopkg.in/mgo.v2", Version: "2.0.0-20180705113604-9856a29383ce", Locations: types.Locations{{StartLine: 813, EndLine: 813}}},
+		{Name: "gopkg.in/natefinch/lumberjack.v2", Version: "2.0.0", Locations: types.Locations{{StartLine: 814, EndLine: 814}}},
+		{Name: "gopkg.in/resty.v1", Version: "1.12.0", Locations: types.Locations{{StartLine: 815, EndLine: 815}}},
+		{Name: "gopkg.in/square/go-jose.v2", Version: "2.2.2", Locations: types.Locations{{StartLine: 816, EndLine: 816}}},
+		{Name: "gopkg.in/tomb.v1", Version: "1.0.0-20141024135613-dd632973f1e7", Locations: types.Locations{{StartLine: 817, EndLine: 817}}},
+		{Name: "gopkg.in/warnings.v0", Version: "0.1.2", Locations: types.Locations{{StartLine: 819, EndLine: 819}}},
+		{Name: "gopkg.in/yaml.v2", Version: "2.4.0", Locations: types.Locations{{StartLine: 827, EndLine: 827}}},
+		{Name: "gopkg.in/yaml.v3", Version: "3.0.0-20200615113413-eeeca48fe776", Locations: types.Locations{{StartLine: 829, EndLine: 829}}},
+		{Name: "gotest.tools", Version: "2.2.0+incompatible", Locations: types.Locations{{StartLine: 831, EndLine: 831}}},
+		{Name: "honnef.co/go/tools", Version: "0.0.1-2020.1.4", Locations: types.Locations{{StartLine: 839, EndLine: 839}}},
+		{Name: "k8s.io/api", Version: "0.17.4", Locations: types.Locations{{StartLine: 840, EndLine: 840}}},
+		{Name: "k8s.io/apimachinery", Version: "0.17.4", Locations: types.Locations{{StartLine: 841, EndLine: 841}}},
+		{Name: "k8s.io/apiserver", Version: "0.17.4", Locations: types.Locations{{StartLine: 842, EndLine: 842}}},
+		{Name: "k8s.io/client-go", Version: "0.17.4", Locations: types.Locations{{StartLine: 843, EndLine: 843}}},
+		{Name: "k8s.io/cloud-provider", Version: "0.17.4", Locations: types.Locations{{StartLine: 844, EndLine: 844}}},
+		{Name: "k8s.io/code-generator", Version: "0.17.2", Locations: types.Locations{{StartLine: 845, EndLine: 845}}},
+		{Name: "k8s.io/component-base", Version: "0.17.4", Locations: types.Locations{{StartLine: 846, EndLine: 846}}},
+		{Name: "k8s.io/csi-translation-lib", Version: "0.17.4", Locations: types.Locations{{StartLine: 847, EndLine: 847}}},
+		{Name: "k8s.io/gengo", Version: "0.0.0-20190822140433-26a664648505", Locations: types.Locations{{StartLine: 849, EndLine: 849}}},
+		{Name: "k8s.io/klog", Version: "1.0.0", Locations: types.Locations{{StartLine: 852, EndLine: 852}}},
+		{Name: "k8s.io/klog/v2", Version: "2.0.0", Locations: types.Locations{{StartLine: 853, EndLine: 853}}},
+		{Name: "k8s.io/kube-openapi", Version: "0.0.0-20191107075043-30be4d16710a", Locations: types.Locations{{StartLine: 854, EndLine: 854}}},
+		{Name: "k8s.io/legacy-cloud-providers", Version: "0.17.4", Locations: types.Locations{{StartLine: 855, EndLine: 855}}},
+		{Name: "k8s.io/utils", Version: "0.0.0-20201110183641-67b214c5f920", Locations: types.Locations{{StartLine: 857, EndLine: 857}}},
+		{Name: "modernc.org/cc", Version: "1.0.0", Locations: types.Locations{{StartLine: 858, EndLine: 858}}},
+		{Name: "modernc.org/golex", Version: "1.0.0", Locations: types.Locations{{StartLine: 859, EndLine: 859}}},
+		{Name: "modernc.org/mathutil", Version: "1.0.0", Locations: types.Locations{{StartLine: 860, EndLine: 860}}},
+		{Name: "modernc.org/strutil", Version: "1.0.0", Locations: types.Locations{{StartLine: 861, EndLine: 861}}},
+		{Name: "modernc.org/xc", Version: "1.0.0", Locations: types.Locations{{StartLine: 862, EndLine: 862}}},
+		{Name: "moul.io/http2curl", Version: "1.0.0", Locations: types.Locations{{StartLine: 863, EndLine: 863}}},
+		{Name: "rsc.io/binaryregexp", Version: "0.2.0", Locations: types.Locations{{StartLine: 864, EndLine: 864}}},
+		{Name: "rsc.io/quote/v3", Version: "3.1.0", Locations: types.Locations{{StartLine: 865, EndLine: 865}}},
+		{Name: "rsc.io/sampler", Version: "1.3.0", Locations: types.Locations{{StartLine: 866, EndLine: 866}}},
+		{Name: "sigs.k8s.io/structured-merge-diff", Version: "1.0.1-0.20191108220359-b1b620dd3f06", Locations: types.Locations{{StartLine: 868, EndLine: 868}}},
+		{Name: "sigs.k8s.io/yaml", Version: "1.1.0", Locations: types.Locations{{StartLine: 869, EndLine: 869}}},
 	}
 )
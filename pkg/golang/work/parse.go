@@ -0,0 +1,135 @@
+package work
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/golang/mod"
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// Module is a module in the workspace.
+type Module struct {
+	// Path is the module path declared in go.mod. e.g. "example.com/app"
+	Path string
+	// Dir is the slash-separated directory relative to go.work. e.g. "app" and "../shared"
+	Dir       string
+	Libraries []types.Library
+}
+
+// Parse parses a go.work file and go.mod of the modules in the "use" directives.
+// dir is the slash-separated directory of go.work in fsys. Modules outside fsys are skipped.
+// Replace directives in go.work take precedence over the ones in go.mod,
+// and the modules in the workspace are not returned as libraries as they are resolved locally.
+func Parse(r io.Reader, fsys fs.FS, dir string) ([]Module, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("file read error: %w", err)
+	}
+
+	workFile, err := modfile.ParseWork("go.work", data, nil)
+	if err != nil {
+		return nil, xerrors.Errorf("go.work parse error: %w", err)
+	}
+
+	var modules []Module
+	var moduleDirs []string
+	for _, use := range workFile.Use {
+		// e.g. "work" + "../shared" => "shared"
+		moduleDir := path.Join(dir, filepath.ToSlash(use.Path))
+		if path.IsAbs(use.Path) || filepath.IsAbs(use.Path) || !fs.ValidPath(moduleDir) {
+			log.Logger.Debugf("Skipping the module outside the file system: %s", use.Path)
+			continue
+		}
+		goMod, err := fs.ReadFile(fsys, path.Join(moduleDir, "go.mod"))
+		if err != nil {
+			return nil, xerrors.Errorf("%s/go.mod read error: %w", use.Path, err)
+		}
+		modulePath := modfile.ModulePath(goMod)
+		if modulePath == "" {
+			return nil, xerrors.Errorf("%s/go.mod: module path is missing", use.Path)
+		}
+		modules = append(modules, Module{
+			Path: modulePath,
+			Dir:  path.Clean(filepath.ToSlash(use.Path)),
+		})
+		moduleDirs = append(moduleDirs, moduleDir)
+	}
+
+	// Modules in the workspace are replaced with the local directories.
+	replaces := append([]*modfile.Replace{}, workFile.Replace...)
+	for _, m := range modules {
+		replaces = append(replaces, &modfile.Replace{
+			Old: module.Version{Path: m.Path},
+			New: module.Version{Path: m.Dir},
+		})
+	}
+
+	for i, m := range modules {
+		libs, err := parseModule(fsys, moduleDirs[i], replaces)
+		if err != nil {
+			return nil, xerrors.Errorf("%s parse error: %w", m.Path, err)
+		}
+		sort.Slice(libs, func(i, j int) bool {
+			return libs[i].Name < libs[j].Name
+		})
+		modules[i].Libraries = libs
+	}
+	return modules, nil
+}
+
+func parseModule(fsys fs.FS, dir string, replaces []*modfile.Replace) ([]types.Library, error) {
+	goMod, err := fsys.Open(path.Join(dir, "go.mod"))
+	if err != nil {
+		return nil, xerrors.Errorf("file open error: %w", err)
+	}
+	defer goMod.Close()
+
+	opts := []mod.Option{mod.WithReplaces(replaces)}
+
+	// go.sum is optional.
+	goSum, err := fsys.Open(path.Join(dir, "go.sum"))
+	if err == nil {
+		defer goSum.Close()
+		opts = append(opts, mod.WithGoSum(goSum))
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, xerrors.Errorf("file open error: %w", err)
+	}
+
+	return mod.Parse(goMod, opts...)
+}
+
+// Libraries merges the libraries of all the modules.
+// A library is indirect only if it is indirect in all the modules.
+func Libraries(modules []Module) []types.Library {
+	var libs []types.Library
+	index := map[string]int{}
+	for _, m := range modules {
+		for _, lib := range m.Libraries {
+			id := utils.PackageID(lib.Name, lib.Version)
+			if i, ok := index[id]; ok {
+				libs[i].Indirect = libs[i].Indirect && lib.Indirect
+				continue
+			}
+			index[id] = len(libs)
+			libs = append(libs, lib)
+		}
+	}
+	sort.Slice(libs, func(i, j int) bool {
+		if libs[i].Name != libs[j].Name {
+			return libs[i].Name < libs[j].Name
+		}
+		return libs[i].Version < libs[j].Version
+	})
+	return libs
+}
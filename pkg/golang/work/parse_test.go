@@ -0,0 +1,66 @@
+package work
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		want     []Module
+		wantLibs []types.Library
+		wantErr  string
+	}{
+		{
+			name:     "workspace",
+			file:     "testdata/workspace/go.work",
+			want:     WorkspaceModules,
+			wantLibs: WorkspaceLibraries,
+		},
+		{
+			name:     "parent directory",
+			file:     "testdata/parent/work/go.work",
+			want:     ParentModules,
+			wantLibs: ParentLibraries,
+		},
+		{
+			name:    "missing module",
+			file:    "testdata/missing/go.work",
+			wantErr: "app/go.mod read error",
+		},
+		{
+			name:    "invalid",
+			file:    "testdata/invalid.work",
+			wantErr: "go.work parse error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			// Modules outside testdata are skipped.
+			dir := path.Dir(strings.TrimPrefix(tt.file, "testdata/"))
+			got, err := Parse(f, os.DirFS("testdata"), dir)
+			if tt.wantErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantLibs, Libraries(got))
+		})
+	}
+}
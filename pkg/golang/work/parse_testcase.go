@@ -0,0 +1,55 @@
+package work
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// go.work replaces golang.org/x/xerrors in both modules
+	WorkspaceModules = []Module{
+		{
+			Path: "example.com/app",
+			Dir:  "app",
+			Libraries: []types.Library{
+				{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.0-20220406074731-71021a481237", Indirect: false},
+				{Name: "golang.org/x/xerrors", Version: "0.0.0-20220907171357-04be3eba64a2", Indirect: true},
+				{Name: "gopkg.in/yaml.v3", Version: "3.0.0-20210107192922-496545a6307b", Indirect: true},
+			},
+		},
+		{
+			Path: "example.com/lib",
+			Dir:  "lib",
+			Libraries: []types.Library{
+				{Name: "golang.org/x/xerrors", Version: "0.0.0-20220907171357-04be3eba64a2", Indirect: false},
+				{Name: "gopkg.in/yaml.v3", Version: "3.0.0-20210107192922-496545a6307b", Indirect: false},
+			},
+		},
+	}
+
+	WorkspaceLibraries = []types.Library{
+		{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.0-20220406074731-71021a481237", Indirect: false},
+		{Name: "golang.org/x/xerrors", Version: "0.0.0-20220907171357-04be3eba64a2", Indirect: false},
+		{Name: "gopkg.in/yaml.v3", Version: "3.0.0-20210107192922-496545a6307b", Indirect: false},
+	}
+
+	// go.work uses the module in the parent directory and the one outside the file system is skipped.
+	ParentModules = []Module{
+		{
+			Path: "example.com/app",
+			Dir:  "app",
+			Libraries: []types.Library{
+				{Name: "github.com/pkg/errors", Version: "0.9.1", Indirect: false},
+			},
+		},
+		{
+			Path: "example.com/shared",
+			Dir:  "../shared",
+			Libraries: []types.Library{
+				{Name: "golang.org/x/xerrors", Version: "0.0.0-20200804184101-5ec99f83aff1", Indirect: false},
+			},
+		},
+	}
+
+	ParentLibraries = []types.Library{
+		{Name: "github.com/pkg/errors", Version: "0.9.1", Indirect: false},
+		{Name: "golang.org/x/xerrors", Version: "0.0.0-20200804184101-5ec99f83aff1", Indirect: false},
+	}
+)
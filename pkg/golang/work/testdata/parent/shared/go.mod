@@ -0,0 +1,5 @@
+module example.com/shared
+
+go 1.18
+
+require golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
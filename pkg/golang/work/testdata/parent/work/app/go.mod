@@ -0,0 +1,8 @@
+module example.com/app
+
+go 1.18
+
+require (
+	example.com/shared v0.0.0-00010101000000-000000000000
+	github.com/pkg/errors v0.9.1
+)
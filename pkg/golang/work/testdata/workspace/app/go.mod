@@ -0,0 +1,15 @@
+module example.com/app
+
+go 1.18
+
+require (
+	example.com/lib v0.0.0-00010101000000-000000000000
+	github.com/aquasecurity/go-dep-parser v0.0.0-20211224170007-df43bca6b6ff
+)
+
+require (
+	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b // indirect
+)
+
+replace github.com/aquasecurity/go-dep-parser => github.com/aquasecurity/go-dep-parser v0.0.0-20220406074731-71021a481237
@@ -0,0 +1,10 @@
+module example.com/lib
+
+go 1.18
+
+require (
+	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
+	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b
+)
+
+replace golang.org/x/xerrors => ../xerrors
@@ -5,6 +5,8 @@ import (
 	"os"
 	"regexp"
 	"strings"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
 )
 
 var (
@@ -17,6 +19,11 @@ type artifact struct {
 	Version    version
 	Module     bool
 	Exclusions map[string]struct{}
+	// Scope is the declared scope until it is enqueued, and then the effective scope. It is empty for the root.
+	Scope    string
+	Optional bool
+	// Path is the IDs of the artifacts from the root to the requester. It is empty for the root.
+	Path []string
 }
 
 func newArtifact(groupID, artifactID, version string, props map[string]string) artifact {
@@ -55,30 +62,59 @@ func (a artifact) String() string {
 	return fmt.Sprintf("%s:%s", a.Name(), a.Version)
 }
 
+// Depth returns the depth in the dependency tree. Direct dependencies are 1.
+func (a artifact) Depth() int {
+	return len(a.Path)
+}
+
+func (a artifact) request() Request {
+	return Request{
+		Version: a.Version.String(),
+		Depth:   a.Depth(),
+		Scope:   a.Scope,
+		Path:    a.Path,
+	}
+}
+
 type version struct {
 	ver  string
 	hard bool
+	// rng is the version range to be resolved with maven-metadata.xml. e.g. "[1.0,2.0)"
+	rng versionRange
 }
 
-// Only soft and hard requirements for the specified version are supported at the moment.
+// newVersion parses soft requirements, hard requirements and version ranges.
+// ref. https://maven.apache.org/pom.html#dependency-version-requirement-specification
 func newVersion(s string) version {
-	var hard bool
-	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
-		s = strings.Trim(s, "[]")
-		hard = true
+	if !isVersionRange(s) {
+		return version{ver: s}
 	}
 
-	// TODO: Other requirements are not supported
-	if strings.ContainsAny(s, ",()[]") {
-		s = ""
+	r, err := parseVersionRange(s)
+	if err != nil {
+		log.Logger.Debugf("Invalid version requirement: %s", err)
+		return version{}
 	}
 
+	// Hard requirement for the specified version. e.g. [1.0]
+	if len(r) == 1 && r[0].lower == r[0].upper && r[0].lowerInclusive && r[0].upperInclusive {
+		return version{
+			ver:  r[0].lower,
+			hard: true,
+		}
+	}
+
+	// Ranges are also hard requirements, but the version is not determined until it is resolved.
 	return version{
-		ver:  s,
-		hard: hard,
+		hard: true,
+		rng:  r,
 	}
 }
 
+func (v1 version) unresolved() bool {
+	return v1.ver == "" && v1.rng != nil
+}
+
 func (v1 version) shouldOverride(v2 version) bool {
 	if !v1.hard && v2.hard {
 		return true
@@ -0,0 +1,73 @@
+package pom
+
+import "sort"
+
+// Request is a version of an artifact requested in the dependency tree.
+type Request struct {
+	Version string
+	// Depth is the depth in the dependency tree. Direct dependencies are 1.
+	Depth int
+	// Scope is the scope in the tree, or the declared scope if the dependency is not transitive.
+	// e.g. test dependencies of dependencies
+	Scope string
+	// Path is the IDs of the artifacts from the root to the requester.
+	// e.g. ["com.example:app:1.0.0", "org.example:example-dependency:1.2.3"]
+	Path []string
+}
+
+// Conflict is an artifact requested with different versions in the dependency tree,
+// like "omitted for conflict" in "mvn dependency:tree -Dverbose".
+// Requests which are not transitive or not in the scopes given by WithScopes are also included.
+type Conflict struct {
+	// Name is "groupId:artifactId".
+	Name   string
+	Winner Request
+	// Requests are all the requests including the winner in the order of the mediation.
+	Requests []Request
+}
+
+// mediation records the requested versions of artifacts.
+// The nearest version wins, and the first declaration wins at the same depth.
+// Hard requirements such as "[1.0]" take precedence over soft requirements.
+// ref. https://maven.apache.org/guides/introduction/introduction-to-dependency-mechanism.html#transitive-dependencies
+type mediation struct {
+	requests map[string][]Request
+	winners  map[string]Request
+}
+
+func newMediation() *mediation {
+	return &mediation{
+		requests: map[string][]Request{},
+		winners:  map[string]Request{},
+	}
+}
+
+func (m *mediation) request(art artifact) {
+	m.requests[art.Name()] = append(m.requests[art.Name()], art.request())
+}
+
+func (m *mediation) win(art artifact) {
+	m.winners[art.Name()] = art.request()
+}
+
+// conflicts returns the artifacts requested with different versions sorted by name.
+func (m *mediation) conflicts() []Conflict {
+	var conflicts []Conflict
+	for name, winner := range m.winners {
+		requests := m.requests[name]
+		for _, r := range requests {
+			if r.Version != winner.Version {
+				conflicts = append(conflicts, Conflict{
+					Name:     name,
+					Winner:   winner,
+					Requests: requests,
+				})
+				break
+			}
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Name < conflicts[j].Name
+	})
+	return conflicts
+}
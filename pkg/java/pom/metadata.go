@@ -0,0 +1,31 @@
+package pom
+
+import (
+	"encoding/xml"
+	"io"
+
+	"golang.org/x/net/html/charset"
+	"golang.org/x/xerrors"
+)
+
+// metadata is maven-metadata.xml at the artifact level.
+// ref. https://maven.apache.org/ref/3.8.6/maven-repository-metadata/repository-metadata.html
+type metadata struct {
+	GroupID    string `xml:"groupId"`
+	ArtifactID string `xml:"artifactId"`
+	Versioning struct {
+		Latest   string   `xml:"latest"`
+		Release  string   `xml:"release"`
+		Versions []string `xml:"versions>version"`
+	} `xml:"versioning"`
+}
+
+func parseMetadata(r io.Reader) (*metadata, error) {
+	parsed := &metadata{}
+	decoder := xml.NewDecoder(r)
+	decoder.CharsetReader = charset.NewReaderLabel
+	if err := decoder.Decode(parsed); err != nil {
+		return nil, xerrors.Errorf("xml decode error: %w", err)
+	}
+	return parsed, nil
+}
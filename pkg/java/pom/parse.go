@@ -4,11 +4,11 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
-	"net/url"
 	"os"
-	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -25,8 +25,15 @@ const (
 )
 
 type options struct {
-	offline     bool
-	remoteRepos []string
+	offline          bool
+	remoteRepos      []string
+	conflictHandler  func(Conflict)
+	scopes           []string
+	optional         bool
+	activeProfiles   []string
+	inactiveProfiles []string
+	properties       map[string]string
+	fsys             fs.FS
 }
 
 type option func(*options)
@@ -43,18 +50,86 @@ func WithRemoteRepos(repos []string) option {
 	}
 }
 
+// WithScopes includes dependencies in the given scopes in addition to compile scope.
+// e.g. "runtime", "provided", "test" and "system"
+func WithScopes(scopes []string) option {
+	return func(opts *options) {
+		opts.scopes = scopes
+	}
+}
+
+// WithOptional includes optional dependencies of the root.
+// Transitive optional dependencies are not included as Maven doesn't include them.
+func WithOptional(optional bool) option {
+	return func(opts *options) {
+		opts.optional = optional
+	}
+}
+
+// WithActiveProfiles activates the profiles explicitly like "mvn -P".
+func WithActiveProfiles(ids []string) option {
+	return func(opts *options) {
+		opts.activeProfiles = ids
+	}
+}
+
+// WithInactiveProfiles deactivates the profiles explicitly like "mvn -P !id".
+func WithInactiveProfiles(ids []string) option {
+	return func(opts *options) {
+		opts.inactiveProfiles = ids
+	}
+}
+
+// WithProperties sets the user properties like "mvn -Dname=value".
+// They are used to activate profiles with <property> in addition to environment variables.
+func WithProperties(props map[string]string) option {
+	return func(opts *options) {
+		opts.properties = props
+	}
+}
+
+// WithFS reads the POM and related files such as parent POMs and modules from the file system.
+// The file path given to NewParser must be relative to the root of the file system.
+// The local repository is always read from the local disk, which is also used for the others if fsys is nil.
+func WithFS(fsys fs.FS) option {
+	return func(opts *options) {
+		opts.fsys = fsys
+	}
+}
+
+// WithConflictHandler sets a handler called for each artifact requested with different versions.
+// Conflicts are logged by default.
+func WithConflictHandler(handler func(Conflict)) option {
+	return func(opts *options) {
+		opts.conflictHandler = handler
+	}
+}
+
 type parser struct {
 	rootPath           string
 	cache              pomCache
 	localRepository    string
-	remoteRepositories []string
+	remoteRepositories []remoteRepository
 	offline            bool
+	conflictHandler    func(Conflict)
+	scopes             map[string]struct{}
+	optional           bool
+	activation         activationEnv
+	settings           settings
+	settingsProfiles   []pomProfile
+	trustedURLs        map[string]struct{}
+	client             *http.Client
+	fsys               fs.FS
+
+	// "groupId:artifactId" => the versions in maven-metadata.xml
+	versions map[string][]string
 }
 
 func NewParser(filePath string, opts ...option) *parser {
 	o := &options{
-		offline:     false,
-		remoteRepos: []string{centralURL},
+		offline:         false,
+		remoteRepos:     []string{centralURL},
+		conflictHandler: logConflict,
 	}
 
 	for _, opt := range opts {
@@ -68,19 +143,53 @@ func NewParser(filePath string, opts ...option) *parser {
 		localRepository = filepath.Join(homeDir, ".m2", "repository")
 	}
 
+	// "central" is the ID of Maven Central, which is often used in <mirrorOf>.
+	var remoteRepos []remoteRepository
+	for _, repoURL := range o.remoteRepos {
+		repo := remoteRepository{URL: repoURL}
+		if repoURL == centralURL {
+			repo.ID = "central"
+		}
+		remoteRepos = append(remoteRepos, repo)
+	}
+
+	// Profiles in <activeProfiles> of settings.xml are activated in POMs as well.
+	activeProfiles := append(append([]string{}, o.activeProfiles...), s.ActiveProfiles...)
+	activation := newActivationEnv(activeProfiles, o.inactiveProfiles)
+	activation.properties = o.properties
+	activation.fsys = o.fsys
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = s.proxyFunc()
+
+	scopes := map[string]struct{}{scopeCompile: {}}
+	for _, scope := range o.scopes {
+		scopes[scope] = struct{}{}
+	}
+
 	return &parser{
 		rootPath:           filepath.Clean(filePath),
 		cache:              newPOMCache(),
 		localRepository:    localRepository,
-		remoteRepositories: o.remoteRepos,
+		remoteRepositories: remoteRepos,
 		offline:            o.offline,
+		conflictHandler:    o.conflictHandler,
+		scopes:             scopes,
+		optional:           o.optional,
+		activation:         activation,
+		settings:           s,
+		settingsProfiles:   activation.activate(s.Profiles, ""),
+		trustedURLs:        trustedURLs(o.remoteRepos, s),
+		client:             &http.Client{Transport: transport},
+		fsys:               o.fsys,
+		versions:           map[string][]string{},
 	}
 }
 
-func (p *parser) Parse(r io.Reader) ([]types.Library, error) {
+func (p *parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
 	content, err := parsePom(r)
 	if err != nil {
-		return nil, xerrors.Errorf("failed to parse POM: %w", err)
+		return nil, nil, xerrors.Errorf("failed to parse POM: %w", err)
 	}
 
 	root := &pom{
@@ -91,7 +200,7 @@ func (p *parser) Parse(r io.Reader) ([]types.Library, error) {
 	// Analyze root POM
 	result, err := p.analyze(root, nil)
 	if err != nil {
-		return nil, xerrors.Errorf("analyze error (%s): %w", p.rootPath, err)
+		return nil, nil, xerrors.Errorf("analyze error (%s): %w", p.rootPath, err)
 	}
 
 	// Cache root POM
@@ -100,7 +209,7 @@ func (p *parser) Parse(r io.Reader) ([]types.Library, error) {
 	return p.parseRoot(root.artifact())
 }
 
-func (p *parser) parseRoot(root artifact) ([]types.Library, error) {
+func (p *parser) parseRoot(root artifact) ([]types.Library, []types.Dependency, error) {
 	// Prepare a queue for dependencies
 	queue := newArtifactQueue()
 
@@ -109,23 +218,41 @@ func (p *parser) parseRoot(root artifact) ([]types.Library, error) {
 	queue.enqueue(root)
 
 	var libs []types.Library
+	var deps []types.Dependency
 	uniqArtifacts := map[string]version{}
 
-	// Iterate direct and transitive dependencies
+	// "groupId:artifactId" => the direct dependencies with the declared scopes
+	children := map[string][]artifact{}
+
+	// Record all the requested versions to report conflicts
+	med := newMediation()
+
+	// Iterate direct and transitive dependencies.
+	// Dependencies in all the scopes take part in the mediation, and then they are filtered by the scopes as Maven does.
 	for !queue.IsEmpty() {
 		art := queue.dequeue()
 
 		// Modules should be handled separately so that they can have independent dependencies.
 		// It means multi-module allows for duplicate dependencies.
 		if art.Module {
-			moduleLibs, err := p.parseRoot(art)
+			moduleLibs, moduleDeps, err := p.parseRoot(art)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			libs = append(libs, moduleLibs...)
+			deps = append(deps, moduleDeps...)
 			continue
 		}
 
+		// Version ranges are resolved with maven-metadata.xml before comparing versions.
+		if art.Version.unresolved() {
+			art.Version = p.resolveVersionRange(art)
+		}
+
+		if !art.IsEmpty() {
+			med.request(art)
+		}
+
 		// For soft requirements, skip dependency resolution that has already been resolved.
 		if v, ok := uniqArtifacts[art.Name()]; ok {
 			if !v.shouldOverride(art.Version) {
@@ -135,38 +262,158 @@ func (p *parser) parseRoot(root artifact) ([]types.Library, error) {
 
 		result, err := p.resolve(art)
 		if err != nil {
-			return nil, xerrors.Errorf("resolve error (%s): %w", art, err)
+			return nil, nil, xerrors.Errorf("resolve error (%s): %w", art, err)
 		}
 
 		// Parse, cache, and enqueue modules.
 		for _, relativePath := range result.modules {
 			moduleArtifact, err := p.parseModule(result.filePath, relativePath)
 			if err != nil {
-				return nil, xerrors.Errorf("module error (%s): %w", relativePath, err)
+				return nil, nil, xerrors.Errorf("module error (%s): %w", relativePath, err)
 			}
 
 			queue.enqueue(moduleArtifact)
 		}
 
-		// Resolve transitive dependencies later
-		queue.enqueue(result.dependencies...)
+		// Resolve transitive dependencies later.
+		// BFS visits nearer dependencies first, and dependencies at the same depth in the declaration order.
+		path := append(append([]string{}, art.Path...), art.String())
+		var transitive []artifact
+		for _, d := range result.dependencies {
+			scope, ok := effectiveScope(art, d)
+			if !ok {
+				// Record the request to explain why the version is not selected.
+				d.Path = path
+				if !d.IsEmpty() {
+					med.request(d)
+				}
+				continue
+			}
+			transitive = append(transitive, d)
+
+			d.Scope = scope
+			d.Path = path
+			queue.enqueue(d)
+		}
 
 		// Offline mode may be missing some fields.
 		if !art.IsEmpty() {
 			// Override the version
 			uniqArtifacts[art.Name()] = art.Version
+			med.win(art)
+
+			// Override the dependencies as well since they depend on the version
+			children[art.Name()] = transitive
+		} else if art.Depth() == 0 {
+			// The scopes of the direct dependencies are needed even if the root misses some fields.
+			children[art.Name()] = transitive
 		}
 	}
 
-	// Convert to []types.Library
+	// The scopes are derived from the root after the mediation so that they reflect the selected versions.
+	scopes, optional := finalScopes(root.Name(), children)
+	included := func(name string) bool {
+		if name == root.Name() {
+			return true
+		}
+		if _, ok := p.scopes[scopes[name]]; !ok {
+			return false
+		}
+		return !optional[name] || p.optional
+	}
+
+	// Convert to []types.Library and []types.Dependency
 	for name, ver := range uniqArtifacts {
-		libs = append(libs, types.Library{
+		if !included(name) {
+			continue
+		}
+		lib := types.Library{
+			ID:      packageID(name, ver.String()),
 			Name:    name,
 			Version: ver.String(),
+			Scope:   scopes[name],
+		}
+		libs = append(libs, lib)
+
+		// Dependencies are resolved to the versions selected in this tree.
+		var ids []string
+		for _, child := range children[name] {
+			depVer, ok := uniqArtifacts[child.Name()]
+			if !ok || !included(child.Name()) {
+				continue
+			}
+			ids = append(ids, packageID(child.Name(), depVer.String()))
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		sort.Strings(ids)
+		deps = append(deps, types.Dependency{
+			ID:        lib.ID,
+			DependsOn: utils.UniqueStrings(ids),
 		})
 	}
 
-	return libs, nil
+	for _, c := range med.conflicts() {
+		p.conflictHandler(c)
+	}
+
+	return libs, deps, nil
+}
+
+// effectiveScope returns the scope of the dependency in the tree.
+// It returns false if the dependency is not transitive, such as optional and test dependencies of dependencies.
+func effectiveScope(parent, dep artifact) (string, bool) {
+	// Direct dependencies of the root
+	if parent.Depth() == 0 {
+		return dep.Scope, true
+	}
+	if dep.Optional {
+		return "", false
+	}
+	scope := deriveScope(parent.Scope, dep.Scope)
+	return scope, scope != ""
+}
+
+// finalScopes returns the scopes of the selected artifacts and whether they are reachable only via optional
+// dependencies of the root. The scope of direct dependencies is respected, otherwise the widest scope derived
+// from the requesters is selected. Requests of the versions not selected are also taken into account as Maven does.
+func finalScopes(rootName string, children map[string][]artifact) (map[string]string, map[string]bool) {
+	scopes := map[string]string{}
+	optional := map[string]bool{}
+	for _, d := range children[rootName] {
+		scopes[d.Name()] = d.Scope
+		optional[d.Name()] = d.Optional
+	}
+	direct := map[string]struct{}{}
+	for name := range scopes {
+		direct[name] = struct{}{}
+	}
+
+	// Scopes only get wider, so the loop ends.
+	for changed := true; changed; {
+		changed = false
+		for parent, deps := range children {
+			parentScope, ok := scopes[parent]
+			if !ok || parent == rootName {
+				continue
+			}
+			for _, d := range deps {
+				name := d.Name()
+				if _, ok := direct[name]; ok || name == rootName {
+					continue
+				}
+				scope := widerScope(scopes[name], deriveScope(parentScope, d.Scope))
+				opt, ok := optional[name]
+				opt = optional[parent] && (opt || !ok)
+				if _, ok := scopes[name]; !ok || scope != scopes[name] || opt != optional[name] {
+					scopes[name], optional[name] = scope, opt
+					changed = true
+				}
+			}
+		}
+	}
+	return scopes, optional
 }
 
 func (p *parser) parseModule(currentPath, relativePath string) (artifact, error) {
@@ -223,8 +470,11 @@ func (p *parser) analyze(pom *pom, exclusions map[string]struct{}) (analysisResu
 		return analysisResult{}, nil
 	}
 
+	// Inject the active profiles
+	pom.applyProfiles(p.activation, p.settingsProfiles)
+
 	// Update remoteRepositories
-	p.remoteRepositories = utils.UniqueStrings(append(p.remoteRepositories, pom.repositories()...))
+	p.remoteRepositories = mergeRepositories(p.remoteRepositories, pom.repositories()...)
 
 	// Parent
 	parent, err := p.parseParent(pom.filePath, pom.content.Parent)
@@ -291,11 +541,8 @@ func (p parser) parseDependencies(deps []pomDependency, props map[string]string,
 	var dependencies []artifact
 	for _, d := range deps {
 		// Resolve dependencies
+		// Scopes and optional dependencies are filtered when the tree is built since they depend on the depth.
 		d = d.Resolve(props, depManagement)
-
-		if (d.Scope != "" && d.Scope != "compile") || d.Optional {
-			continue
-		}
 		dependencies = append(dependencies, d.ToArtifact(exclusions))
 	}
 	return dependencies
@@ -305,7 +552,8 @@ func (p parser) mergeDependencies(parent, child []artifact, exclusions map[strin
 	var deps []artifact
 	unique := map[string]struct{}{}
 
-	for _, d := range append(parent, child...) {
+	// Child dependencies come first and parent dependencies follow as Maven inherits them.
+	for _, d := range append(child, parent...) {
 		if _, ok := exclusions[d.Name()]; ok {
 			continue
 		}
@@ -404,28 +652,54 @@ func (p parser) openRelativePom(currentPath, relativePath string) (*pom, error)
 	// e.g. child + ../parent => parent/
 	filePath := filepath.Join(dir, relativePath)
 
-	isDir, err := isDirectory(filePath)
+	fileInfo, err := p.stat(filePath)
 	if err != nil {
 		return nil, err
-	} else if isDir {
+	} else if fileInfo.IsDir() {
 		// e.g. parent/ => parent/pom.xml
 		filePath = filepath.Join(filePath, "pom.xml")
 	}
 
-	pom, err := p.openPom(filePath)
+	f, err := p.open(filePath)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to open %s: %w", filePath, err)
+	}
+	defer f.Close()
+
+	pom, err := readPom(filePath, f)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to open %s: %w", filePath, err)
 	}
 	return pom, nil
 }
 
+// open opens a file of the project. It is read from the file system given by WithFS if any.
+func (p parser) open(filePath string) (fs.File, error) {
+	if p.fsys != nil {
+		return p.fsys.Open(filepath.ToSlash(filePath))
+	}
+	return os.Open(filePath)
+}
+
+func (p parser) stat(filePath string) (fs.FileInfo, error) {
+	if p.fsys != nil {
+		return fs.Stat(p.fsys, filepath.ToSlash(filePath))
+	}
+	return os.Stat(filePath)
+}
+
 func (p parser) openPom(filePath string) (*pom, error) {
 	f, err := os.Open(filePath)
 	if err != nil {
 		return nil, xerrors.Errorf("file open error (%s): %w", filePath, err)
 	}
+	defer f.Close()
 
-	content, err := parsePom(f)
+	return readPom(filePath, f)
+}
+
+func readPom(filePath string, r io.Reader) (*pom, error) {
+	content, err := parsePom(r)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to parse the local POM: %w", err)
 	}
@@ -434,6 +708,7 @@ func (p parser) openPom(filePath string) (*pom, error) {
 		content:  content,
 	}, nil
 }
+
 func (p parser) tryRepository(groupID, artifactID, version string) (*pom, error) {
 	// Generate a proper path to the pom.xml
 	// e.g. com.fasterxml.jackson.core, jackson-annotations, 2.10.0
@@ -472,21 +747,15 @@ func (p parser) fetchPOMFromRemoteRepository(paths []string) (*pom, error) {
 	}
 
 	// try all remoteRepositories
-	for _, repo := range p.remoteRepositories {
-		repoURL, err := url.Parse(repo)
+	for _, repo := range p.repositories() {
+		resp, err := p.httpGet(repo, paths)
 		if err != nil {
-			continue
-		}
-
-		paths = append([]string{repoURL.Path}, paths...)
-		repoURL.Path = path.Join(paths...)
-
-		resp, err := http.Get(repoURL.String())
-		if err != nil || resp.StatusCode != http.StatusOK {
+			log.Logger.Debug(err)
 			continue
 		}
 
 		content, err := parsePom(resp.Body)
+		_ = resp.Body.Close()
 		if err != nil {
 			return nil, xerrors.Errorf("failed to parse the remote POM: %w", err)
 		}
@@ -499,6 +768,97 @@ func (p parser) fetchPOMFromRemoteRepository(paths []string) (*pom, error) {
 	return nil, xerrors.Errorf("the POM was not found in remote remoteRepositories")
 }
 
+// resolveVersionRange returns the highest version in the range among the versions available in the repositories.
+// The version remains unresolved if no version satisfies the range.
+func (p parser) resolveVersionRange(art artifact) version {
+	versions := p.fetchVersions(art.GroupID, art.ArtifactID)
+	ver, ok := art.Version.rng.highest(versions)
+	if !ok {
+		log.Logger.Debugf("No version of %s satisfies the version range", art.Name())
+		return art.Version
+	}
+	return version{
+		ver:  ver,
+		hard: true,
+	}
+}
+
+// fetchVersions returns the versions listed in maven-metadata.xml of the local and remote repositories.
+// The versions are cached per artifact since the same artifact is often requested with ranges several times.
+func (p parser) fetchVersions(groupID, artifactID string) []string {
+	name := groupID + ":" + artifactID
+	if versions, ok := p.versions[name]; ok {
+		return versions
+	}
+
+	// e.g. com.fasterxml.jackson.core, jackson-annotations => com/fasterxml/jackson/core/jackson-annotations
+	paths := append(strings.Split(groupID, "."), artifactID)
+
+	var versions []string
+	for _, m := range append(p.loadMetadataFromLocalRepository(paths), p.fetchMetadataFromRemoteRepositories(paths)...) {
+		versions = append(versions, m.Versioning.Versions...)
+	}
+	versions = utils.UniqueStrings(versions)
+	p.versions[name] = versions
+	return versions
+}
+
+func (p parser) loadMetadataFromLocalRepository(paths []string) []*metadata {
+	// The local repository has the metadata per remote repository. e.g. maven-metadata-central.xml
+	dir := filepath.Join(append([]string{p.localRepository}, paths...)...)
+	files, err := filepath.Glob(filepath.Join(dir, "maven-metadata*.xml"))
+	if err != nil {
+		return nil
+	}
+
+	var metadataList []*metadata
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			log.Logger.Debugf("file open error (%s): %s", file, err)
+			continue
+		}
+		m, err := parseMetadata(f)
+		_ = f.Close()
+		if err != nil {
+			log.Logger.Debugf("failed to parse %s: %s", file, err)
+			continue
+		}
+		metadataList = append(metadataList, m)
+	}
+	return metadataList
+}
+
+func (p parser) fetchMetadataFromRemoteRepositories(paths []string) []*metadata {
+	// Do not try fetching maven-metadata.xml from remote repositories in offline mode
+	if p.offline {
+		log.Logger.Debug("Fetching the remote maven-metadata.xml is skipped")
+		return nil
+	}
+
+	var metadataList []*metadata
+	for _, repo := range p.repositories() {
+		resp, err := p.httpGet(repo, append(paths, "maven-metadata.xml"))
+		if err != nil {
+			log.Logger.Debug(err)
+			continue
+		}
+
+		m, err := parseMetadata(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			log.Logger.Debugf("failed to parse maven-metadata.xml in %s: %s", repo.URL, err)
+			continue
+		}
+		metadataList = append(metadataList, m)
+	}
+	return metadataList
+}
+
+func logConflict(c Conflict) {
+	log.Logger.Debugf("%s:%s was selected among %d requests", c.Name, c.Winner.Version, len(c.Requests))
+}
+
 func parsePom(r io.Reader) (*pomXML, error) {
 	parsed := &pomXML{}
 	decoder := xml.NewDecoder(r)
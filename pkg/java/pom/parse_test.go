@@ -17,12 +17,18 @@ import (
 
 func TestPom_Parse(t *testing.T) {
 	tests := []struct {
-		name      string
-		inputFile string
-		local     bool
-		offline   bool
-		want      []types.Library
-		wantErr   string
+		name             string
+		inputFile        string
+		local            bool
+		offline          bool
+		scopes           []string
+		optional         bool
+		activeProfiles   []string
+		inactiveProfiles []string
+		properties       map[string]string
+		want             []types.Library
+		wantDeps         []types.Dependency
+		wantErr          string
 	}{
 		{
 			name:      "local repository",
@@ -30,12 +36,23 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:happy:1.0.0",
 					Name:    "com.example:happy",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:happy:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -45,12 +62,23 @@ func TestPom_Parse(t *testing.T) {
 			local:     false,
 			want: []types.Library{
 				{
+					ID:      "com.example:happy:1.0.0",
 					Name:    "com.example:happy",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:happy:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -61,8 +89,10 @@ func TestPom_Parse(t *testing.T) {
 			offline:   true,
 			want: []types.Library{
 				{
+					ID:      "org.example:example-offline:2.3.4",
 					Name:    "org.example:example-offline",
 					Version: "2.3.4",
+					Scope:   "compile",
 				},
 			},
 		},
@@ -72,12 +102,23 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:child:1.0.0",
 					Name:    "com.example:child",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:child:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -87,12 +128,74 @@ func TestPom_Parse(t *testing.T) {
 			local:     false,
 			want: []types.Library{
 				{
+					ID:      "com.example:child:1.0.0-SNAPSHOT",
 					Name:    "com.example:child",
 					Version: "1.0.0-SNAPSHOT",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:child:1.0.0-SNAPSHOT",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+			},
+		},
+		{
+			name:      "inherit parent dependencies in the declaration order",
+			inputFile: filepath.Join("testdata", "parent-dependency-order", "child", "pom.xml"),
+			local:     true,
+			want: []types.Library{
+				{
+					ID:      "com.example:child:1.0.0",
+					Name:    "com.example:child",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:2.0.0",
+					Name:    "org.example:example-api",
+					Version: "2.0.0",
+					Scope:   "compile",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "compile",
+				},
+				{
+					ID:      "org.example:example-dependency2:2.3.4",
+					Name:    "org.example:example-dependency2",
+					Version: "2.3.4",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:child:1.0.0",
+					DependsOn: []string{
+						"org.example:example-dependency2:2.3.4",
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency2:2.3.4",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+					},
 				},
 			},
 		},
@@ -102,12 +205,23 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:child:3.0.0",
 					Name:    "com.example:child",
 					Version: "3.0.0",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:child:3.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -117,12 +231,23 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:child:1.0.0",
 					Name:    "com.example:child",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:child:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -132,12 +257,23 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "org.example:child:1.0.0",
 					Name:    "org.example:child",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "org.example:child:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -147,16 +283,36 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:soft:1.0.0",
 					Name:    "com.example:soft",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
 				},
 				{
+					ID:      "org.example:example-dependency:1.2.3",
 					Name:    "org.example:example-dependency",
 					Version: "1.2.3",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:soft:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -166,20 +322,48 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:soft-transitive:1.0.0",
 					Name:    "com.example:soft-transitive",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "org.example:example-api:2.0.0",
 					Name:    "org.example:example-api",
 					Version: "2.0.0",
+					Scope:   "compile",
 				},
 				{
+					ID:      "org.example:example-dependency:1.2.3",
 					Name:    "org.example:example-dependency",
 					Version: "1.2.3",
+					Scope:   "compile",
 				},
 				{
+					ID:      "org.example:example-dependency2:2.3.4",
 					Name:    "org.example:example-dependency2",
 					Version: "2.3.4",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:soft-transitive:1.0.0",
+					DependsOn: []string{
+						"org.example:example-dependency2:2.3.4",
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency2:2.3.4",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+					},
 				},
 			},
 		},
@@ -189,16 +373,36 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:hard:1.0.0",
 					Name:    "com.example:hard",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "org.example:example-api:2.0.0",
 					Name:    "org.example:example-api",
 					Version: "2.0.0",
+					Scope:   "compile",
 				},
 				{
+					ID:      "org.example:example-dependency:1.2.4",
 					Name:    "org.example:example-dependency",
 					Version: "1.2.4",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:hard:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+						"org.example:example-dependency:1.2.4",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.4",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+					},
 				},
 			},
 		},
@@ -208,23 +412,496 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:hard:1.0.0",
 					Name:    "com.example:hard",
 					Version: "1.0.0",
 				},
 			},
 		},
+		{
+			name:      "version range with local repository",
+			inputFile: filepath.Join("testdata", "version-range", "pom.xml"),
+			local:     true,
+			want: []types.Library{
+				{
+					ID:      "com.example:version-range:1.0.0",
+					Name:    "com.example:version-range",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:1.7.30",
+					Name:    "org.example:example-api",
+					Version: "1.7.30",
+					Scope:   "compile",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:version-range:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+			},
+		},
+		{
+			name:      "version range with remote repository",
+			inputFile: filepath.Join("testdata", "version-range", "pom.xml"),
+			want: []types.Library{
+				{
+					ID:      "com.example:version-range:1.0.0",
+					Name:    "com.example:version-range",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:1.7.30",
+					Name:    "org.example:example-api",
+					Version: "1.7.30",
+					Scope:   "compile",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:version-range:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+			},
+		},
+		{
+			name:      "compile scope only",
+			inputFile: filepath.Join("testdata", "scopes", "pom.xml"),
+			local:     true,
+			want: []types.Library{
+				{
+					ID:      "com.example:scopes:1.0.0",
+					Name:    "com.example:scopes",
+					Version: "1.0.0",
+				},
+			},
+		},
+		{
+			name:      "runtime scope",
+			inputFile: filepath.Join("testdata", "scopes", "pom.xml"),
+			local:     true,
+			scopes:    []string{"runtime"},
+			// example-api:1.7.30 requested by the test dependency is nearer, and the scope is widened to runtime.
+			want: []types.Library{
+				{
+					ID:      "com.example:scopes:1.0.0",
+					Name:    "com.example:scopes",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:1.7.30",
+					Name:    "org.example:example-api",
+					Version: "1.7.30",
+					Scope:   "runtime",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "runtime",
+				},
+				{
+					ID:      "org.example:example-nested:3.3.3",
+					Name:    "org.example:example-nested",
+					Version: "3.3.3",
+					Scope:   "runtime",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:scopes:1.0.0",
+					DependsOn: []string{
+						"org.example:example-nested:3.3.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+				{
+					ID: "org.example:example-nested:3.3.3",
+					DependsOn: []string{
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+			},
+		},
+		{
+			name:      "runtime and test scopes with optional dependencies",
+			inputFile: filepath.Join("testdata", "scopes", "pom.xml"),
+			local:     true,
+			scopes:    []string{"runtime", "test"},
+			optional:  true,
+			want: []types.Library{
+				{
+					ID:      "com.example:scopes:1.0.0",
+					Name:    "com.example:scopes",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:1.7.30",
+					Name:    "org.example:example-api",
+					Version: "1.7.30",
+					Scope:   "runtime",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "runtime",
+				},
+				{
+					ID:      "org.example:example-dependency-management:2.2.2",
+					Name:    "org.example:example-dependency-management",
+					Version: "2.2.2",
+					Scope:   "compile",
+				},
+				{
+					ID:      "org.example:example-dependency2:2.3.4",
+					Name:    "org.example:example-dependency2",
+					Version: "2.3.4",
+					Scope:   "test",
+				},
+				{
+					ID:      "org.example:example-nested:3.3.3",
+					Name:    "org.example:example-nested",
+					Version: "3.3.3",
+					Scope:   "runtime",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:scopes:1.0.0",
+					DependsOn: []string{
+						"org.example:example-dependency-management:2.2.2",
+						"org.example:example-dependency2:2.3.4",
+						"org.example:example-nested:3.3.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency2:2.3.4",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+				{
+					ID: "org.example:example-nested:3.3.3",
+					DependsOn: []string{
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+			},
+		},
+		{
+			name:      "direct dependency in test scope wins",
+			inputFile: filepath.Join("testdata", "scopes-mediation", "pom.xml"),
+			local:     true,
+			want: []types.Library{
+				{
+					ID:      "com.example:scopes-mediation:1.0.0",
+					Name:    "com.example:scopes-mediation",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:scopes-mediation:1.0.0",
+					DependsOn: []string{
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+			},
+		},
+		{
+			name:      "direct dependency in test scope wins with test scope",
+			inputFile: filepath.Join("testdata", "scopes-mediation", "pom.xml"),
+			local:     true,
+			scopes:    []string{"test"},
+			want: []types.Library{
+				{
+					ID:      "com.example:scopes-mediation:1.0.0",
+					Name:    "com.example:scopes-mediation",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:1.7.30",
+					Name:    "org.example:example-api",
+					Version: "1.7.30",
+					Scope:   "test",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:scopes-mediation:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+			},
+		},
+		{
+			name:      "profiles activated by file",
+			inputFile: filepath.Join("testdata", "profiles", "pom.xml"),
+			local:     true,
+			want: []types.Library{
+				{
+					ID:      "com.example:profiles:1.0.0",
+					Name:    "com.example:profiles",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:2.0.0",
+					Name:    "org.example:example-api",
+					Version: "2.0.0",
+					Scope:   "compile",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:profiles:1.0.0",
+					DependsOn: []string{
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+					},
+				},
+			},
+		},
+		{
+			name:             "profiles activated by default",
+			inputFile:        filepath.Join("testdata", "profiles", "pom.xml"),
+			local:            true,
+			inactiveProfiles: []string{"file"},
+			want: []types.Library{
+				{
+					ID:      "com.example:profiles:1.0.0",
+					Name:    "com.example:profiles",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:1.7.30",
+					Name:    "org.example:example-api",
+					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:profiles:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+			},
+		},
+		{
+			name:       "profiles activated by user property",
+			inputFile:  filepath.Join("testdata", "profiles-property", "pom.xml"),
+			local:      true,
+			properties: map[string]string{"release": "true"},
+			want: []types.Library{
+				{
+					ID:      "com.example:profiles-property:1.0.0",
+					Name:    "com.example:profiles-property",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:1.7.30",
+					Name:    "org.example:example-api",
+					Version: "1.7.30",
+					Scope:   "compile",
+				},
+				{
+					ID:      "org.example:example-dependency2:2.3.4",
+					Name:    "org.example:example-dependency2",
+					Version: "2.3.4",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:profiles-property:1.0.0",
+					DependsOn: []string{
+						"org.example:example-dependency2:2.3.4",
+					},
+				},
+				{
+					ID: "org.example:example-dependency2:2.3.4",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+			},
+		},
+		{
+			name:      "profiles activated by missing user property",
+			inputFile: filepath.Join("testdata", "profiles-property", "pom.xml"),
+			local:     true,
+			want: []types.Library{
+				{
+					ID:      "com.example:profiles-property:1.0.0",
+					Name:    "com.example:profiles-property",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:1.7.30",
+					Name:    "org.example:example-api",
+					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:profiles-property:1.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+			},
+		},
+		{
+			name:           "profiles activated explicitly",
+			inputFile:      filepath.Join("testdata", "profiles", "pom.xml"),
+			local:          true,
+			activeProfiles: []string{"extra"},
+			want: []types.Library{
+				{
+					ID:      "com.example:profiles:1.0.0",
+					Name:    "com.example:profiles",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:2.0.0",
+					Name:    "org.example:example-api",
+					Version: "2.0.0",
+					Scope:   "compile",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "compile",
+				},
+				{
+					ID:      "org.example:example-dependency2:2.3.4",
+					Name:    "org.example:example-dependency2",
+					Version: "2.3.4",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:profiles:1.0.0",
+					DependsOn: []string{
+						"org.example:example-dependency2:2.3.4",
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency2:2.3.4",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+					},
+				},
+			},
+		},
 		{
 			name:      "import dependencyManagement",
 			inputFile: filepath.Join("testdata", "import-dependency-management", "pom.xml"),
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:import:2.0.0",
 					Name:    "com.example:import",
 					Version: "2.0.0",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:import:2.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -234,12 +911,23 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:import:2.0.0",
 					Name:    "com.example:import",
 					Version: "2.0.0",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:import:2.0.0",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -249,16 +937,35 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:exclusions:3.0.0",
 					Name:    "com.example:exclusions",
 					Version: "3.0.0",
 				},
 				{
+					ID:      "org.example:example-dependency:1.2.3",
 					Name:    "org.example:example-dependency",
 					Version: "1.2.3",
+					Scope:   "compile",
 				},
 				{
+					ID:      "org.example:example-nested:3.3.3",
 					Name:    "org.example:example-nested",
 					Version: "3.3.3",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:exclusions:3.0.0",
+					DependsOn: []string{
+						"org.example:example-nested:3.3.3",
+					},
+				},
+				{
+					ID: "org.example:example-nested:3.3.3",
+					DependsOn: []string{
+						"org.example:example-dependency:1.2.3",
+					},
 				},
 			},
 		},
@@ -268,16 +975,28 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:aggregation:1.0.0",
 					Name:    "com.example:aggregation",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "com.example:module:1.1.1",
 					Name:    "com.example:module",
 					Version: "1.1.1",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:module:1.1.1",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -287,24 +1006,45 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:aggregation:1.0.0",
 					Name:    "com.example:aggregation",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "com.example:module1:1.1.1",
 					Name:    "com.example:module1",
 					Version: "1.1.1",
 				},
 				{
+					ID:      "com.example:module2:1.1.1",
 					Name:    "com.example:module2",
 					Version: "1.1.1",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
 				},
 				{
+					ID:      "org.example:example-api:2.0.0",
 					Name:    "org.example:example-api",
 					Version: "2.0.0",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:module1:1.1.1",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
+				},
+				{
+					ID: "com.example:module2:1.1.1",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+					},
 				},
 			},
 		},
@@ -314,12 +1054,23 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:no-parent:1.0-SNAPSHOT",
 					Name:    "com.example:no-parent",
 					Version: "1.0-SNAPSHOT",
 				},
 				{
+					ID:      "org.example:example-api:1.7.30",
 					Name:    "org.example:example-api",
 					Version: "1.7.30",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:no-parent:1.0-SNAPSHOT",
+					DependsOn: []string{
+						"org.example:example-api:1.7.30",
+					},
 				},
 			},
 		},
@@ -329,12 +1080,23 @@ func TestPom_Parse(t *testing.T) {
 			local:     true,
 			want: []types.Library{
 				{
+					ID:      "com.example:not-found-dependency:1.0.0",
 					Name:    "com.example:not-found-dependency",
 					Version: "1.0.0",
 				},
 				{
+					ID:      "org.example:example-not-found:999",
 					Name:    "org.example:example-not-found",
 					Version: "999",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:not-found-dependency:1.0.0",
+					DependsOn: []string{
+						"org.example:example-not-found:999",
+					},
 				},
 			},
 		},
@@ -362,9 +1124,12 @@ func TestPom_Parse(t *testing.T) {
 				remoteRepos = []string{ts.URL}
 			}
 
-			p := pom.NewParser(tt.inputFile, pom.WithRemoteRepos(remoteRepos), pom.WithOffline(tt.offline))
+			p := pom.NewParser(tt.inputFile, pom.WithRemoteRepos(remoteRepos), pom.WithOffline(tt.offline),
+				pom.WithScopes(tt.scopes), pom.WithOptional(tt.optional),
+				pom.WithActiveProfiles(tt.activeProfiles), pom.WithInactiveProfiles(tt.inactiveProfiles),
+				pom.WithProperties(tt.properties))
 
-			got, err := p.Parse(f)
+			got, gotDeps, err := p.Parse(f)
 			if tt.wantErr != "" {
 				require.NotNil(t, err)
 				assert.Contains(t, err.Error(), tt.wantErr)
@@ -377,6 +1142,382 @@ func TestPom_Parse(t *testing.T) {
 			})
 
 			assert.Equal(t, tt.want, got)
+
+			sort.Slice(gotDeps, func(i, j int) bool {
+				return gotDeps[i].ID < gotDeps[j].ID
+			})
+			assert.Equal(t, tt.wantDeps, gotDeps)
 		})
 	}
 }
+
+func TestPom_ParseConflicts(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputFile string
+		want      []pom.Conflict
+	}{
+		{
+			name:      "nearest wins",
+			inputFile: filepath.Join("testdata", "soft-requirement", "pom.xml"),
+			want: []pom.Conflict{
+				{
+					Name: "org.example:example-api",
+					Winner: pom.Request{
+						Version: "1.7.30",
+						Depth:   1,
+						Scope:   "compile",
+						Path:    []string{"com.example:soft:1.0.0"},
+					},
+					Requests: []pom.Request{
+						{
+							Version: "1.7.30",
+							Depth:   1,
+							Scope:   "compile",
+							Path:    []string{"com.example:soft:1.0.0"},
+						},
+						{
+							Version: "2.0.0",
+							Depth:   2,
+							Scope:   "compile",
+							Path: []string{
+								"com.example:soft:1.0.0",
+								"org.example:example-dependency:1.2.3",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "first declaration wins at the same depth",
+			inputFile: filepath.Join("testdata", "parent-dependency-order", "child", "pom.xml"),
+			want: []pom.Conflict{
+				{
+					Name: "org.example:example-api",
+					Winner: pom.Request{
+						Version: "2.0.0",
+						Depth:   2,
+						Scope:   "compile",
+						Path: []string{
+							"com.example:child:1.0.0",
+							"org.example:example-dependency:1.2.3",
+						},
+					},
+					Requests: []pom.Request{
+						{
+							Version: "2.0.0",
+							Depth:   2,
+							Scope:   "compile",
+							Path: []string{
+								"com.example:child:1.0.0",
+								"org.example:example-dependency:1.2.3",
+							},
+						},
+						{
+							Version: "1.7.30",
+							Depth:   2,
+							Scope:   "compile",
+							Path: []string{
+								"com.example:child:1.0.0",
+								"org.example:example-dependency2:2.3.4",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "hard requirement wins",
+			inputFile: filepath.Join("testdata", "hard-requirement", "pom.xml"),
+			want: []pom.Conflict{
+				{
+					Name: "org.example:example-api",
+					Winner: pom.Request{
+						Version: "2.0.0",
+						Depth:   2,
+						Scope:   "compile",
+						Path: []string{
+							"com.example:hard:1.0.0",
+							"org.example:example-dependency:1.2.4",
+						},
+					},
+					Requests: []pom.Request{
+						{
+							Version: "1.7.30",
+							Depth:   1,
+							Scope:   "compile",
+							Path:    []string{"com.example:hard:1.0.0"},
+						},
+						{
+							Version: "2.0.0",
+							Depth:   2,
+							Scope:   "compile",
+							Path: []string{
+								"com.example:hard:1.0.0",
+								"org.example:example-dependency:1.2.4",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "request not in the tree",
+			inputFile: filepath.Join("testdata", "conflict-dropped", "pom.xml"),
+			want: []pom.Conflict{
+				{
+					Name: "org.example:example-api",
+					Winner: pom.Request{
+						Version: "1.7.30",
+						Depth:   1,
+						Scope:   "compile",
+						Path:    []string{"com.example:conflict-dropped:1.0.0"},
+					},
+					Requests: []pom.Request{
+						{
+							Version: "1.7.30",
+							Depth:   1,
+							Scope:   "compile",
+							Path:    []string{"com.example:conflict-dropped:1.0.0"},
+						},
+						{
+							Version: "2.0.0",
+							Depth:   2,
+							Scope:   "test",
+							Path: []string{
+								"com.example:conflict-dropped:1.0.0",
+								"org.example:example-test-only:1.0.0",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "direct dependency in test scope",
+			inputFile: filepath.Join("testdata", "scopes-mediation", "pom.xml"),
+			want: []pom.Conflict{
+				{
+					Name: "org.example:example-api",
+					Winner: pom.Request{
+						Version: "1.7.30",
+						Depth:   1,
+						Scope:   "test",
+						Path:    []string{"com.example:scopes-mediation:1.0.0"},
+					},
+					Requests: []pom.Request{
+						{
+							Version: "1.7.30",
+							Depth:   1,
+							Scope:   "test",
+							Path:    []string{"com.example:scopes-mediation:1.0.0"},
+						},
+						{
+							Version: "2.0.0",
+							Depth:   2,
+							Scope:   "compile",
+							Path: []string{
+								"com.example:scopes-mediation:1.0.0",
+								"org.example:example-dependency:1.2.3",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "no conflict",
+			inputFile: filepath.Join("testdata", "happy", "pom.xml"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.inputFile)
+			require.NoError(t, err)
+			defer f.Close()
+
+			t.Setenv("MAVEN_HOME", "testdata")
+
+			var got []pom.Conflict
+			p := pom.NewParser(tt.inputFile, pom.WithRemoteRepos(nil), pom.WithOffline(true),
+				pom.WithConflictHandler(func(c pom.Conflict) {
+					got = append(got, c)
+				}))
+
+			_, _, err = p.Parse(f)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPom_ParseWithSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		want     []types.Library
+		wantDeps []types.Dependency
+	}{
+		{
+			name:     "mirror with credentials",
+			password: "password",
+			want: []types.Library{
+				{
+					ID:      "com.example:settings:1.0.0",
+					Name:    "com.example:settings",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-api:2.0.0",
+					Name:    "org.example:example-api",
+					Version: "2.0.0",
+					Scope:   "compile",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:settings:1.0.0",
+					DependsOn: []string{
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+				{
+					ID: "org.example:example-dependency:1.2.3",
+					DependsOn: []string{
+						"org.example:example-api:2.0.0",
+					},
+				},
+			},
+		},
+		{
+			name:     "wrong credentials",
+			password: "wrong",
+			want: []types.Library{
+				{
+					ID:      "com.example:settings:1.0.0",
+					Name:    "com.example:settings",
+					Version: "1.0.0",
+				},
+				{
+					ID:      "org.example:example-dependency:1.2.3",
+					Name:    "org.example:example-dependency",
+					Version: "1.2.3",
+					Scope:   "compile",
+				},
+			},
+			wantDeps: []types.Dependency{
+				{
+					ID: "com.example:settings:1.0.0",
+					DependsOn: []string{
+						"org.example:example-dependency:1.2.3",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputFile := filepath.Join("testdata", "settings", "pom.xml")
+			f, err := os.Open(inputFile)
+			require.NoError(t, err)
+			defer f.Close()
+
+			// The mirror requires basic auth
+			h := http.FileServer(http.Dir(filepath.Join("testdata", "repository")))
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "password" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				h.ServeHTTP(w, r)
+			}))
+			defer ts.Close()
+
+			t.Setenv("HOME", filepath.Join("testdata", "settings", "user"))
+			t.Setenv("MAVEN_HOME", filepath.Join("testdata", "settings", "global"))
+			t.Setenv("NEXUS_URL", ts.URL)
+			t.Setenv("NEXUS_PASSWORD", tt.password)
+
+			// Maven Central is replaced with the mirror.
+			p := pom.NewParser(inputFile)
+
+			got, gotDeps, err := p.Parse(f)
+			require.NoError(t, err)
+
+			sort.Slice(got, func(i, j int) bool {
+				return got[i].Name < got[j].Name
+			})
+			assert.Equal(t, tt.want, got)
+
+			sort.Slice(gotDeps, func(i, j int) bool {
+				return gotDeps[i].ID < gotDeps[j].ID
+			})
+			assert.Equal(t, tt.wantDeps, gotDeps)
+		})
+	}
+}
+
+func TestPom_ParseCredentials(t *testing.T) {
+	// Record the users sent to each repository
+	newServer := func(users *[]string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, _, _ := r.BasicAuth()
+			*users = append(*users, user)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+	}
+	var mirrorUsers, pomUsers []string
+	mirror := newServer(&mirrorUsers)
+	defer mirror.Close()
+	untrusted := newServer(&pomUsers)
+	defer untrusted.Close()
+
+	// The POM declares a repository with the same ID as the server in settings.xml.
+	dir := t.TempDir()
+	settings := `<settings>
+  <localRepository>` + filepath.Join(dir, "repository") + `</localRepository>
+  <servers><server><id>corp-nexus</id><username>user</username><password>password</password></server></servers>
+  <mirrors><mirror><id>corp-nexus</id><mirrorOf>central</mirrorOf><url>` + mirror.URL + `</url></mirror></mirrors>
+</settings>`
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".m2"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".m2", "settings.xml"), []byte(settings), 0644))
+
+	inputFile := filepath.Join(dir, "pom.xml")
+	content := `<project>
+  <groupId>com.example</groupId>
+  <artifactId>credentials</artifactId>
+  <version>1.0.0</version>
+  <repositories><repository><id>corp-nexus</id><url>` + untrusted.URL + `</url></repository></repositories>
+  <dependencies>
+    <dependency><groupId>org.example</groupId><artifactId>example-api</artifactId><version>1.7.30</version></dependency>
+  </dependencies>
+</project>`
+	require.NoError(t, os.WriteFile(inputFile, []byte(content), 0644))
+
+	t.Setenv("HOME", dir)
+	t.Setenv("MAVEN_HOME", dir)
+
+	f, err := os.Open(inputFile)
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, _, err = pom.NewParser(inputFile).Parse(f)
+	require.NoError(t, err)
+
+	// Only the mirror in settings.xml receives the credentials.
+	require.NotEmpty(t, mirrorUsers)
+	require.NotEmpty(t, pomUsers)
+	for _, user := range mirrorUsers {
+		assert.Equal(t, "user", user)
+	}
+	for _, user := range pomUsers {
+		assert.Empty(t, user)
+	}
+}
@@ -87,48 +87,63 @@ func (p pom) artifact() artifact {
 	return newArtifact(p.content.GroupId, p.content.ArtifactId, p.content.Version, p.content.Properties)
 }
 
-func (p pom) repositories() []string {
-	var urls []string
+func (p pom) repositories() []remoteRepository {
+	var repos []remoteRepository
 	for _, rep := range p.content.Repositories.Repository {
 		if rep.Releases.Enabled != "false" {
-			urls = append(urls, rep.URL)
+			repos = append(repos, remoteRepository{
+				ID:  rep.ID,
+				URL: rep.URL,
+			})
 		}
 	}
-	return urls
+	return repos
 }
 
 type pomXML struct {
-	Parent     pomParent `xml:"parent"`
-	GroupId    string    `xml:"groupId"`
-	ArtifactId string    `xml:"artifactId"`
-	Version    string    `xml:"version"`
-	Modules    struct {
-		Text   string   `xml:",chardata"`
-		Module []string `xml:"module"`
-	} `xml:"modules"`
-	Properties           properties `xml:"properties"`
-	DependencyManagement struct {
-		Text         string          `xml:",chardata"`
-		Dependencies pomDependencies `xml:"dependencies"`
-	} `xml:"dependencyManagement"`
+	Parent               pomParent               `xml:"parent"`
+	GroupId              string                  `xml:"groupId"`
+	ArtifactId           string                  `xml:"artifactId"`
+	Version              string                  `xml:"version"`
+	Modules              pomModules              `xml:"modules"`
+	Properties           properties              `xml:"properties"`
+	DependencyManagement pomDependencyManagement `xml:"dependencyManagement"`
+	Dependencies         pomDependencies         `xml:"dependencies"`
+	Repositories         pomRepositories         `xml:"repositories"`
+	Profiles             struct {
+		Text    string       `xml:",chardata"`
+		Profile []pomProfile `xml:"profile"`
+	} `xml:"profiles"`
+}
+
+type pomModules struct {
+	Text   string   `xml:",chardata"`
+	Module []string `xml:"module"`
+}
+
+type pomDependencyManagement struct {
+	Text         string          `xml:",chardata"`
 	Dependencies pomDependencies `xml:"dependencies"`
-	Repositories struct {
-		Text       string `xml:",chardata"`
-		Repository []struct {
-			Text     string `xml:",chardata"`
-			ID       string `xml:"id"`
-			Name     string `xml:"name"`
-			URL      string `xml:"url"`
-			Releases struct {
-				Text    string `xml:",chardata"`
-				Enabled string `xml:"enabled"`
-			} `xml:"releases"`
-			Snapshots struct {
-				Text    string `xml:",chardata"`
-				Enabled string `xml:"enabled"`
-			} `xml:"snapshots"`
-		} `xml:"repository"`
-	} `xml:"repositories"`
+}
+
+type pomRepositories struct {
+	Text       string          `xml:",chardata"`
+	Repository []pomRepository `xml:"repository"`
+}
+
+type pomRepository struct {
+	Text     string `xml:",chardata"`
+	ID       string `xml:"id"`
+	Name     string `xml:"name"`
+	URL      string `xml:"url"`
+	Releases struct {
+		Text    string `xml:",chardata"`
+		Enabled string `xml:"enabled"`
+	} `xml:"releases"`
+	Snapshots struct {
+		Text    string `xml:",chardata"`
+		Enabled string `xml:"enabled"`
+	} `xml:"snapshots"`
 }
 
 type pomParent struct {
@@ -201,17 +216,26 @@ func (d pomDependency) Resolve(props map[string]string, depManagement map[string
 // ToArtifact converts dependency to artifact.
 // It should be called after calling Resolve() so that variables can be evaluated.
 func (d pomDependency) ToArtifact(exclusions map[string]struct{}) artifact {
-	if exclusions == nil {
-		exclusions = map[string]struct{}{}
+	// Copy the exclusions so that they don't leak into siblings.
+	excl := map[string]struct{}{}
+	for name := range exclusions {
+		excl[name] = struct{}{}
 	}
+	exclusions = excl
 	for _, e := range d.Exclusions {
 		exclusions[fmt.Sprintf("%s:%s", e.Exclusion.GroupID, e.Exclusion.ArtifactID)] = struct{}{}
 	}
+	scope := d.Scope
+	if scope == "" {
+		scope = scopeCompile
+	}
 	return artifact{
 		GroupID:    d.GroupID,
 		ArtifactID: d.ArtifactID,
 		Version:    newVersion(d.Version),
 		Exclusions: exclusions,
+		Scope:      scope,
+		Optional:   d.Optional,
 	}
 }
 
@@ -0,0 +1,332 @@
+package pom
+
+import (
+	"bufio"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// ref. https://maven.apache.org/guides/introduction/introduction-to-profiles.html
+type pomProfile struct {
+	ID                   string                  `xml:"id"`
+	Activation           pomActivation           `xml:"activation"`
+	Modules              pomModules              `xml:"modules"`
+	Properties           properties              `xml:"properties"`
+	DependencyManagement pomDependencyManagement `xml:"dependencyManagement"`
+	Dependencies         pomDependencies         `xml:"dependencies"`
+	Repositories         pomRepositories         `xml:"repositories"`
+}
+
+type pomActivation struct {
+	ActiveByDefault bool   `xml:"activeByDefault"`
+	JDK             string `xml:"jdk"`
+	OS              struct {
+		Name    string `xml:"name"`
+		Family  string `xml:"family"`
+		Arch    string `xml:"arch"`
+		Version string `xml:"version"`
+	} `xml:"os"`
+	Property struct {
+		Name  string `xml:"name"`
+		Value string `xml:"value"`
+	} `xml:"property"`
+	File struct {
+		Exists  string `xml:"exists"`
+		Missing string `xml:"missing"`
+	} `xml:"file"`
+}
+
+// activationEnv is the environment where profiles are activated.
+// JDK and OS conditions are evaluated against the host as Maven does.
+type activationEnv struct {
+	activeProfiles   map[string]struct{}
+	inactiveProfiles map[string]struct{}
+
+	// jdk is the version of the JDK in JAVA_HOME. It is empty if unknown.
+	jdk        string
+	osName     string
+	osFamilies []string
+	osArch     string
+
+	// properties are the user properties given by WithProperties.
+	properties map[string]string
+
+	// fsys is the file system of the project given by WithFS. Files are looked up in the local disk if nil.
+	fsys fs.FS
+}
+
+func newActivationEnv(activeProfiles, inactiveProfiles []string) activationEnv {
+	env := activationEnv{
+		activeProfiles:   map[string]struct{}{},
+		inactiveProfiles: map[string]struct{}{},
+		jdk:              javaVersion(),
+		osArch:           runtime.GOARCH,
+	}
+	for _, id := range activeProfiles {
+		env.activeProfiles[id] = struct{}{}
+	}
+	for _, id := range inactiveProfiles {
+		env.inactiveProfiles[id] = struct{}{}
+	}
+
+	// Use the values of "os.name" and "os.arch" in Java
+	switch runtime.GOOS {
+	case "windows":
+		env.osName, env.osFamilies = "windows", []string{"windows", "dos"}
+	case "darwin":
+		env.osName, env.osFamilies = "mac os x", []string{"mac", "unix"}
+	default:
+		env.osName, env.osFamilies = runtime.GOOS, []string{"unix"}
+	}
+	switch runtime.GOARCH {
+	case "arm64":
+		env.osArch = "aarch64"
+	case "386":
+		env.osArch = "x86"
+	}
+	return env
+}
+
+// javaVersion returns JAVA_VERSION in $JAVA_HOME/release. e.g. JAVA_VERSION="17.0.2"
+func javaVersion() string {
+	javaHome := os.Getenv("JAVA_HOME")
+	if javaHome == "" {
+		return ""
+	}
+	f, err := os.Open(filepath.Join(javaHome, "release"))
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.HasPrefix(line, "JAVA_VERSION=") {
+			return strings.Trim(strings.TrimPrefix(line, "JAVA_VERSION="), `"`)
+		}
+	}
+	return ""
+}
+
+// activate returns the active profiles in the declaration order.
+// Profiles with activeByDefault are active only if no other profile in the same POM is activated.
+func (env activationEnv) activate(profiles []pomProfile, basedir string) []pomProfile {
+	var active, byDefault []pomProfile
+	for _, profile := range profiles {
+		if _, ok := env.inactiveProfiles[profile.ID]; ok {
+			continue
+		}
+		if _, ok := env.activeProfiles[profile.ID]; ok || env.isActive(profile.Activation, basedir) {
+			active = append(active, profile)
+		} else if profile.Activation.ActiveByDefault {
+			byDefault = append(byDefault, profile)
+		}
+	}
+	if len(active) == 0 {
+		return byDefault
+	}
+	return active
+}
+
+// isActive returns true if all the conditions are satisfied. A profile without conditions is not active.
+func (env activationEnv) isActive(a pomActivation, basedir string) bool {
+	var conditions []bool
+	if a.JDK != "" {
+		conditions = append(conditions, env.matchJDK(a.JDK))
+	}
+	if a.OS.Name != "" || a.OS.Family != "" || a.OS.Arch != "" || a.OS.Version != "" {
+		conditions = append(conditions, env.matchOS(a))
+	}
+	if a.Property.Name != "" {
+		conditions = append(conditions, env.matchProperty(a.Property.Name, a.Property.Value))
+	}
+	if a.File.Exists != "" || a.File.Missing != "" {
+		conditions = append(conditions, env.matchFile(a.File.Exists, a.File.Missing, basedir))
+	}
+
+	for _, c := range conditions {
+		if !c {
+			return false
+		}
+	}
+	return len(conditions) > 0
+}
+
+// matchJDK supports a prefix such as "1.8", a negated prefix such as "!1.8" and a range such as "[1.8,11)".
+func (env activationEnv) matchJDK(jdk string) bool {
+	if env.jdk == "" {
+		return false
+	}
+	if isVersionRange(jdk) {
+		r, err := parseVersionRange(jdk)
+		if err != nil {
+			return false
+		}
+		return r.contains(env.jdk)
+	}
+	return matchNegatable(jdk, func(s string) bool {
+		return strings.HasPrefix(env.jdk, s)
+	})
+}
+
+func (env activationEnv) matchOS(a pomActivation) bool {
+	if a.OS.Name != "" && !matchNegatable(a.OS.Name, func(s string) bool {
+		return strings.EqualFold(s, env.osName)
+	}) {
+		return false
+	}
+	if a.OS.Family != "" && !matchNegatable(a.OS.Family, func(s string) bool {
+		for _, family := range env.osFamilies {
+			if strings.EqualFold(s, family) {
+				return true
+			}
+		}
+		return false
+	}) {
+		return false
+	}
+	if a.OS.Arch != "" && !matchNegatable(a.OS.Arch, func(s string) bool {
+		return strings.EqualFold(s, env.osArch)
+	}) {
+		return false
+	}
+	// The OS version is unknown, so only negated versions match.
+	if a.OS.Version != "" && !strings.HasPrefix(a.OS.Version, "!") {
+		return false
+	}
+	return true
+}
+
+// matchProperty checks the presence or the value of the property.
+// Environment variables such as "env.BUILD_ENV" and the user properties are available.
+func (env activationEnv) matchProperty(name, value string) bool {
+	negated := strings.HasPrefix(name, "!")
+	name = strings.TrimPrefix(name, "!")
+
+	var actual string
+	if strings.HasPrefix(name, "env.") {
+		actual = os.Getenv(strings.TrimPrefix(name, "env."))
+	} else {
+		actual = env.properties[name]
+	}
+
+	if value == "" {
+		return (actual != "") != negated
+	}
+	return matchNegatable(value, func(s string) bool {
+		return s == actual
+	})
+}
+
+// matchFile checks the existence of the file. Relative paths are resolved from the directory of the POM.
+// The directory is "/" + the slash-separated path in the file system if the file system is given.
+func (env activationEnv) matchFile(exists, missing, basedir string) bool {
+	filePath, want := exists, true
+	if filePath == "" {
+		filePath, want = missing, false
+	}
+
+	filePath = evaluateVariable(filePath, map[string]string{
+		"basedir":         basedir,
+		"project.basedir": basedir,
+	})
+
+	// POMs in remote repositories don't have the directory, so relative paths never match.
+	if env.fsys != nil {
+		filePath = filepath.ToSlash(filePath)
+		if !path.IsAbs(filePath) {
+			if basedir == "" {
+				return false
+			}
+			filePath = path.Join(basedir, filePath)
+		}
+		_, err := fs.Stat(env.fsys, strings.TrimPrefix(path.Clean(filePath), "/"))
+		return (err == nil) == want
+	}
+
+	if !filepath.IsAbs(filePath) {
+		if basedir == "" {
+			return false
+		}
+		filePath = filepath.Join(basedir, filePath)
+	}
+
+	_, err := os.Stat(filePath)
+	return (err == nil) == want
+}
+
+func matchNegatable(expected string, match func(string) bool) bool {
+	if strings.HasPrefix(expected, "!") {
+		return !match(expected[1:])
+	}
+	return match(expected)
+}
+
+// applyProfiles injects the active profiles and the active profiles in settings.xml into the POM before inheritance.
+// Elements in the profiles take precedence over the ones in the POM.
+func (p *pom) applyProfiles(env activationEnv, settingsProfiles []pomProfile) {
+	// ${basedir} is the absolute path of the directory containing pom.xml.
+	var basedir string
+	switch {
+	case p.filePath == "":
+	case env.fsys != nil:
+		basedir = path.Join("/", filepath.ToSlash(filepath.Dir(p.filePath)))
+	default:
+		if dir, err := filepath.Abs(filepath.Dir(p.filePath)); err == nil {
+			basedir = dir
+		}
+	}
+
+	profiles := append(env.activate(p.content.Profiles.Profile, basedir), settingsProfiles...)
+	for _, profile := range profiles {
+		c := p.content
+		c.Properties = utils.MergeMaps(c.Properties, profile.Properties)
+		c.Modules.Module = utils.UniqueStrings(append(c.Modules.Module, profile.Modules.Module...))
+		c.Dependencies.Dependency = mergeProfileDependencies(c.Dependencies.Dependency,
+			profile.Dependencies.Dependency)
+		c.DependencyManagement.Dependencies.Dependency = mergeProfileDependencies(
+			c.DependencyManagement.Dependencies.Dependency, profile.DependencyManagement.Dependencies.Dependency)
+		c.Repositories.Repository = mergeProfileRepositories(c.Repositories.Repository,
+			profile.Repositories.Repository)
+	}
+}
+
+// mergeProfileDependencies overrides the dependencies with the same name and appends the others.
+// The result is the same even if the profile is applied twice.
+func mergeProfileDependencies(deps, profileDeps []pomDependency) []pomDependency {
+	index := map[string]int{}
+	for i, d := range deps {
+		index[d.Name()] = i
+	}
+	for _, d := range profileDeps {
+		if i, ok := index[d.Name()]; ok {
+			deps[i] = d
+			continue
+		}
+		index[d.Name()] = len(deps)
+		deps = append(deps, d)
+	}
+	return deps
+}
+
+func mergeProfileRepositories(repos, profileRepos []pomRepository) []pomRepository {
+	for _, r := range profileRepos {
+		var found bool
+		for _, repo := range repos {
+			if repo.URL == r.URL {
+				found = true
+				break
+			}
+		}
+		if !found {
+			repos = append(repos, r)
+		}
+	}
+	return repos
+}
@@ -0,0 +1,145 @@
+package pom
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_activationEnv_activate(t *testing.T) {
+	env := activationEnv{
+		activeProfiles:   map[string]struct{}{"explicit": {}},
+		inactiveProfiles: map[string]struct{}{"disabled": {}},
+		jdk:              "17.0.2",
+		osName:           "linux",
+		osFamilies:       []string{"unix"},
+		osArch:           "amd64",
+		properties:       map[string]string{"release": "true"},
+	}
+
+	newProfile := func(id string, f func(a *pomActivation)) pomProfile {
+		profile := pomProfile{ID: id}
+		f(&profile.Activation)
+		return profile
+	}
+	basedir, err := filepath.Abs(filepath.Join("testdata", "profiles"))
+	require.NoError(t, err)
+
+	activeByDefault := newProfile("default", func(a *pomActivation) { a.ActiveByDefault = true })
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		profiles []pomProfile
+		want     []string
+	}{
+		{
+			name:     "active by default",
+			profiles: []pomProfile{activeByDefault, {ID: "no-activation"}},
+			want:     []string{"default"},
+		},
+		{
+			name: "explicitly active",
+			profiles: []pomProfile{
+				activeByDefault,
+				{ID: "explicit"},
+			},
+			want: []string{"explicit"},
+		},
+		{
+			name: "explicitly inactive",
+			profiles: []pomProfile{
+				activeByDefault,
+				newProfile("disabled", func(a *pomActivation) { a.JDK = "17" }),
+			},
+			want: []string{"default"},
+		},
+		{
+			name: "jdk",
+			profiles: []pomProfile{
+				newProfile("prefix", func(a *pomActivation) { a.JDK = "17" }),
+				newProfile("wrong prefix", func(a *pomActivation) { a.JDK = "1.8" }),
+				newProfile("negated", func(a *pomActivation) { a.JDK = "!1.8" }),
+				newProfile("range", func(a *pomActivation) { a.JDK = "[11,)" }),
+				newProfile("out of range", func(a *pomActivation) { a.JDK = "[1.8,11)" }),
+			},
+			want: []string{"prefix", "negated", "range"},
+		},
+		{
+			name: "os",
+			profiles: []pomProfile{
+				newProfile("family", func(a *pomActivation) { a.OS.Family = "Unix" }),
+				newProfile("negated family", func(a *pomActivation) { a.OS.Family = "!windows" }),
+				newProfile("name and arch", func(a *pomActivation) {
+					a.OS.Name = "Linux"
+					a.OS.Arch = "amd64"
+				}),
+				newProfile("wrong arch", func(a *pomActivation) {
+					a.OS.Family = "unix"
+					a.OS.Arch = "aarch64"
+				}),
+				newProfile("version", func(a *pomActivation) { a.OS.Version = "5.10" }),
+			},
+			want: []string{"family", "negated family", "name and arch"},
+		},
+		{
+			name: "property",
+			env: map[string]string{
+				"BUILD_ENV": "ci",
+			},
+			profiles: []pomProfile{
+				newProfile("present", func(a *pomActivation) { a.Property.Name = "env.BUILD_ENV" }),
+				newProfile("missing", func(a *pomActivation) { a.Property.Name = "!env.SKIP" }),
+				newProfile("value", func(a *pomActivation) {
+					a.Property.Name = "env.BUILD_ENV"
+					a.Property.Value = "ci"
+				}),
+				newProfile("negated value", func(a *pomActivation) {
+					a.Property.Name = "env.BUILD_ENV"
+					a.Property.Value = "!ci"
+				}),
+				newProfile("user property", func(a *pomActivation) { a.Property.Name = "release" }),
+				newProfile("negated user property", func(a *pomActivation) { a.Property.Name = "!release" }),
+				newProfile("missing user property", func(a *pomActivation) { a.Property.Name = "skipTests" }),
+				newProfile("negated missing user property", func(a *pomActivation) { a.Property.Name = "!skipTests" }),
+			},
+			want: []string{"present", "missing", "value", "user property", "negated missing user property"},
+		},
+		{
+			name: "file",
+			profiles: []pomProfile{
+				newProfile("exists", func(a *pomActivation) { a.File.Exists = "${basedir}/pom.xml" }),
+				newProfile("relative", func(a *pomActivation) { a.File.Exists = "pom.xml" }),
+				newProfile("missing", func(a *pomActivation) { a.File.Missing = "src/main/java" }),
+				newProfile("not missing", func(a *pomActivation) { a.File.Missing = "pom.xml" }),
+			},
+			want: []string{"exists", "relative", "missing"},
+		},
+		{
+			name: "all the conditions must be satisfied",
+			profiles: []pomProfile{
+				activeByDefault,
+				newProfile("partially", func(a *pomActivation) {
+					a.JDK = "17"
+					a.OS.Family = "windows"
+				}),
+			},
+			want: []string{"default"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			var got []string
+			for _, profile := range env.activate(tt.profiles, basedir) {
+				got = append(got, profile.ID)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
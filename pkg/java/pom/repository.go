@@ -0,0 +1,101 @@
+package pom
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+)
+
+// remoteRepository is a remote repository. The ID is used to select mirrors and servers in settings.xml.
+type remoteRepository struct {
+	ID  string
+	URL string
+}
+
+// mergeRepositories appends the repositories with new URLs.
+func mergeRepositories(repos []remoteRepository, newRepos ...remoteRepository) []remoteRepository {
+	for _, r := range newRepos {
+		var found bool
+		for _, repo := range repos {
+			if repo.URL == r.URL {
+				found = true
+				break
+			}
+		}
+		if !found {
+			repos = append(repos, r)
+		}
+	}
+	return repos
+}
+
+// repositories returns the remote repositories replaced with the mirrors.
+func (p parser) repositories() []remoteRepository {
+	var repos []remoteRepository
+	for _, repo := range p.remoteRepositories {
+		if m, ok := p.settings.findMirror(repo); ok {
+			repo = remoteRepository{
+				ID:  m.ID,
+				URL: m.URL,
+			}
+		}
+		repos = mergeRepositories(repos, repo)
+	}
+	return repos
+}
+
+// trustedURLs returns the URLs of the repositories configured by the user, i.e. the ones given by WithRemoteRepos
+// and the mirrors and repositories in settings.xml. Repositories declared in POMs are not trusted.
+func trustedURLs(remoteRepos []string, s settings) map[string]struct{} {
+	urls := map[string]struct{}{}
+	for _, u := range remoteRepos {
+		urls[strings.TrimSuffix(u, "/")] = struct{}{}
+	}
+	for _, m := range s.Mirrors {
+		urls[strings.TrimSuffix(m.URL, "/")] = struct{}{}
+	}
+	for _, profile := range s.Profiles {
+		for _, r := range profile.Repositories.Repository {
+			urls[strings.TrimSuffix(r.URL, "/")] = struct{}{}
+		}
+	}
+	return urls
+}
+
+// httpGet fetches the file in the repository with the credentials of the server with the same ID.
+// The credentials are sent only to trusted repositories so that a POM can't receive them
+// by declaring a repository with the same ID.
+func (p parser) httpGet(repo remoteRepository, paths []string) (*http.Response, error) {
+	repoURL, err := url.Parse(repo.URL)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid repository URL: %w", err)
+	}
+	repoURL.Path = path.Join(append([]string{repoURL.Path}, paths...)...)
+
+	req, err := http.NewRequest(http.MethodGet, repoURL.String(), nil)
+	if err != nil {
+		return nil, xerrors.Errorf("http request error: %w", err)
+	}
+	if srv, ok := p.settings.findServer(repo.ID); ok && srv.Username != "" {
+		if _, trusted := p.trustedURLs[strings.TrimSuffix(repo.URL, "/")]; trusted {
+			req.SetBasicAuth(srv.Username, srv.Password)
+		} else {
+			log.Logger.Debugf("The credentials of %s are not sent to the untrusted repository: %s", srv.ID, repo.URL)
+		}
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, xerrors.Errorf("http error: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, xerrors.Errorf("unexpected status code (%s): %d", repoURL, resp.StatusCode)
+	}
+	return resp, nil
+}
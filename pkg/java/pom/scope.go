@@ -0,0 +1,53 @@
+package pom
+
+// Dependency scopes
+// ref. https://maven.apache.org/guides/introduction/introduction-to-dependency-mechanism.html#dependency-scope
+const (
+	scopeCompile  = "compile"
+	scopeProvided = "provided"
+	scopeRuntime  = "runtime"
+	scopeTest     = "test"
+	scopeSystem   = "system"
+)
+
+// scopePriority is used to select the widest scope when an artifact is requested with different scopes.
+var scopePriority = map[string]int{
+	scopeCompile:  4,
+	scopeRuntime:  3,
+	scopeProvided: 2,
+	scopeSystem:   2,
+	scopeTest:     1,
+}
+
+// deriveScope returns the scope of the transitive dependency following the propagation table of Maven.
+// It returns an empty string if the dependency is not transitive.
+//
+//	           | compile  | provided | runtime  | test
+//	compile    | compile  | -        | runtime  | -
+//	provided   | provided | -        | provided | -
+//	runtime    | runtime  | -        | runtime  | -
+//	test       | test     | -        | test     | -
+func deriveScope(parent, child string) string {
+	switch child {
+	case scopeProvided, scopeTest:
+		return ""
+	case scopeSystem:
+		return scopeSystem
+	}
+
+	switch parent {
+	case scopeCompile:
+		return child
+	case scopeProvided, scopeSystem:
+		return scopeProvided
+	}
+	return parent
+}
+
+// widerScope returns the wider scope of the two.
+func widerScope(s1, s2 string) string {
+	if scopePriority[s2] > scopePriority[s1] {
+		return s2
+	}
+	return s1
+}
@@ -2,30 +2,68 @@ package pom
 
 import (
 	"encoding/xml"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
 
 	"golang.org/x/net/html/charset"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
 )
 
+// ref. https://maven.apache.org/settings.html
 type settings struct {
-	LocalRepository string `xml:"localRepository"`
+	LocalRepository string       `xml:"localRepository"`
+	Mirrors         []mirror     `xml:"mirrors>mirror"`
+	Servers         []server     `xml:"servers>server"`
+	Proxies         []proxy      `xml:"proxies>proxy"`
+	Profiles        []pomProfile `xml:"profiles>profile"`
+	ActiveProfiles  []string     `xml:"activeProfiles>activeProfile"`
+}
+
+type mirror struct {
+	ID       string `xml:"id"`
+	URL      string `xml:"url"`
+	MirrorOf string `xml:"mirrorOf"`
+}
+
+type server struct {
+	ID       string `xml:"id"`
+	Username string `xml:"username"`
+	Password string `xml:"password"`
+}
+
+type proxy struct {
+	ID            string `xml:"id"`
+	Active        string `xml:"active"`
+	Protocol      string `xml:"protocol"`
+	Host          string `xml:"host"`
+	Port          string `xml:"port"`
+	Username      string `xml:"username"`
+	Password      string `xml:"password"`
+	NonProxyHosts string `xml:"nonProxyHosts"`
 }
 
+// readSettings reads the user settings and the global settings. The user settings take precedence.
 func readSettings() settings {
 	userSettingsPath := filepath.Join(os.Getenv("HOME"), ".m2", "settings.xml")
 	userSettings, err := openSettings(userSettingsPath)
-	if err == nil && userSettings.LocalRepository != "" {
-		return userSettings
+	if err != nil {
+		log.Logger.Debugf("Unable to read the user settings: %s", err)
 	}
 
 	globalSettingsPath := filepath.Join(os.Getenv("MAVEN_HOME"), "conf", "settings.xml")
 	globalSettings, err := openSettings(globalSettingsPath)
-	if err == nil && globalSettings.LocalRepository != "" {
-		return globalSettings
+	if err != nil {
+		log.Logger.Debugf("Unable to read the global settings: %s", err)
 	}
 
-	return settings{}
+	return userSettings.merge(globalSettings)
 }
 
 func openSettings(filePath string) (settings, error) {
@@ -33,12 +71,197 @@ func openSettings(filePath string) (settings, error) {
 	if err != nil {
 		return settings{}, err
 	}
+	defer f.Close()
+
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return settings{}, err
+	}
 
 	s := settings{}
-	decoder := xml.NewDecoder(f)
+	decoder := xml.NewDecoder(strings.NewReader(interpolateSettings(string(b))))
 	decoder.CharsetReader = charset.NewReaderLabel
 	if err = decoder.Decode(&s); err != nil {
 		return settings{}, err
 	}
 	return s, nil
 }
+
+// interpolateSettings evaluates environment variables and ${user.home}. Other variables are left as they are.
+// e.g. ${env.NEXUS_PASSWORD}
+func interpolateSettings(s string) string {
+	return varRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		name := varRegexp.FindStringSubmatch(v)[1]
+		switch {
+		case strings.HasPrefix(name, "env."):
+			return os.Getenv(strings.TrimPrefix(name, "env."))
+		case name == "user.home":
+			if home, err := os.UserHomeDir(); err == nil {
+				return home
+			}
+		}
+		return v
+	})
+}
+
+// merge merges the global settings into the user settings.
+// Elements with the same ID in the user settings take precedence.
+func (s settings) merge(global settings) settings {
+	if s.LocalRepository == "" {
+		s.LocalRepository = global.LocalRepository
+	}
+
+	ids := map[string]struct{}{}
+	seen := func(kind, id string) bool {
+		key := kind + ":" + id
+		if _, ok := ids[key]; ok {
+			return true
+		}
+		ids[key] = struct{}{}
+		return false
+	}
+
+	var mirrors []mirror
+	for _, m := range append(s.Mirrors, global.Mirrors...) {
+		if !seen("mirror", m.ID) {
+			mirrors = append(mirrors, m)
+		}
+	}
+	var servers []server
+	for _, srv := range append(s.Servers, global.Servers...) {
+		if !seen("server", srv.ID) {
+			servers = append(servers, srv)
+		}
+	}
+	var proxies []proxy
+	for _, p := range append(s.Proxies, global.Proxies...) {
+		if !seen("proxy", p.ID) {
+			proxies = append(proxies, p)
+		}
+	}
+	var profiles []pomProfile
+	for _, p := range append(s.Profiles, global.Profiles...) {
+		if !seen("profile", p.ID) {
+			profiles = append(profiles, p)
+		}
+	}
+	var activeProfiles []string
+	for _, id := range append(s.ActiveProfiles, global.ActiveProfiles...) {
+		if !seen("activeProfile", id) {
+			activeProfiles = append(activeProfiles, id)
+		}
+	}
+
+	s.Mirrors, s.Servers, s.Proxies, s.Profiles, s.ActiveProfiles = mirrors, servers, proxies, profiles, activeProfiles
+	return s
+}
+
+// findMirror returns the mirror of the repository. A mirror of the exact ID takes precedence over patterns.
+// ref. https://maven.apache.org/guides/mini/guide-mirror-settings.html
+func (s settings) findMirror(repo remoteRepository) (mirror, bool) {
+	if repo.ID != "" {
+		for _, m := range s.Mirrors {
+			if strings.TrimSpace(m.MirrorOf) == repo.ID {
+				return m, true
+			}
+		}
+	}
+	for _, m := range s.Mirrors {
+		if matchMirrorOf(m.MirrorOf, repo) {
+			return m, true
+		}
+	}
+	return mirror{}, false
+}
+
+// matchMirrorOf matches the repository with the patterns such as "*", "external:*", "repo1,repo2" and "*,!repo1".
+func matchMirrorOf(mirrorOf string, repo remoteRepository) bool {
+	var matched bool
+	for _, pattern := range strings.Split(mirrorOf, ",") {
+		pattern = strings.TrimSpace(pattern)
+		switch {
+		case strings.HasPrefix(pattern, "!"):
+			if pattern[1:] == repo.ID {
+				return false
+			}
+		case pattern == "*":
+			matched = true
+		case pattern == "external:*":
+			matched = matched || isExternalRepository(repo.URL)
+		case pattern == "external:http:*":
+			matched = matched || (isExternalRepository(repo.URL) && strings.HasPrefix(repo.URL, "http:"))
+		case pattern == repo.ID && repo.ID != "":
+			matched = true
+		}
+	}
+	return matched
+}
+
+// isExternalRepository returns false for repositories on localhost and file repositories.
+func isExternalRepository(repoURL string) bool {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return false
+	}
+	host := u.Hostname()
+	return u.Scheme != "file" && host != "localhost" && host != "127.0.0.1" && host != "::1"
+}
+
+func (s settings) findServer(id string) (server, bool) {
+	for _, srv := range s.Servers {
+		if id != "" && srv.ID == id {
+			return srv, true
+		}
+	}
+	return server{}, false
+}
+
+// proxyFunc returns the proxy of http.Transport. The first active proxy for the protocol is used.
+// Proxies in the environment variables are used if settings.xml has no active proxy.
+func (s settings) proxyFunc() func(*http.Request) (*url.URL, error) {
+	return func(req *http.Request) (*url.URL, error) {
+		for _, p := range s.Proxies {
+			if p.Active == "false" || !p.matchProtocol(req.URL.Scheme) {
+				continue
+			}
+			if p.isNonProxyHost(req.URL.Hostname()) {
+				return nil, nil
+			}
+			return p.url(), nil
+		}
+		return http.ProxyFromEnvironment(req)
+	}
+}
+
+// matchProtocol returns true if the proxy is for the scheme. HTTP proxies are also used for HTTPS.
+func (p proxy) matchProtocol(scheme string) bool {
+	protocol := strings.ToLower(p.Protocol)
+	if protocol == "" {
+		protocol = "http"
+	}
+	return protocol == scheme || (protocol == "http" && scheme == "https")
+}
+
+// isNonProxyHost matches the host with the patterns separated by "|". e.g. "*.example.com|localhost"
+func (p proxy) isNonProxyHost(host string) bool {
+	for _, pattern := range strings.FieldsFunc(p.NonProxyHosts, func(r rune) bool { return r == '|' || r == ',' }) {
+		if ok, _ := path.Match(strings.ToLower(strings.TrimSpace(pattern)), strings.ToLower(host)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (p proxy) url() *url.URL {
+	u := &url.URL{
+		Scheme: "http",
+		Host:   p.Host,
+	}
+	if p.Port != "" {
+		u.Host = net.JoinHostPort(p.Host, p.Port)
+	}
+	if p.Username != "" {
+		u.User = url.UserPassword(p.Username, p.Password)
+	}
+	return u
+}
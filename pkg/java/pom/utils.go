@@ -1,11 +1,9 @@
 package pom
 
-import "os"
+import "fmt"
 
-func isDirectory(path string) (bool, error) {
-	fileInfo, err := os.Stat(path)
-	if err != nil {
-		return false, err
-	}
-	return fileInfo.IsDir(), err
+// packageID returns the Maven coordinate used as the library ID.
+// e.g. org.example:example-api:1.7.30
+func packageID(name, version string) string {
+	return fmt.Sprintf("%s:%s", name, version)
 }
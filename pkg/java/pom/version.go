@@ -0,0 +1,414 @@
+package pom
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// comparableVersion is a port of Maven's ComparableVersion.
+// ref. https://maven.apache.org/ref/3.8.6/maven-artifact/apidocs/org/apache/maven/artifact/versioning/ComparableVersion.html
+type comparableVersion struct {
+	value string
+	items listItem
+}
+
+func newComparableVersion(s string) comparableVersion {
+	return comparableVersion{
+		value: s,
+		items: parseComparableVersion(s),
+	}
+}
+
+// Compare returns -1, 0 or 1.
+func (v comparableVersion) Compare(o comparableVersion) int {
+	return v.items.compare(o.items)
+}
+
+func (v comparableVersion) String() string {
+	return v.value
+}
+
+// compareVersions compares two versions in the Maven ordering.
+func compareVersions(v1, v2 string) int {
+	return newComparableVersion(v1).Compare(newComparableVersion(v2))
+}
+
+type item interface {
+	// compare compares the item with another item. The other item is nil when it is missing.
+	compare(o item) int
+	isNull() bool
+}
+
+// intItem holds digits without leading zeros so that it doesn't overflow.
+type intItem string
+
+func newIntItem(s string) intItem {
+	return intItem(strings.TrimLeft(s, "0"))
+}
+
+func (i intItem) compare(o item) int {
+	switch o := o.(type) {
+	case nil:
+		if i.isNull() {
+			return 0
+		}
+		return 1
+	case intItem:
+		if len(i) != len(o) {
+			return compareInts(len(i), len(o))
+		}
+		return strings.Compare(string(i), string(o))
+	case stringItem:
+		return 1 // 1.1 > 1-sp
+	case listItem:
+		return 1 // 1.1 > 1-1
+	}
+	return 0
+}
+
+func (i intItem) isNull() bool {
+	return i == ""
+}
+
+var (
+	qualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+	aliases    = map[string]string{
+		"ga":      "",
+		"final":   "",
+		"release": "",
+		"cr":      "rc",
+	}
+	// releaseVersionIndex is the comparable qualifier of releases.
+	releaseVersionIndex = strconv.Itoa(len(qualifiers) - 2)
+)
+
+type stringItem string
+
+func newStringItem(s string, followedByDigit bool) stringItem {
+	if followedByDigit && len(s) == 1 {
+		// a1 = alpha-1, b1 = beta-1, m1 = milestone-1
+		switch s {
+		case "a":
+			s = "alpha"
+		case "b":
+			s = "beta"
+		case "m":
+			s = "milestone"
+		}
+	}
+	if alias, ok := aliases[s]; ok {
+		s = alias
+	}
+	return stringItem(s)
+}
+
+// comparableQualifier returns the index of the well-known qualifiers. Unknown qualifiers are considered
+// after the known ones and ordered lexically. e.g. "0" for alpha, "7-xyz" for xyz
+func comparableQualifier(qualifier string) string {
+	for i, q := range qualifiers {
+		if q == qualifier {
+			return strconv.Itoa(i)
+		}
+	}
+	return strconv.Itoa(len(qualifiers)) + "-" + qualifier
+}
+
+func (s stringItem) compare(o item) int {
+	switch o := o.(type) {
+	case nil:
+		// 1-rc < 1, 1-ga > 1
+		return strings.Compare(comparableQualifier(string(s)), releaseVersionIndex)
+	case intItem:
+		return -1 // 1.any < 1.1
+	case stringItem:
+		return strings.Compare(comparableQualifier(string(s)), comparableQualifier(string(o)))
+	case listItem:
+		return -1 // 1.any < 1-1
+	}
+	return 0
+}
+
+func (s stringItem) isNull() bool {
+	return comparableQualifier(string(s)) == releaseVersionIndex
+}
+
+// listItem represents a sub-list split by "-".
+type listItem []item
+
+func (l listItem) compare(o item) int {
+	switch o := o.(type) {
+	case nil:
+		if len(l) == 0 {
+			return 0
+		}
+		return l[0].compare(nil)
+	case intItem:
+		return -1 // 1-1 < 1.0.x
+	case stringItem:
+		return 1 // 1-1 > 1-sp
+	case listItem:
+		for i := 0; i < len(l) || i < len(o); i++ {
+			var left, right item
+			if i < len(l) {
+				left = l[i]
+			}
+			if i < len(o) {
+				right = o[i]
+			}
+
+			var result int
+			if left == nil {
+				// If this is shorter, then invert the compare and mul with -1
+				if right != nil {
+					result = -1 * right.compare(left)
+				}
+			} else {
+				result = left.compare(right)
+			}
+			if result != 0 {
+				return result
+			}
+		}
+	}
+	return 0
+}
+
+func (l listItem) isNull() bool {
+	return len(l) == 0
+}
+
+// normalize removes trailing null items. e.g. "1.0.0" => "1"
+func (l *listItem) normalize() {
+	for i := len(*l) - 1; i >= 0; i-- {
+		last := (*l)[i]
+		if last.isNull() {
+			*l = append((*l)[:i], (*l)[i+1:]...)
+		} else if _, ok := last.(*listItem); !ok {
+			break
+		}
+	}
+}
+
+func (l *listItem) add(i item) {
+	*l = append(*l, i)
+}
+
+// parseComparableVersion splits the version into items. Sub-lists are held as pointers while parsing
+// so that they can be normalized, and they are dereferenced at the end.
+func parseComparableVersion(version string) listItem {
+	version = strings.ToLower(version)
+
+	root := &listItem{}
+	list := root
+	stack := []*listItem{list}
+
+	newList := func() {
+		l := &listItem{}
+		list.add(l)
+		list = l
+		stack = append(stack, l)
+	}
+
+	isDigit := false
+	startIndex := 0
+	for i := 0; i < len(version); i++ {
+		c := version[i]
+		switch {
+		case c == '.':
+			if i == startIndex {
+				list.add(newIntItem("0"))
+			} else {
+				list.add(parseItem(isDigit, version[startIndex:i]))
+			}
+			startIndex = i + 1
+		case c == '-':
+			if i == startIndex {
+				list.add(newIntItem("0"))
+			} else {
+				list.add(parseItem(isDigit, version[startIndex:i]))
+			}
+			startIndex = i + 1
+			newList()
+		case '0' <= c && c <= '9':
+			if !isDigit && i > startIndex {
+				// e.g. "1.0.0.X1" < "1.0.0-X2"
+				list.add(newStringItem(version[startIndex:i], true))
+				startIndex = i
+				newList()
+			}
+			isDigit = true
+		default:
+			if isDigit && i > startIndex {
+				list.add(parseItem(true, version[startIndex:i]))
+				startIndex = i
+				newList()
+			}
+			isDigit = false
+		}
+	}
+	if len(version) > startIndex {
+		list.add(parseItem(isDigit, version[startIndex:]))
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		stack[i].normalize()
+	}
+	return deref(*root)
+}
+
+func parseItem(isDigit bool, buf string) item {
+	if isDigit {
+		return newIntItem(buf)
+	}
+	return newStringItem(buf, false)
+}
+
+// deref replaces pointers to sub-lists with values.
+func deref(l listItem) listItem {
+	items := make(listItem, 0, len(l))
+	for _, i := range l {
+		if sub, ok := i.(*listItem); ok {
+			i = deref(*sub)
+		}
+		items = append(items, i)
+	}
+	return items
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// restriction is a range of versions. Empty bounds mean no limit.
+type restriction struct {
+	lower          string
+	lowerInclusive bool
+	upper          string
+	upperInclusive bool
+}
+
+func (r restriction) contains(v string) bool {
+	if r.lower != "" {
+		c := compareVersions(v, r.lower)
+		if c < 0 || (c == 0 && !r.lowerInclusive) {
+			return false
+		}
+	}
+	if r.upper != "" {
+		c := compareVersions(v, r.upper)
+		if c > 0 || (c == 0 && !r.upperInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// versionRange is a union of restrictions.
+// e.g. "[1.0,2.0)", "(,1.5]" and "(,1.0],[1.2,)"
+// ref. https://maven.apache.org/enforcer/enforcer-rules/versionRanges.html
+type versionRange []restriction
+
+func isVersionRange(s string) bool {
+	return strings.ContainsAny(s, "[(")
+}
+
+func parseVersionRange(spec string) (versionRange, error) {
+	var r versionRange
+	process := strings.TrimSpace(spec)
+	for strings.HasPrefix(process, "[") || strings.HasPrefix(process, "(") {
+		end := strings.IndexAny(process, "])")
+		if end < 0 {
+			return nil, xerrors.Errorf("unbounded range: %s", spec)
+		}
+		res, err := parseRestriction(process[:end+1])
+		if err != nil {
+			return nil, xerrors.Errorf("invalid range %s: %w", spec, err)
+		}
+		r = append(r, res)
+
+		process = strings.TrimSpace(process[end+1:])
+		if strings.HasPrefix(process, ",") {
+			process = strings.TrimSpace(process[1:])
+		}
+	}
+	if process != "" || len(r) == 0 {
+		return nil, xerrors.Errorf("invalid range: %s", spec)
+	}
+	return r, nil
+}
+
+func parseRestriction(spec string) (restriction, error) {
+	res := restriction{
+		lowerInclusive: strings.HasPrefix(spec, "["),
+		upperInclusive: strings.HasSuffix(spec, "]"),
+	}
+	process := strings.TrimSpace(spec[1 : len(spec)-1])
+
+	lower, upper, ok := strings.Cut(process, ",")
+	if !ok {
+		// Hard requirement. e.g. [1.0]
+		if !res.lowerInclusive || !res.upperInclusive {
+			return restriction{}, xerrors.New("single version must be surrounded by []")
+		}
+		res.lower, res.upper = process, process
+		return res, nil
+	}
+	if strings.Contains(upper, ",") {
+		return restriction{}, xerrors.New("too many bounds")
+	}
+
+	res.lower, res.upper = strings.TrimSpace(lower), strings.TrimSpace(upper)
+	if (res.lower == "" && res.lowerInclusive) || (res.upper == "" && res.upperInclusive) {
+		return restriction{}, xerrors.New("unbounded side must be exclusive")
+	}
+	if res.lower != "" && res.upper != "" && compareVersions(res.upper, res.lower) < 0 {
+		return restriction{}, xerrors.New("lower bound must be less than or equal to upper bound")
+	}
+	return res, nil
+}
+
+func (r versionRange) contains(v string) bool {
+	for _, res := range r {
+		if res.contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// highest returns the highest version in the range.
+// Snapshots are selected only if a bound of the range is a snapshot as Maven does.
+func (r versionRange) highest(versions []string) (string, bool) {
+	snapshot := r.hasSnapshotBound()
+	var highest string
+	for _, v := range versions {
+		if !r.contains(v) || (isSnapshot(v) && !snapshot) {
+			continue
+		}
+		if highest == "" || compareVersions(v, highest) > 0 {
+			highest = v
+		}
+	}
+	return highest, highest != ""
+}
+
+func (r versionRange) hasSnapshotBound() bool {
+	for _, res := range r {
+		if isSnapshot(res.lower) || isSnapshot(res.upper) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSnapshot(v string) bool {
+	return strings.HasSuffix(strings.ToUpper(v), "-SNAPSHOT")
+}
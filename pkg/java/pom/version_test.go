@@ -0,0 +1,115 @@
+package pom
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_compareVersions(t *testing.T) {
+	// Each version is less than the next one.
+	// ref. https://github.com/apache/maven/blob/maven-3.8.6/maven-artifact/src/test/java/org/apache/maven/artifact/versioning/ComparableVersionTest.java
+	tests := []struct {
+		name     string
+		versions []string
+	}{
+		{
+			name: "qualifiers",
+			versions: []string{"1-alpha2snapshot", "1-alpha2", "1-alpha-123", "1-beta-2", "1-beta123", "1-m2", "1-m11",
+				"1-rc", "1-cr2", "1-rc123", "1-SNAPSHOT", "1", "1-sp", "1-sp2", "1-sp123", "1-abc", "1-def", "1-pom-1",
+				"1-1-snapshot", "1-1", "1-2", "1-123"},
+		},
+		{
+			name: "numbers",
+			versions: []string{"2.0", "2-1", "2.0.a", "2.0.0.a", "2.0.2", "2.0.123", "2.1.0", "2.1-a", "2.1b", "2.1-c",
+				"2.1-1", "2.1.0.1", "2.2", "2.123", "11.a2", "11.a11", "11.b2", "11.b11", "11.m2", "11.m11", "11", "11.a",
+				"11b", "11c", "11m"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < len(tt.versions)-1; i++ {
+				low, high := tt.versions[i], tt.versions[i+1]
+				assert.Equal(t, -1, compareVersions(low, high), "%s < %s", low, high)
+				assert.Equal(t, 1, compareVersions(high, low), "%s > %s", high, low)
+			}
+		})
+	}
+
+	equals := [][2]string{
+		{"1", "1.0.0"},
+		{"1-ga", "1"},
+		{"1-final", "1-release"},
+		{"1-cr1", "1-rc1"},
+		{"1a1", "1-alpha-1"},
+		{"1.0.0-SNAPSHOT", "1-snapshot"},
+		{"18446744073709551616", "18446744073709551616.0"},
+	}
+	for _, e := range equals {
+		assert.Equal(t, 0, compareVersions(e[0], e[1]), "%s == %s", e[0], e[1])
+	}
+}
+
+func Test_parseVersionRange(t *testing.T) {
+	versions := []string{"0.9", "1.0", "1.2", "1.5", "1.7.30", "2.0.0", "2.1", "2.2-SNAPSHOT"}
+	tests := []struct {
+		spec        string
+		wantHighest string
+		wantErr     string
+	}{
+		{spec: "[1.0]", wantHighest: "1.0"},
+		{spec: "[1.0,2.0)", wantHighest: "1.7.30"},
+		{spec: "[1.0,2.0.0]", wantHighest: "2.0.0"},
+		{spec: "(,1.5]", wantHighest: "1.5"},
+		{spec: "(,1.5)", wantHighest: "1.2"},
+		{spec: "[1.5,)", wantHighest: "2.1"},
+		{spec: "(,1.0],[1.2,1.5)", wantHighest: "1.2"},
+		{spec: "[3.0,)"},
+		{spec: "[2.1,2.2-SNAPSHOT]", wantHighest: "2.2-SNAPSHOT"},
+		{spec: "(1.0)", wantErr: "single version must be surrounded by []"},
+		{spec: "[2.0,1.0]", wantErr: "lower bound must be less than or equal to upper bound"},
+		{spec: "[,1.0]", wantErr: "unbounded side must be exclusive"},
+		{spec: "[1.0,2.0", wantErr: "unbounded range"},
+		{spec: "[1.0,2.0),1.5", wantErr: "invalid range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			r, err := parseVersionRange(tt.spec)
+			if tt.wantErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got, ok := r.highest(versions)
+			assert.Equal(t, tt.wantHighest != "", ok)
+			assert.Equal(t, tt.wantHighest, got)
+		})
+	}
+}
+
+func Test_parser_fetchVersions(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("MAVEN_HOME", t.TempDir())
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.ServeFile(w, r, filepath.Join("testdata", "repository", "org", "example", "example-api", "maven-metadata.xml"))
+	}))
+	defer ts.Close()
+
+	p := NewParser("pom.xml", WithRemoteRepos([]string{ts.URL}))
+
+	// maven-metadata.xml is fetched only once per artifact.
+	for i := 0; i < 2; i++ {
+		got := p.fetchVersions("org.example", "example-api")
+		assert.Equal(t, []string{"1.7.30", "1.9.0-SNAPSHOT", "2.0.0"}, got)
+	}
+	assert.Equal(t, 1, requests)
+}
@@ -0,0 +1,152 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// WalkFunc is called for every JSON object and array with its path and line range.
+// The path consists of object keys and array indices from the root.
+// e.g. {"dependencies": {"asap": {...}}} => ["dependencies", "asap"]
+type WalkFunc func(path []string, location types.Location)
+
+type frame struct {
+	path    []string
+	start   int64
+	object  bool
+	key     string
+	wantKey bool
+	index   int
+}
+
+// Walk decodes JSON tokens and reports the line range of each object and array.
+// encoding/json doesn't expose positions, so the input offsets are converted into line numbers.
+func Walk(data []byte, fn WalkFunc) error {
+	lines := newlineOffsets(data)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	var stack []*frame
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return xerrors.Errorf("JSON token error: %w", err)
+		}
+
+		// The offset points at the end of the token. Delimiters are one byte.
+		offset := decoder.InputOffset()
+
+		var parent *frame
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1]
+		}
+
+		switch t := token.(type) {
+		case json.Delim:
+			switch t {
+			case '{', '[':
+				path := childPath(parent)
+				stack = append(stack, &frame{
+					path:    path,
+					start:   offset - 1,
+					object:  t == '{',
+					wantKey: t == '{',
+				})
+			case '}', ']':
+				f := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				fn(f.path, types.Location{
+					StartLine: lineNumber(lines, f.start),
+					EndLine:   lineNumber(lines, offset-1),
+				})
+				if len(stack) > 0 {
+					valueDone(stack[len(stack)-1])
+				}
+			}
+		case string:
+			if parent != nil && parent.object && parent.wantKey {
+				parent.key = t
+				parent.wantKey = false
+				continue
+			}
+			valueDone(parent)
+		default:
+			valueDone(parent)
+		}
+	}
+	return nil
+}
+
+func childPath(parent *frame) []string {
+	if parent == nil {
+		return nil
+	}
+	path := make([]string, len(parent.path), len(parent.path)+1)
+	copy(path, parent.path)
+	if parent.object {
+		return append(path, parent.key)
+	}
+	return append(path, strconv.Itoa(parent.index))
+}
+
+func valueDone(f *frame) {
+	if f == nil {
+		return
+	}
+	if f.object {
+		f.wantKey = true
+	} else {
+		f.index++
+	}
+}
+
+func newlineOffsets(data []byte) []int64 {
+	var offsets []int64
+	for i, b := range data {
+		if b == '\n' {
+			offsets = append(offsets, int64(i))
+		}
+	}
+	return offsets
+}
+
+// lineNumber returns the 1-based line number of the offset.
+func lineNumber(newlines []int64, offset int64) int {
+	return sort.Search(len(newlines), func(i int) bool {
+		return newlines[i] >= offset
+	}) + 1
+}
+
+// Pointer converts the path into a JSON Pointer defined in RFC 6901.
+// e.g. ["dependencies", "@babel/core"] => "/dependencies/@babel~1core"
+func Pointer(path []string) string {
+	var b strings.Builder
+	for _, p := range path {
+		b.WriteString("/")
+		b.WriteString(pointerEscaper.Replace(p))
+	}
+	return b.String()
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// Locations returns the line ranges of all objects and arrays keyed by JSON Pointer.
+func Locations(data []byte) (map[string]types.Location, error) {
+	locs := map[string]types.Location{}
+	err := Walk(data, func(path []string, location types.Location) {
+		locs[Pointer(path)] = location
+	})
+	if err != nil {
+		return nil, err
+	}
+	return locs, nil
+}
@@ -0,0 +1,58 @@
+// Package lockfile provides helpers shared by the parsers of the Node.js lock files.
+package lockfile
+
+import (
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// Digests parses "integrity". Invalid ones are ignored as they don't affect the dependencies.
+func Digests(integrity string) []types.Digest {
+	d, err := types.ParseIntegrity(integrity)
+	if err != nil {
+		log.Logger.Debugf("Invalid integrity: %s", err)
+		return nil
+	}
+	return d
+}
+
+// UniqueDeps merges dependencies of the same library, such as the ones installed at different locations.
+// The result is sorted by ID.
+func UniqueDeps(deps []types.Dependency) []types.Dependency {
+	var ids []string
+	dependsOn := map[string][]string{}
+	for _, dep := range deps {
+		if _, ok := dependsOn[dep.ID]; !ok {
+			ids = append(ids, dep.ID)
+		}
+		dependsOn[dep.ID] = append(dependsOn[dep.ID], dep.DependsOn...)
+	}
+	sort.Strings(ids)
+
+	var uniqDeps []types.Dependency
+	for _, id := range ids {
+		depIDs := utils.UniqueStrings(dependsOn[id])
+		sort.Strings(depIDs)
+		uniqDeps = append(uniqDeps, types.Dependency{
+			ID:        id,
+			DependsOn: depIDs,
+		})
+	}
+	return uniqDeps
+}
+
+// LastLine returns the last line of the YAML node and its children, or the given line if it is larger.
+func LastLine(n *yaml.Node, line int) int {
+	if n.Line > line {
+		line = n.Line
+	}
+	for _, c := range n.Content {
+		line = LastLine(c, line)
+	}
+	return line
+}
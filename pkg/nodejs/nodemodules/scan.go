@@ -0,0 +1,139 @@
+package nodemodules
+
+import (
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/packagejson"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// virtualStore is the directory where pnpm installs packages.
+// e.g. node_modules/.pnpm/react@17.0.2/node_modules/react
+const virtualStore = ".pnpm"
+
+// Package is a package installed in node_modules.
+type Package struct {
+	types.Library
+	// Path is the slash-separated directory of the package in the file system.
+	// e.g. node_modules/@babel/core/node_modules/semver
+	Path string
+}
+
+// Scan returns all the packages installed under the node_modules directory, including nested and scoped ones.
+// Symbolic links are not followed since pnpm links packages installed in the virtual store, which is scanned directly.
+// Directories without a valid package.json are skipped.
+func Scan(fsys fs.FS, dir string) ([]Package, error) {
+	if _, err := fs.Stat(fsys, dir); err != nil {
+		return nil, xerrors.Errorf("stat error: %w", err)
+	}
+
+	var pkgs []Package
+	if err := scan(fsys, dir, &pkgs); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+
+	sort.Slice(pkgs, func(i, j int) bool {
+		return pkgs[i].Path < pkgs[j].Path
+	})
+	return pkgs, nil
+}
+
+// scan reads a node_modules directory.
+func scan(fsys fs.FS, dir string, pkgs *[]Package) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return xerrors.Errorf("read dir error: %w", err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		switch {
+		case name == virtualStore:
+			if err = scanVirtualStore(fsys, path.Join(dir, name), pkgs); err != nil {
+				return err
+			}
+		case strings.HasPrefix(name, "."):
+			// e.g. .bin and .cache
+			continue
+		case strings.HasPrefix(name, "@"):
+			// Scoped packages. e.g. node_modules/@babel/core
+			scoped, err := fs.ReadDir(fsys, path.Join(dir, name))
+			if err != nil {
+				return xerrors.Errorf("read dir error: %w", err)
+			}
+			for _, s := range scoped {
+				if !s.IsDir() {
+					continue
+				}
+				if err = scanPackage(fsys, path.Join(dir, name, s.Name()), pkgs); err != nil {
+					return err
+				}
+			}
+		default:
+			if err = scanPackage(fsys, path.Join(dir, name), pkgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanVirtualStore reads node_modules in each directory of the virtual store.
+// e.g. node_modules/.pnpm/react-dom@17.0.2_react@17.0.2/node_modules
+func scanVirtualStore(fsys fs.FS, dir string, pkgs *[]Package) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return xerrors.Errorf("read dir error: %w", err)
+	}
+	for _, entry := range entries {
+		nodeModules := path.Join(dir, entry.Name(), "node_modules")
+		if !entry.IsDir() || !isDir(fsys, nodeModules) {
+			continue
+		}
+		if err = scan(fsys, nodeModules, pkgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanPackage reads package.json of the package and its nested node_modules.
+func scanPackage(fsys fs.FS, dir string, pkgs *[]Package) error {
+	f, err := fsys.Open(path.Join(dir, "package.json"))
+	if err != nil {
+		log.Logger.Debugf("Unable to open package.json (%s): %s", dir, err)
+		return nil
+	}
+	lib, err := packagejson.Parse(f)
+	_ = f.Close()
+	if err != nil {
+		log.Logger.Debugf("Unable to parse package.json (%s): %s", dir, err)
+		return nil
+	}
+
+	lib.ID = utils.PackageID(lib.Name, lib.Version)
+	*pkgs = append(*pkgs, Package{
+		Library: lib,
+		Path:    dir,
+	})
+
+	if nested := path.Join(dir, "node_modules"); isDir(fsys, nested) {
+		return scan(fsys, nested, pkgs)
+	}
+	return nil
+}
+
+func isDir(fsys fs.FS, name string) bool {
+	info, err := fs.Stat(fsys, name)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,83 @@
+package nodemodules_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/nodemodules"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		dir     string
+		want    []nodemodules.Package
+		wantErr string
+	}{
+		{
+			name: "nested and scoped packages",
+			dir:  "npm/node_modules",
+			want: []nodemodules.Package{
+				{
+					Library: types.Library{ID: "@babel/core@7.21.0", Name: "@babel/core", Version: "7.21.0", License: "MIT"},
+					Path:    "npm/node_modules/@babel/core",
+				},
+				{
+					Library: types.Library{ID: "semver@6.3.0", Name: "semver", Version: "6.3.0", License: "ISC"},
+					Path:    "npm/node_modules/@babel/core/node_modules/semver",
+				},
+				{
+					Library: types.Library{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9", License: "MIT"},
+					Path:    "npm/node_modules/debug",
+				},
+				{
+					Library: types.Library{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0", License: "MIT"},
+					Path:    "npm/node_modules/debug/node_modules/ms",
+				},
+				{
+					Library: types.Library{ID: "ms@2.1.3", Name: "ms", Version: "2.1.3", License: "MIT"},
+					Path:    "npm/node_modules/ms",
+				},
+			},
+		},
+		{
+			name: "pnpm virtual store",
+			dir:  "pnpm/node_modules",
+			want: []nodemodules.Package{
+				{
+					Library: types.Library{ID: "@types/node@18.15.0", Name: "@types/node", Version: "18.15.0", License: "MIT"},
+					Path:    "pnpm/node_modules/.pnpm/@types+node@18.15.0/node_modules/@types/node",
+				},
+				{
+					Library: types.Library{ID: "loose-envify@1.4.0", Name: "loose-envify", Version: "1.4.0", License: "MIT"},
+					Path:    "pnpm/node_modules/.pnpm/loose-envify@1.4.0/node_modules/loose-envify",
+				},
+				{
+					Library: types.Library{ID: "react@17.0.2", Name: "react", Version: "17.0.2", License: "MIT"},
+					Path:    "pnpm/node_modules/.pnpm/react@17.0.2/node_modules/react",
+				},
+			},
+		},
+		{
+			name:    "missing directory",
+			dir:     "missing/node_modules",
+			wantErr: "stat error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nodemodules.Scan(os.DirFS("testdata"), tt.dir)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,60 +3,307 @@ package npm
 import (
 	"encoding/json"
 	"io"
+	"path"
+	"sort"
+	"strings"
 
-	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"golang.org/x/exp/maps"
 	"golang.org/x/xerrors"
+
+	djson "github.com/aquasecurity/go-dep-parser/pkg/json"
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/internal/lockfile"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 type LockFile struct {
-	Dependencies map[string]Dependency
+	LockfileVersion int
+	Dependencies    map[string]Dependency
+
+	// Packages is available in lockfileVersion 2 and later.
+	// The keys are the install locations. e.g. "node_modules/debug/node_modules/ms"
+	Packages map[string]Package
 }
 type Dependency struct {
 	Version      string
+	Resolved     string
+	Integrity    string
 	Dev          bool
 	Dependencies map[string]Dependency
+	Requires     map[string]string
+}
+
+// Package is an entry of the "packages" section.
+// ref. https://docs.npmjs.com/cli/v9/configuring-npm/package-lock-json#packages
+type Package struct {
+	// Name is set when it differs from the install location. e.g. aliased packages
+	Name      string
+	Version   string
+	Resolved  string
+	Integrity string
+
+	// Dev is true when the package is only a dependency of devDependencies.
+	Dev bool
+	// Optional is true when the package is only an optional dependency.
+	Optional bool
+	// DevOptional is true when the package is a dev dependency and an optional dependency of a non-dev dependency.
+	DevOptional bool
+	// Peer is true when the package is only a peer dependency.
+	Peer bool
+
+	// Link is true for symbolic links such as workspaces. "Resolved" is the location of the link target.
+	Link bool
+
+	Dependencies         map[string]string
+	OptionalDependencies map[string]string
+	PeerDependencies     map[string]string
+	DevDependencies      map[string]string
 }
 
-func Parse(r io.Reader) ([]types.Library, error) {
+const nodeModules = "node_modules"
+
+func Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("read error: %w", err)
+	}
+
 	var lockFile LockFile
-	decoder := json.NewDecoder(r)
-	err := decoder.Decode(&lockFile)
+	if err = json.Unmarshal(data, &lockFile); err != nil {
+		return nil, nil, xerrors.Errorf("decode error: %w", err)
+	}
+
+	locations, err := djson.Locations(data)
 	if err != nil {
-		return nil, xerrors.Errorf("decode error: %w", err)
+		return nil, nil, xerrors.Errorf("location error: %w", err)
 	}
 
-	libs := parse(lockFile.Dependencies)
-	return unique(libs), nil
+	p := parser{locations: locations}
+
+	var libs []types.Library
+	var deps []types.Dependency
+	if lockFile.LockfileVersion >= 2 && len(lockFile.Packages) > 0 {
+		libs, deps = p.parsePackages(lockFile.Packages)
+	} else {
+		libs, deps = p.parse(lockFile.Dependencies, []string{"dependencies"}, map[string]string{})
+	}
+	return unique(libs), lockfile.UniqueDeps(deps), nil
+}
+
+type parser struct {
+	// JSON Pointer => line range
+	locations map[string]types.Location
 }
 
-func parse(dependencies map[string]Dependency) []types.Library {
+// parse walks the nested dependencies. "versions" holds the versions visible from the current
+// level so that "requires" can be resolved the same way as the Node.js module resolution.
+func (p parser) parse(dependencies map[string]Dependency, path []string, versions map[string]string) ([]types.Library, []types.Dependency) {
+	// Nested versions take precedence over the ones in the upper levels.
+	for pkgName, dependency := range dependencies {
+		versions[pkgName] = dependency.Version
+	}
+
 	var libs []types.Library
+	var deps []types.Dependency
 	for pkgName, dependency := range dependencies {
 		if dependency.Dev {
 			continue
 		}
 
-		libs = append(libs, types.Library{
-			Name:    pkgName,
-			Version: dependency.Version,
-		})
+		pkgPath := append(append([]string{}, path...), pkgName)
+		lib := types.Library{
+			ID:       utils.PackageID(pkgName, dependency.Version),
+			Name:     pkgName,
+			Version:  dependency.Version,
+			Resolved: dependency.Resolved,
+			Digests:  lockfile.Digests(dependency.Integrity),
+		}
+		if loc, ok := p.locations[djson.Pointer(pkgPath)]; ok {
+			lib.Locations = types.Locations{loc}
+		}
+		libs = append(libs, lib)
+
+		var dependsOn []string
+		for name, constraint := range dependency.Requires {
+			// Try to resolve the version with nested dependencies first
+			if nested, ok := dependency.Dependencies[name]; ok {
+				dependsOn = append(dependsOn, utils.PackageID(name, nested.Version))
+				continue
+			}
+
+			// Then try the upper levels
+			if ver, ok := versions[name]; ok {
+				dependsOn = append(dependsOn, utils.PackageID(name, ver))
+				continue
+			}
+
+			log.Logger.Debugf("Unable to resolve the dependency: %s@%s", name, constraint)
+		}
+
+		if len(dependsOn) > 0 {
+			sort.Strings(dependsOn)
+			deps = append(deps, types.Dependency{
+				ID:        lib.ID,
+				DependsOn: dependsOn,
+			})
+		}
 
 		if dependency.Dependencies != nil {
 			// Recursion
-			libs = append(libs, parse(dependency.Dependencies)...)
+			childPath := append(append([]string{}, pkgPath...), "dependencies")
+			childLibs, childDeps := p.parse(dependency.Dependencies, childPath, maps.Clone(versions))
+			libs = append(libs, childLibs...)
+			deps = append(deps, childDeps...)
+		}
+	}
+	return libs, deps
+}
+
+// parsePackages parses the "packages" section of lockfileVersion 2 and 3.
+// Dev packages are skipped in the same way as "dependencies", while optional, devOptional and peer
+// packages are installed by default and returned. Dependencies of the root project and workspaces
+// are direct and the others are indirect.
+func (p parser) parsePackages(packages map[string]Package) ([]types.Library, []types.Dependency) {
+	// Install location => library ID
+	ids := map[string]string{}
+	for location, pkg := range packages {
+		if location == "" || pkg.Link || pkg.Dev {
+			continue
+		}
+		ids[location] = utils.PackageID(packageName(location, pkg), pkg.Version)
+	}
+
+	// The root project and workspaces
+	projects := []string{""}
+	for _, pkg := range packages {
+		if pkg.Link && !strings.HasPrefix(pkg.Resolved, nodeModules+"/") &&
+			!strings.Contains(pkg.Resolved, "/"+nodeModules+"/") {
+			projects = append(projects, pkg.Resolved)
+		}
+	}
+	direct := map[string]struct{}{}
+	for _, project := range projects {
+		if project != "" {
+			direct[project] = struct{}{}
+		}
+		for _, location := range p.resolveDependencies(packages, project, packages[project]) {
+			direct[location] = struct{}{}
 		}
 	}
-	return libs
+
+	var libs []types.Library
+	var deps []types.Dependency
+	for location, id := range ids {
+		pkg := packages[location]
+		_, isDirect := direct[location]
+		lib := types.Library{
+			ID:       id,
+			Name:     packageName(location, pkg),
+			Version:  pkg.Version,
+			Indirect: !isDirect,
+			Resolved: pkg.Resolved,
+			Digests:  lockfile.Digests(pkg.Integrity),
+		}
+		if loc, ok := p.locations[djson.Pointer([]string{"packages", location})]; ok {
+			lib.Locations = types.Locations{loc}
+		}
+		libs = append(libs, lib)
+
+		var dependsOn []string
+		for _, depLocation := range p.resolveDependencies(packages, location, pkg) {
+			if depID, ok := ids[depLocation]; ok {
+				dependsOn = append(dependsOn, depID)
+			}
+		}
+		if len(dependsOn) > 0 {
+			sort.Strings(dependsOn)
+			deps = append(deps, types.Dependency{
+				ID:        id,
+				DependsOn: utils.UniqueStrings(dependsOn),
+			})
+		}
+	}
+	return libs, deps
 }
 
+// resolveDependencies returns the install locations of the dependencies.
+// Dev dependencies are not resolved since dev packages are skipped.
+func (p parser) resolveDependencies(packages map[string]Package, location string, pkg Package) []string {
+	var locations []string
+	for _, dependencies := range []map[string]string{pkg.Dependencies, pkg.OptionalDependencies, pkg.PeerDependencies} {
+		for name, constraint := range dependencies {
+			depLocation, ok := resolveLocation(packages, location, name)
+			if !ok {
+				// Optional and peer dependencies may not be installed.
+				log.Logger.Debugf("Unable to resolve the dependency: %s@%s", name, constraint)
+				continue
+			}
+			locations = append(locations, depLocation)
+		}
+	}
+	return locations
+}
+
+// resolveLocation finds the install location in the same way as the Node.js module resolution.
+// e.g. "ms" required by "node_modules/debug" is looked up in "node_modules/debug/node_modules/ms",
+// and then "node_modules/ms".
+func resolveLocation(packages map[string]Package, location, name string) (string, bool) {
+	for {
+		candidate := path.Join(location, nodeModules, name)
+		if pkg, ok := packages[candidate]; ok {
+			if pkg.Link {
+				return pkg.Resolved, true
+			}
+			return candidate, true
+		}
+		if location == "" {
+			return "", false
+		}
+
+		// Move up to the parent package.
+		// e.g. "node_modules/a/node_modules/b" => "node_modules/a"
+		i := strings.LastIndex(location, nodeModules+"/")
+		if i <= 0 {
+			location = ""
+		} else {
+			location = strings.TrimSuffix(location[:i], "/")
+		}
+	}
+}
+
+// packageName returns the "name" field of aliased packages and workspaces,
+// or the name from the install location.
+// e.g. "node_modules/@babel/core" => "@babel/core"
+func packageName(location string, pkg Package) string {
+	if pkg.Name != "" {
+		return pkg.Name
+	}
+	if i := strings.LastIndex(location, nodeModules+"/"); i >= 0 {
+		return location[i+len(nodeModules)+1:]
+	}
+	return path.Base(location)
+}
+
+// unique merges libraries installed at different locations.
 func unique(libs []types.Library) []types.Library {
 	var uniqLibs []types.Library
-	unique := map[types.Library]struct{}{}
+	index := map[string]int{}
 	for _, lib := range libs {
-		if _, ok := unique[lib]; !ok {
-			unique[lib] = struct{}{}
+		i, ok := index[lib.ID]
+		if !ok {
+			index[lib.ID] = len(uniqLibs)
 			uniqLibs = append(uniqLibs, lib)
+			continue
 		}
+		uniqLibs[i].Locations = append(uniqLibs[i].Locations, lib.Locations...)
+		// Direct if any of them is direct
+		uniqLibs[i].Indirect = uniqLibs[i].Indirect && lib.Indirect
+	}
+	for _, lib := range uniqLibs {
+		sort.Slice(lib.Locations, func(i, j int) bool {
+			return lib.Locations[i].StartLine < lib.Locations[j].StartLine
+		})
 	}
 	return uniqLibs
 }
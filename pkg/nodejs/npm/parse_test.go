@@ -15,28 +15,39 @@ import (
 
 func TestParse(t *testing.T) {
 	vectors := []struct {
-		file string // Test input file
-		want []types.Library
+		file     string // Test input file
+		want     []types.Library
+		wantDeps []types.Dependency
 	}{
 		{
-			file: "testdata/package-lock_normal.json",
-			want: npmNormal,
+			file:     "testdata/package-lock_normal.json",
+			want:     npmNormal,
+			wantDeps: npmNormalDeps,
 		},
 		{
-			file: "testdata/package-lock_react.json",
-			want: npmReact,
+			file:     "testdata/package-lock_react.json",
+			want:     npmReact,
+			wantDeps: npmReactDeps,
 		},
 		{
-			file: "testdata/package-lock_with_dev.json",
-			want: npmWithDev,
+			file:     "testdata/package-lock_with_dev.json",
+			want:     npmWithDev,
+			wantDeps: npmWithDevDeps,
 		},
 		{
-			file: "testdata/package-lock_many.json",
-			want: npmMany,
+			file:     "testdata/package-lock_many.json",
+			want:     npmMany,
+			wantDeps: npmManyDeps,
 		},
 		{
-			file: "testdata/package-lock_nested.json",
-			want: npmNested,
+			file:     "testdata/package-lock_nested.json",
+			want:     npmNested,
+			wantDeps: npmNestedDeps,
+		},
+		{
+			file:     "testdata/package-lock_v3.json",
+			want:     npmV3,
+			wantDeps: npmV3Deps,
 		},
 	}
 
@@ -45,13 +56,18 @@ func TestParse(t *testing.T) {
 			f, err := os.Open(v.file)
 			require.NoError(t, err)
 
-			got, err := Parse(f)
+			got, deps, err := Parse(f)
 			require.NoError(t, err)
 
 			sortLibs(got)
 			sortLibs(v.want)
 
 			assert.Equal(t, v.want, got)
+
+			sort.Slice(deps, func(i, j int) bool {
+				return deps[i].ID < deps[j].ID
+			})
+			assert.Equal(t, v.wantDeps, deps)
 		})
 	}
 }
@@ -8,9 +8,9 @@ var (
 	// npm install --save promise jquery
 	// npm ls | grep -E -o "\S+@\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}'
 	npmNormal = []types.Library{
-		{Name: "asap", Version: "2.0.6"},
-		{Name: "jquery", Version: "3.4.0"},
-		{Name: "promise", Version: "8.0.3"},
+		{ID: "asap@2.0.6", Name: "asap", Version: "2.0.6", Locations: types.Locations{{StartLine: 6, EndLine: 10}}, Resolved: "https://registry.npmjs.org/asap/-/asap-2.0.6.tgz", Digests: []types.Digest{"sha1:e50347611d7e690943208bbdafebcbc2fb866d46"}},
+		{ID: "jquery@3.4.0", Name: "jquery", Version: "3.4.0", Locations: types.Locations{{StartLine: 11, EndLine: 15}}, Resolved: "https://registry.npmjs.org/jquery/-/jquery-3.4.0.tgz", Digests: []types.Digest{"sha512:8204425e59fdcc4aafe8ea8019714472c845e5d481bc29338fa1a6da0cee4797d66b0697f2dedcc4a56493280a383ac303329da18c3797f7b7a66def953e086d"}},
+		{ID: "promise@8.0.3", Name: "promise", Version: "8.0.3", Locations: types.Locations{{StartLine: 16, EndLine: 23}}, Resolved: "https://registry.npmjs.org/promise/-/promise-8.0.3.tgz", Digests: []types.Digest{"sha512:1de44350bd512622e1c80d3f82b9fe3d34a194101c2ee87fd41246b6bbe3c1bbd10c24cb2cc133f6b386095f91def1d8e0c8b122ea0c11df58abf5efb133dc8f"}},
 	}
 
 	// docker run --name node --rm -it node:12-alpine sh
@@ -18,18 +18,18 @@ var (
 	// npm install --save react redux
 	// npm ls | grep -E -o "\S+@\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}'
 	npmReact = []types.Library{
-		{Name: "asap", Version: "2.0.6"},
-		{Name: "jquery", Version: "3.4.0"},
-		{Name: "js-tokens", Version: "4.0.0"},
-		{Name: "loose-envify", Version: "1.4.0"},
-		{Name: "object-assign", Version: "4.1.1"},
-		{Name: "promise", Version: "8.0.3"},
-		{Name: "prop-types", Version: "15.7.2"},
-		{Name: "react", Version: "16.8.6"},
-		{Name: "react-is", Version: "16.8.6"},
-		{Name: "redux", Version: "4.0.1"},
-		{Name: "scheduler", Version: "0.13.6"},
-		{Name: "symbol-observable", Version: "1.2.0"},
+		{ID: "asap@2.0.6", Name: "asap", Version: "2.0.6", Locations: types.Locations{{StartLine: 6, EndLine: 10}}, Resolved: "https://registry.npmjs.org/asap/-/asap-2.0.6.tgz", Digests: []types.Digest{"sha1:e50347611d7e690943208bbdafebcbc2fb866d46"}},
+		{ID: "jquery@3.4.0", Name: "jquery", Version: "3.4.0", Locations: types.Locations{{StartLine: 11, EndLine: 15}}, Resolved: "https://registry.npmjs.org/jquery/-/jquery-3.4.0.tgz", Digests: []types.Digest{"sha512:8204425e59fdcc4aafe8ea8019714472c845e5d481bc29338fa1a6da0cee4797d66b0697f2dedcc4a56493280a383ac303329da18c3797f7b7a66def953e086d"}},
+		{ID: "js-tokens@4.0.0", Name: "js-tokens", Version: "4.0.0", Locations: types.Locations{{StartLine: 16, EndLine: 20}}, Resolved: "https://registry.npmjs.org/js-tokens/-/js-tokens-4.0.0.tgz", Digests: []types.Digest{"sha512:45d2547e5704ddc5332a232a420b02bb4e853eef5474824ed1b7986cf84737893a6a9809b627dca02b53f5b7313a9601b690f690233a49bce0e026aeb16fcf29"}},
+		{ID: "loose-envify@1.4.0", Name: "loose-envify", Version: "1.4.0", Locations: types.Locations{{StartLine: 21, EndLine: 28}}, Resolved: "https://registry.npmjs.org/loose-envify/-/loose-envify-1.4.0.tgz", Digests: []types.Digest{"sha512:972bb13c6aff59f86b95e9b608bfd472751cd7372a280226043cee918ed8e45ff242235d928ebe7d12debe5c351e03324b0edfeb5d54218e34f04b71452a0add"}},
+		{ID: "object-assign@4.1.1", Name: "object-assign", Version: "4.1.1", Locations: types.Locations{{StartLine: 29, EndLine: 33}}, Resolved: "https://registry.npmjs.org/object-assign/-/object-assign-4.1.1.tgz", Digests: []types.Digest{"sha1:2109adc7965887cfc05cbbd442cac8bfbb360863"}},
+		{ID: "promise@8.0.3", Name: "promise", Version: "8.0.3", Locations: types.Locations{{StartLine: 34, EndLine: 41}}, Resolved: "https://registry.npmjs.org/promise/-/promise-8.0.3.tgz", Digests: []types.Digest{"sha512:1de44350bd512622e1c80d3f82b9fe3d34a194101c2ee87fd41246b6bbe3c1bbd10c24cb2cc133f6b386095f91def1d8e0c8b122ea0c11df58abf5efb133dc8f"}},
+		{ID: "prop-types@15.7.2", Name: "prop-types", Version: "15.7.2", Locations: types.Locations{{StartLine: 42, EndLine: 51}}, Resolved: "https://registry.npmjs.org/prop-types/-/prop-types-15.7.2.tgz", Digests: []types.Digest{"sha512:f1042291d1fbfff476beeac8252bad675b261d84dc2e945610e9479f37161e6058ace194cac2b04acac2f3d0428858f709badf27f9d715d25ea4e56b6351821d"}},
+		{ID: "react@16.8.6", Name: "react", Version: "16.8.6", Locations: types.Locations{{StartLine: 52, EndLine: 62}}, Resolved: "https://registry.npmjs.org/react/-/react-16.8.6.tgz", Digests: []types.Digest{"sha512:a42d2e32484b6879b5d5948950b7ce06a578b48664c7ced92ef6db418ba7362c4002f8e70beb272428345d05e7f5522cb6d56c6d93ff1ff7b0ce0b1de5fc4a5f"}},
+		{ID: "react-is@16.8.6", Name: "react-is", Version: "16.8.6", Locations: types.Locations{{StartLine: 63, EndLine: 67}}, Resolved: "https://registry.npmjs.org/react-is/-/react-is-16.8.6.tgz", Digests: []types.Digest{"sha512:6949376c77d9d9b45254515b6de552e22fa534f66bdff58ce634f6279a26515575cf372cd6701a7f7979d5cb40e4516f0916e1ac7d1b740b1145075d14964fb4"}},
+		{ID: "redux@4.0.1", Name: "redux", Version: "4.0.1", Locations: types.Locations{{StartLine: 68, EndLine: 76}}, Resolved: "https://registry.npmjs.org/redux/-/redux-4.0.1.tgz", Digests: []types.Digest{"sha512:47b6c0b52924ee763a3bf39831547d462048f978218c5f6b95b979f34e8725b4298742c954766b539a1a3b8abbd1e50a8aa311aa6e32d3b28b4e5319d819559a"}},
+		{ID: "scheduler@0.13.6", Name: "scheduler", Version: "0.13.6", Locations: types.Locations{{StartLine: 77, EndLine: 85}}, Resolved: "https://registry.npmjs.org/scheduler/-/scheduler-0.13.6.tgz", Digests: []types.Digest{"sha512:2169ce6c7b78d77b9c0182ac0fd2754128542a46ca2d0407771472c3bdecc3814ddba896af70d8fc7df8c463dee2798bd43c17c969925a632b03d4df41b13f5d"}},
+		{ID: "symbol-observable@1.2.0", Name: "symbol-observable", Version: "1.2.0", Locations: types.Locations{{StartLine: 86, EndLine: 90}}, Resolved: "https://registry.npmjs.org/symbol-observable/-/symbol-observable-1.2.0.tgz", Digests: []types.Digest{"sha512:7bdd349ccf1146d1a1955dfa286114f64eb92b798f6f5595ef439d8dfc651b6100b8cd67a22fc4fc1696fee3212fb6cf12cb0af10579eef3777ac8b18d4bdc5d"}},
 	}
 
 	// docker run --name node --rm -it node:12-alpine sh
@@ -38,18 +38,18 @@ var (
 	// npm install --save-dev mocha
 	// npm ls -prod | grep -E -o "\S+@\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}'
 	npmWithDev = []types.Library{
-		{Name: "asap", Version: "2.0.6"},
-		{Name: "jquery", Version: "3.4.0"},
-		{Name: "js-tokens", Version: "4.0.0"},
-		{Name: "loose-envify", Version: "1.4.0"},
-		{Name: "object-assign", Version: "4.1.1"},
-		{Name: "promise", Version: "8.0.3"},
-		{Name: "prop-types", Version: "15.7.2"},
-		{Name: "react", Version: "16.8.6"},
-		{Name: "react-is", Version: "16.8.6"},
-		{Name: "redux", Version: "4.0.1"},
-		{Name: "scheduler", Version: "0.13.6"},
-		{Name: "symbol-observable", Version: "1.2.0"},
+		{ID: "asap@2.0.6", Name: "asap", Version: "2.0.6", Locations: types.Locations{{StartLine: 36, EndLine: 40}}, Resolved: "https://registry.npmjs.org/asap/-/asap-2.0.6.tgz", Digests: []types.Digest{"sha1:e50347611d7e690943208bbdafebcbc2fb866d46"}},
+		{ID: "jquery@3.4.0", Name: "jquery", Version: "3.4.0", Locations: types.Locations{{StartLine: 407, EndLine: 411}}, Resolved: "https://registry.npmjs.org/jquery/-/jquery-3.4.0.tgz", Digests: []types.Digest{"sha512:8204425e59fdcc4aafe8ea8019714472c845e5d481bc29338fa1a6da0cee4797d66b0697f2dedcc4a56493280a383ac303329da18c3797f7b7a66def953e086d"}},
+		{ID: "js-tokens@4.0.0", Name: "js-tokens", Version: "4.0.0", Locations: types.Locations{{StartLine: 412, EndLine: 416}}, Resolved: "https://registry.npmjs.org/js-tokens/-/js-tokens-4.0.0.tgz", Digests: []types.Digest{"sha512:45d2547e5704ddc5332a232a420b02bb4e853eef5474824ed1b7986cf84737893a6a9809b627dca02b53f5b7313a9601b690f690233a49bce0e026aeb16fcf29"}},
+		{ID: "loose-envify@1.4.0", Name: "loose-envify", Version: "1.4.0", Locations: types.Locations{{StartLine: 461, EndLine: 468}}, Resolved: "https://registry.npmjs.org/loose-envify/-/loose-envify-1.4.0.tgz", Digests: []types.Digest{"sha512:972bb13c6aff59f86b95e9b608bfd472751cd7372a280226043cee918ed8e45ff242235d928ebe7d12debe5c351e03324b0edfeb5d54218e34f04b71452a0add"}},
+		{ID: "object-assign@4.1.1", Name: "object-assign", Version: "4.1.1", Locations: types.Locations{{StartLine: 587, EndLine: 591}}, Resolved: "https://registry.npmjs.org/object-assign/-/object-assign-4.1.1.tgz", Digests: []types.Digest{"sha1:2109adc7965887cfc05cbbd442cac8bfbb360863"}},
+		{ID: "promise@8.0.3", Name: "promise", Version: "8.0.3", Locations: types.Locations{{StartLine: 700, EndLine: 707}}, Resolved: "https://registry.npmjs.org/promise/-/promise-8.0.3.tgz", Digests: []types.Digest{"sha512:1de44350bd512622e1c80d3f82b9fe3d34a194101c2ee87fd41246b6bbe3c1bbd10c24cb2cc133f6b386095f91def1d8e0c8b122ea0c11df58abf5efb133dc8f"}},
+		{ID: "prop-types@15.7.2", Name: "prop-types", Version: "15.7.2", Locations: types.Locations{{StartLine: 708, EndLine: 717}}, Resolved: "https://registry.npmjs.org/prop-types/-/prop-types-15.7.2.tgz", Digests: []types.Digest{"sha512:f1042291d1fbfff476beeac8252bad675b261d84dc2e945610e9479f37161e6058ace194cac2b04acac2f3d0428858f709badf27f9d715d25ea4e56b6351821d"}},
+		{ID: "react@16.8.6", Name: "react", Version: "16.8.6", Locations: types.Locations{{StartLine: 728, EndLine: 738}}, Resolved: "https://registry.npmjs.org/react/-/react-16.8.6.tgz", Digests: []types.Digest{"sha512:a42d2e32484b6879b5d5948950b7ce06a578b48664c7ced92ef6db418ba7362c4002f8e70beb272428345d05e7f5522cb6d56c6d93ff1ff7b0ce0b1de5fc4a5f"}},
+		{ID: "react-is@16.8.6", Name: "react-is", Version: "16.8.6", Locations: types.Locations{{StartLine: 739, EndLine: 743}}, Resolved: "https://registry.npmjs.org/react-is/-/react-is-16.8.6.tgz", Digests: []types.Digest{"sha512:6949376c77d9d9b45254515b6de552e22fa534f66bdff58ce634f6279a26515575cf372cd6701a7f7979d5cb40e4516f0916e1ac7d1b740b1145075d14964fb4"}},
+		{ID: "redux@4.0.1", Name: "redux", Version: "4.0.1", Locations: types.Locations{{StartLine: 744, EndLine: 752}}, Resolved: "https://registry.npmjs.org/redux/-/redux-4.0.1.tgz", Digests: []types.Digest{"sha512:47b6c0b52924ee763a3bf39831547d462048f978218c5f6b95b979f34e8725b4298742c954766b539a1a3b8abbd1e50a8aa311aa6e32d3b28b4e5319d819559a"}},
+		{ID: "scheduler@0.13.6", Name: "scheduler", Version: "0.13.6", Locations: types.Locations{{StartLine: 765, EndLine: 773}}, Resolved: "https://registry.npmjs.org/scheduler/-/scheduler-0.13.6.tgz", Digests: []types.Digest{"sha512:2169ce6c7b78d77b9c0182ac0fd2754128542a46ca2d0407771472c3bdecc3814ddba896af70d8fc7df8c463dee2798bd43c17c969925a632b03d4df41b13f5d"}},
+		{ID: "symbol-observable@1.2.0", Name: "symbol-observable", Version: "1.2.0", Locations: types.Locations{{StartLine: 853, EndLine: 857}}, Resolved: "https://registry.npmjs.org/symbol-observable/-/symbol-observable-1.2.0.tgz", Digests: []types.Digest{"sha512:7bdd349ccf1146d1a1955dfa286114f64eb92b798f6f5595ef439d8dfc651b6100b8cd67a22fc4fc1696fee3212fb6cf12cb0af10579eef3777ac8b18d4bdc5d"}},
 	}
 
 	// docker run --name node --rm -it node:12-alpine sh
@@ -59,135 +59,404 @@ var (
 	// npm install --save lodash request chalk commander express async axios vue
 	// npm ls -prod | grep -E -o "\S+@\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}'
 	npmMany = []types.Library{
-		{Name: "accepts", Version: "1.3.6"},
-		{Name: "ajv", Version: "6.10.0"},
-		{Name: "ansi-styles", Version: "3.2.1"},
-		{Name: "array-flatten", Version: "1.1.1"},
-		{Name: "asap", Version: "2.0.6"},
-		{Name: "asn1", Version: "0.2.4"},
-		{Name: "assert-plus", Version: "1.0.0"},
-		{Name: "async", Version: "2.6.2"},
-		{Name: "asynckit", Version: "0.4.0"},
-		{Name: "aws-sign2", Version: "0.7.0"},
-		{Name: "aws4", Version: "1.8.0"},
-		{Name: "axios", Version: "0.18.0"},
-		{Name: "bcrypt-pbkdf", Version: "1.0.2"},
-		{Name: "body-parser", Version: "1.18.3"},
-		{Name: "bytes", Version: "3.0.0"},
-		{Name: "caseless", Version: "0.12.0"},
-		{Name: "chalk", Version: "2.4.2"},
-		{Name: "color-convert", Version: "1.9.3"},
-		{Name: "color-name", Version: "1.1.3"},
-		{Name: "combined-stream", Version: "1.0.7"},
-		{Name: "commander", Version: "2.20.0"},
-		{Name: "content-disposition", Version: "0.5.2"},
-		{Name: "content-type", Version: "1.0.4"},
-		{Name: "cookie-signature", Version: "1.0.6"},
-		{Name: "cookie", Version: "0.3.1"},
-		{Name: "core-util-is", Version: "1.0.2"},
-		{Name: "dashdash", Version: "1.14.1"},
-		{Name: "debug", Version: "2.6.9"},
-		{Name: "debug", Version: "3.2.6"},
-		{Name: "delayed-stream", Version: "1.0.0"},
-		{Name: "depd", Version: "1.1.2"},
-		{Name: "destroy", Version: "1.0.4"},
-		{Name: "ecc-jsbn", Version: "0.1.2"},
-		{Name: "ee-first", Version: "1.1.1"},
-		{Name: "encodeurl", Version: "1.0.2"},
-		{Name: "escape-html", Version: "1.0.3"},
-		{Name: "escape-string-regexp", Version: "1.0.5"},
-		{Name: "etag", Version: "1.8.1"},
-		{Name: "express", Version: "4.16.4"},
-		{Name: "extend", Version: "3.0.2"},
-		{Name: "extsprintf", Version: "1.3.0"},
-		{Name: "fast-deep-equal", Version: "2.0.1"},
-		{Name: "fast-json-stable-stringify", Version: "2.0.0"},
-		{Name: "finalhandler", Version: "1.1.1"},
-		{Name: "follow-redirects", Version: "1.7.0"},
-		{Name: "forever-agent", Version: "0.6.1"},
-		{Name: "form-data", Version: "2.3.3"},
-		{Name: "forwarded", Version: "0.1.2"},
-		{Name: "fresh", Version: "0.5.2"},
-		{Name: "getpass", Version: "0.1.7"},
-		{Name: "har-schema", Version: "2.0.0"},
-		{Name: "har-validator", Version: "5.1.3"},
-		{Name: "has-flag", Version: "3.0.0"},
-		{Name: "http-errors", Version: "1.6.3"},
-		{Name: "http-signature", Version: "1.2.0"},
-		{Name: "iconv-lite", Version: "0.4.23"},
-		{Name: "inherits", Version: "2.0.3"},
-		{Name: "ipaddr.js", Version: "1.9.0"},
-		{Name: "is-buffer", Version: "1.1.6"},
-		{Name: "is-typedarray", Version: "1.0.0"},
-		{Name: "isstream", Version: "0.1.2"},
-		{Name: "jquery", Version: "3.4.0"},
-		{Name: "js-tokens", Version: "4.0.0"},
-		{Name: "jsbn", Version: "0.1.1"},
-		{Name: "json-schema-traverse", Version: "0.4.1"},
-		{Name: "json-schema", Version: "0.2.3"},
-		{Name: "json-stringify-safe", Version: "5.0.1"},
-		{Name: "jsprim", Version: "1.4.1"},
-		{Name: "lodash", Version: "4.17.11"},
-		{Name: "loose-envify", Version: "1.4.0"},
-		{Name: "media-typer", Version: "0.3.0"},
-		{Name: "merge-descriptors", Version: "1.0.1"},
-		{Name: "methods", Version: "1.1.2"},
-		{Name: "mime-db", Version: "1.40.0"},
-		{Name: "mime-types", Version: "2.1.24"},
-		{Name: "mime", Version: "1.4.1"},
-		{Name: "ms", Version: "2.0.0"},
-		{Name: "ms", Version: "2.1.1"},
-		{Name: "negotiator", Version: "0.6.1"},
-		{Name: "oauth-sign", Version: "0.9.0"},
-		{Name: "object-assign", Version: "4.1.1"},
-		{Name: "on-finished", Version: "2.3.0"},
-		{Name: "parseurl", Version: "1.3.3"},
-		{Name: "path-to-regexp", Version: "0.1.7"},
-		{Name: "performance-now", Version: "2.1.0"},
-		{Name: "promise", Version: "8.0.3"},
-		{Name: "prop-types", Version: "15.7.2"},
-		{Name: "proxy-addr", Version: "2.0.5"},
-		{Name: "psl", Version: "1.1.31"},
-		{Name: "punycode", Version: "1.4.1"},
-		{Name: "punycode", Version: "2.1.1"},
-		{Name: "qs", Version: "6.5.2"},
-		{Name: "range-parser", Version: "1.2.0"},
-		{Name: "raw-body", Version: "2.3.3"},
-		{Name: "react-is", Version: "16.8.6"},
-		{Name: "react", Version: "16.8.6"},
-		{Name: "redux", Version: "4.0.1"},
-		{Name: "request", Version: "2.88.0"},
-		{Name: "safe-buffer", Version: "5.1.2"},
-		{Name: "safer-buffer", Version: "2.1.2"},
-		{Name: "scheduler", Version: "0.13.6"},
-		{Name: "send", Version: "0.16.2"},
-		{Name: "serve-static", Version: "1.13.2"},
-		{Name: "setprototypeof", Version: "1.1.0"},
-		{Name: "sshpk", Version: "1.16.1"},
-		{Name: "statuses", Version: "1.4.0"},
-		{Name: "supports-color", Version: "5.5.0"},
-		{Name: "symbol-observable", Version: "1.2.0"},
-		{Name: "tough-cookie", Version: "2.4.3"},
-		{Name: "tunnel-agent", Version: "0.6.0"},
-		{Name: "tweetnacl", Version: "0.14.5"},
-		{Name: "type-is", Version: "1.6.18"},
-		{Name: "unpipe", Version: "1.0.0"},
-		{Name: "uri-js", Version: "4.2.2"},
-		{Name: "utils-merge", Version: "1.0.1"},
-		{Name: "uuid", Version: "3.3.2"},
-		{Name: "vary", Version: "1.1.2"},
-		{Name: "verror", Version: "1.10.0"},
-		{Name: "vue", Version: "2.6.10"},
+		{ID: "accepts@1.3.6", Name: "accepts", Version: "1.3.6", Locations: types.Locations{{StartLine: 6, EndLine: 14}}, Resolved: "https://registry.npmjs.org/accepts/-/accepts-1.3.6.tgz", Digests: []types.Digest{"sha512:42c6a8503d9da55a6310dcbc245a509d73fdbf2ce864f980a0aac4dd2e87b5207bab349e6e424d9e6758e29d34e054144928875cfb9e10da687ae516ffb6bc22"}},
+		{ID: "ajv@6.10.0", Name: "ajv", Version: "6.10.0", Locations: types.Locations{{StartLine: 15, EndLine: 25}}, Resolved: "https://registry.npmjs.org/ajv/-/ajv-6.10.0.tgz", Digests: []types.Digest{"sha512:9df7e13a99329831024324749a7b14b68084f11957dfc1b4ad83fec202d617266e532baea23492be2ffe7ae3a2401227eb7c216306152081f54ef137b6ee0e12"}},
+		{ID: "ansi-styles@3.2.1", Name: "ansi-styles", Version: "3.2.1", Locations: types.Locations{{StartLine: 38, EndLine: 45}}, Resolved: "https://registry.npmjs.org/ansi-styles/-/ansi-styles-3.2.1.tgz", Digests: []types.Digest{"sha512:553d1923a91945d4e1f18c89c3748c6d89bfbbe36a7ec03112958ed0f7fdb2af3f7bde16c713a93cac7d151d459720ad3950cd390fbc9ed96a17189173eaf9a8"}},
+		{ID: "array-flatten@1.1.1", Name: "array-flatten", Version: "1.1.1", Locations: types.Locations{{StartLine: 55, EndLine: 59}}, Resolved: "https://registry.npmjs.org/array-flatten/-/array-flatten-1.1.1.tgz", Digests: []types.Digest{"sha1:9a5f699051b1e7073328f2a008968b64ea2955d2"}},
+		{ID: "asap@2.0.6", Name: "asap", Version: "2.0.6", Locations: types.Locations{{StartLine: 60, EndLine: 64}}, Resolved: "https://registry.npmjs.org/asap/-/asap-2.0.6.tgz", Digests: []types.Digest{"sha1:e50347611d7e690943208bbdafebcbc2fb866d46"}},
+		{ID: "asn1@0.2.4", Name: "asn1", Version: "0.2.4", Locations: types.Locations{{StartLine: 65, EndLine: 72}}, Resolved: "https://registry.npmjs.org/asn1/-/asn1-0.2.4.tgz", Digests: []types.Digest{"sha512:8f1c334292d08d29965e0c1a09913d373fa09401b4d721754275a06e11b01c8e40e85448118e8856ab478487a91ea23bfe4c84c9011a8010b998110594862f76"}},
+		{ID: "assert-plus@1.0.0", Name: "assert-plus", Version: "1.0.0", Locations: types.Locations{{StartLine: 73, EndLine: 77}}, Resolved: "https://registry.npmjs.org/assert-plus/-/assert-plus-1.0.0.tgz", Digests: []types.Digest{"sha1:f12e0f3c5d77b0b1cdd9146942e4e96c1e4dd525"}},
+		{ID: "async@2.6.2", Name: "async", Version: "2.6.2", Locations: types.Locations{{StartLine: 78, EndLine: 85}}, Resolved: "https://registry.npmjs.org/async/-/async-2.6.2.tgz", Digests: []types.Digest{"sha512:1f5a95621d4c62110414bb0ff7b7152aa086a3b29f0b24edeae116aac4c1afd48ef38a0af54c1bc9dff2096fbaaca24b1e4b013545566430237d2f82731d016e"}},
+		{ID: "asynckit@0.4.0", Name: "asynckit", Version: "0.4.0", Locations: types.Locations{{StartLine: 86, EndLine: 90}}, Resolved: "https://registry.npmjs.org/asynckit/-/asynckit-0.4.0.tgz", Digests: []types.Digest{"sha1:c79ed97f7f34cb8f2ba1bc9790bcc366474b4b79"}},
+		{ID: "aws-sign2@0.7.0", Name: "aws-sign2", Version: "0.7.0", Locations: types.Locations{{StartLine: 91, EndLine: 95}}, Resolved: "https://registry.npmjs.org/aws-sign2/-/aws-sign2-0.7.0.tgz", Digests: []types.Digest{"sha1:b46e890934a9591f2d2f6f86d7e6a9f1b3fe76a8"}},
+		{ID: "aws4@1.8.0", Name: "aws4", Version: "1.8.0", Locations: types.Locations{{StartLine: 96, EndLine: 100}}, Resolved: "https://registry.npmjs.org/aws4/-/aws4-1.8.0.tgz", Digests: []types.Digest{"sha512:45e671bcd1c83aff3c1654fbaf17172080b47cfb78299a996ce962bf25ad5cbb7c112c7ce3377790c0ba88ae6355e4b6aadfa0edfb52ef27b87e2d3a5f9aed45"}},
+		{ID: "axios@0.18.0", Name: "axios", Version: "0.18.0", Locations: types.Locations{{StartLine: 101, EndLine: 116}}, Resolved: "https://registry.npmjs.org/axios/-/axios-0.18.0.tgz", Digests: []types.Digest{"sha1:32d53e4851efdc0a11993b6cd000789d70c05102"}},
+		{ID: "bcrypt-pbkdf@1.0.2", Name: "bcrypt-pbkdf", Version: "1.0.2", Locations: types.Locations{{StartLine: 123, EndLine: 130}}, Resolved: "https://registry.npmjs.org/bcrypt-pbkdf/-/bcrypt-pbkdf-1.0.2.tgz", Digests: []types.Digest{"sha1:a4301d389b6a43f9b67ff3ca11a3f6637e360e9e"}},
+		{ID: "body-parser@1.18.3", Name: "body-parser", Version: "1.18.3", Locations: types.Locations{{StartLine: 131, EndLine: 162}}, Resolved: "https://registry.npmjs.org/body-parser/-/body-parser-1.18.3.tgz", Digests: []types.Digest{"sha1:5b292198ffdd553b3a0f20ded0592b956955c8b4"}},
+		{ID: "bytes@3.0.0", Name: "bytes", Version: "3.0.0", Locations: types.Locations{{StartLine: 179, EndLine: 183}}, Resolved: "https://registry.npmjs.org/bytes/-/bytes-3.0.0.tgz", Digests: []types.Digest{"sha1:d32815404d689699f85a4ea4fa8755dd13a96048"}},
+		{ID: "caseless@0.12.0", Name: "caseless", Version: "0.12.0", Locations: types.Locations{{StartLine: 190, EndLine: 194}}, Resolved: "https://registry.npmjs.org/caseless/-/caseless-0.12.0.tgz", Digests: []types.Digest{"sha1:1b681c21ff84033c826543090689420d187151dc"}},
+		{ID: "chalk@2.4.2", Name: "chalk", Version: "2.4.2", Locations: types.Locations{{StartLine: 195, EndLine: 214}}, Resolved: "https://registry.npmjs.org/chalk/-/chalk-2.4.2.tgz", Digests: []types.Digest{"sha512:32d8be7fd96924d730178b5657cfcead34ed1758198be7fc16a97201da2eada95c156150585dbe3600874a18e409bf881412eaf5bb99c04d71724414e29792b9"}},
+		{ID: "color-convert@1.9.3", Name: "color-convert", Version: "1.9.3", Locations: types.Locations{{StartLine: 232, EndLine: 239}}, Resolved: "https://registry.npmjs.org/color-convert/-/color-convert-1.9.3.tgz", Digests: []types.Digest{"sha512:41f014b5dfaf15d02d150702f020b262dd5f616c52a8088ad9c483eb30c1f0dddca6c10102f471a7dcce1a0e86fd21c7258013f3cfdacff22e0c600bb0d55b1a"}},
+		{ID: "color-name@1.1.3", Name: "color-name", Version: "1.1.3", Locations: types.Locations{{StartLine: 240, EndLine: 244}}, Resolved: "https://registry.npmjs.org/color-name/-/color-name-1.1.3.tgz", Digests: []types.Digest{"sha1:a7d0558bd89c42f795dd42328f740831ca53bc25"}},
+		{ID: "combined-stream@1.0.7", Name: "combined-stream", Version: "1.0.7", Locations: types.Locations{{StartLine: 245, EndLine: 252}}, Resolved: "https://registry.npmjs.org/combined-stream/-/combined-stream-1.0.7.tgz", Digests: []types.Digest{"sha512:6eb5a5f72eaf381d7160f65ca5975edcdf730c1c974e8b0078c0e8e29d70ce8e9430e5f8bee981f933f5459efab1f13a31debc4343494ab13f81b7b3778525fb"}},
+		{ID: "commander@2.20.0", Name: "commander", Version: "2.20.0", Locations: types.Locations{{StartLine: 253, EndLine: 257}}, Resolved: "https://registry.npmjs.org/commander/-/commander-2.20.0.tgz", Digests: []types.Digest{"sha512:ee3db2fb8d30eb5cf2e980b689136951efcdc21372a17ad8a4732b4ae9da306eb89d19da7fdeb33bf28c411e0ec8dfd49c4e4a2f21019ca1dde1a1b7aec923a5"}},
+		{ID: "content-disposition@0.5.2", Name: "content-disposition", Version: "0.5.2", Locations: types.Locations{{StartLine: 264, EndLine: 268}}, Resolved: "https://registry.npmjs.org/content-disposition/-/content-disposition-0.5.2.tgz", Digests: []types.Digest{"sha1:0cf68bb9ddf5f2be7961c3a85178cb85dba78cb4"}},
+		{ID: "content-type@1.0.4", Name: "content-type", Version: "1.0.4", Locations: types.Locations{{StartLine: 269, EndLine: 273}}, Resolved: "https://registry.npmjs.org/content-type/-/content-type-1.0.4.tgz", Digests: []types.Digest{"sha512:8483f71043ecf2d07d013d4bf8d52ab70380a6ce269366686fcf4c5973078c75a0f668a517f8f8a2c9e740b5c108114193fb6f206fed51cf663942623c184f5c"}},
+		{ID: "cookie-signature@1.0.6", Name: "cookie-signature", Version: "1.0.6", Locations: types.Locations{{StartLine: 279, EndLine: 283}}, Resolved: "https://registry.npmjs.org/cookie-signature/-/cookie-signature-1.0.6.tgz", Digests: []types.Digest{"sha1:e303a882b342cc3ee8ca513a79999734dab3ae2c"}},
+		{ID: "cookie@0.3.1", Name: "cookie", Version: "0.3.1", Locations: types.Locations{{StartLine: 274, EndLine: 278}}, Resolved: "https://registry.npmjs.org/cookie/-/cookie-0.3.1.tgz", Digests: []types.Digest{"sha1:e7e0a1f9ef43b4c8ba925c5c5a96e806d16873bb"}},
+		{ID: "core-util-is@1.0.2", Name: "core-util-is", Version: "1.0.2", Locations: types.Locations{{StartLine: 284, EndLine: 288}}, Resolved: "https://registry.npmjs.org/core-util-is/-/core-util-is-1.0.2.tgz", Digests: []types.Digest{"sha1:b5fd54220aa2bc5ab57aab7140c940754503c1a7"}},
+		{ID: "dashdash@1.14.1", Name: "dashdash", Version: "1.14.1", Locations: types.Locations{{StartLine: 302, EndLine: 309}}, Resolved: "https://registry.npmjs.org/dashdash/-/dashdash-1.14.1.tgz", Digests: []types.Digest{"sha1:853cfa0f7cbe2fed5de20326b8dd581035f6e2f0"}},
+		{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9", Locations: types.Locations{{StartLine: 148, EndLine: 155}, {StartLine: 486, EndLine: 493}, {StartLine: 535, EndLine: 542}, {StartLine: 1380, EndLine: 1387}}, Resolved: "https://registry.npmjs.org/debug/-/debug-2.6.9.tgz", Digests: []types.Digest{"sha512:6c2ec496b7496899cf6c03fed44a2d62fa99b1bdde725e708ba05f8ba0494d470da30a7a72fb298348d7ce74532838e6fc4ec076014155e00f54c35c286b0730"}},
+		{ID: "debug@3.2.6", Name: "debug", Version: "3.2.6", Locations: types.Locations{{StartLine: 310, EndLine: 317}}, Resolved: "https://registry.npmjs.org/debug/-/debug-3.2.6.tgz", Digests: []types.Digest{"sha512:99e97e8dfee7aed125e4f9f5431e3acc0457283a416efcdecec7bba7b2ea20d99da0893c3d83f94b249ac44998bfa4d9d09c84280d61b0221de832218084ed59"}},
+		{ID: "delayed-stream@1.0.0", Name: "delayed-stream", Version: "1.0.0", Locations: types.Locations{{StartLine: 333, EndLine: 337}}, Resolved: "https://registry.npmjs.org/delayed-stream/-/delayed-stream-1.0.0.tgz", Digests: []types.Digest{"sha1:df3ae199acadfb7d440aaae0b29e2272b24ec619"}},
+		{ID: "depd@1.1.2", Name: "depd", Version: "1.1.2", Locations: types.Locations{{StartLine: 338, EndLine: 342}}, Resolved: "https://registry.npmjs.org/depd/-/depd-1.1.2.tgz", Digests: []types.Digest{"sha1:9bcd52e14c097763e749b274c4346ed2e560b5a9"}},
+		{ID: "destroy@1.0.4", Name: "destroy", Version: "1.0.4", Locations: types.Locations{{StartLine: 343, EndLine: 347}}, Resolved: "https://registry.npmjs.org/destroy/-/destroy-1.0.4.tgz", Digests: []types.Digest{"sha1:978857442c44749e4206613e37946205826abd80"}},
+		{ID: "ecc-jsbn@0.1.2", Name: "ecc-jsbn", Version: "0.1.2", Locations: types.Locations{{StartLine: 354, EndLine: 362}}, Resolved: "https://registry.npmjs.org/ecc-jsbn/-/ecc-jsbn-0.1.2.tgz", Digests: []types.Digest{"sha1:3a83a904e54353287874c564b7549386849a98c9"}},
+		{ID: "ee-first@1.1.1", Name: "ee-first", Version: "1.1.1", Locations: types.Locations{{StartLine: 363, EndLine: 367}}, Resolved: "https://registry.npmjs.org/ee-first/-/ee-first-1.1.1.tgz", Digests: []types.Digest{"sha1:590c61156b0ae2f4f0255732a158b266bc56b21d"}},
+		{ID: "encodeurl@1.0.2", Name: "encodeurl", Version: "1.0.2", Locations: types.Locations{{StartLine: 374, EndLine: 378}}, Resolved: "https://registry.npmjs.org/encodeurl/-/encodeurl-1.0.2.tgz", Digests: []types.Digest{"sha1:ad3ff4c86ec2d029322f5a02c3a9a606c95b3f59"}},
+		{ID: "escape-html@1.0.3", Name: "escape-html", Version: "1.0.3", Locations: types.Locations{{StartLine: 413, EndLine: 417}}, Resolved: "https://registry.npmjs.org/escape-html/-/escape-html-1.0.3.tgz", Digests: []types.Digest{"sha1:0258eae4d3d0c0974de1c169188ef0051d1d1988"}},
+		{ID: "escape-string-regexp@1.0.5", Name: "escape-string-regexp", Version: "1.0.5", Locations: types.Locations{{StartLine: 418, EndLine: 422}}, Resolved: "https://registry.npmjs.org/escape-string-regexp/-/escape-string-regexp-1.0.5.tgz", Digests: []types.Digest{"sha1:1b61c0562190a8dff6ae3bb2cf0200ca130b86d4"}},
+		{ID: "etag@1.8.1", Name: "etag", Version: "1.8.1", Locations: types.Locations{{StartLine: 429, EndLine: 433}}, Resolved: "https://registry.npmjs.org/etag/-/etag-1.8.1.tgz", Digests: []types.Digest{"sha1:41ae2eeb65efa62268aebfea83ac7d79299b0887"}},
+		{ID: "express@4.16.4", Name: "express", Version: "4.16.4", Locations: types.Locations{{StartLine: 449, EndLine: 500}}, Resolved: "https://registry.npmjs.org/express/-/express-4.16.4.tgz", Digests: []types.Digest{"sha512:8f5d94bb26f814caddfea4009bab821c090fb4ef050d34496410dde43d8a38bd9e2dacf5c9435d501fcd388caad22538ab87056abb140ab9c50cf05c9e4b2e3a"}},
+		{ID: "extend@3.0.2", Name: "extend", Version: "3.0.2", Locations: types.Locations{{StartLine: 501, EndLine: 505}}, Resolved: "https://registry.npmjs.org/extend/-/extend-3.0.2.tgz", Digests: []types.Digest{"sha512:7e3aae0b9f5c0fb0b25babab3572b4141b9f9197288861bcd304ee3ee8d7e7dd1c0794ed967db4136501e12fd601156a8577df665d8b3604be81074f2088a6fe"}},
+		{ID: "extsprintf@1.3.0", Name: "extsprintf", Version: "1.3.0", Locations: types.Locations{{StartLine: 506, EndLine: 510}}, Resolved: "https://registry.npmjs.org/extsprintf/-/extsprintf-1.3.0.tgz", Digests: []types.Digest{"sha1:96918440e3041a7a414f8c52e3c574eb3c3e1e05"}},
+		{ID: "fast-deep-equal@2.0.1", Name: "fast-deep-equal", Version: "2.0.1", Locations: types.Locations{{StartLine: 511, EndLine: 515}}, Resolved: "https://registry.npmjs.org/fast-deep-equal/-/fast-deep-equal-2.0.1.tgz", Digests: []types.Digest{"sha1:7b05218ddf9667bf7f370bf7fdb2cb15fdd0aa49"}},
+		{ID: "fast-json-stable-stringify@2.0.0", Name: "fast-json-stable-stringify", Version: "2.0.0", Locations: types.Locations{{StartLine: 516, EndLine: 520}}, Resolved: "https://registry.npmjs.org/fast-json-stable-stringify/-/fast-json-stable-stringify-2.0.0.tgz", Digests: []types.Digest{"sha1:d5142c0caee6b1189f87d3a76111064f86c8bbf2"}},
+		{ID: "finalhandler@1.1.1", Name: "finalhandler", Version: "1.1.1", Locations: types.Locations{{StartLine: 521, EndLine: 549}}, Resolved: "https://registry.npmjs.org/finalhandler/-/finalhandler-1.1.1.tgz", Digests: []types.Digest{"sha512:6351940e8dfd7b3e1a1c0c3b332b27503e49cd85fe59a223f08e7b90edda10f4f57c544be2cafb9a37a2f7b1609f0840cb2cd16264196931dbdbef6e67574fb6"}},
+		{ID: "follow-redirects@1.7.0", Name: "follow-redirects", Version: "1.7.0", Locations: types.Locations{{StartLine: 568, EndLine: 575}}, Resolved: "https://registry.npmjs.org/follow-redirects/-/follow-redirects-1.7.0.tgz", Digests: []types.Digest{"sha512:9bfa59432e068f6f3b78dcbde278afcb9c1c84ddcaa7e4395a050f372e65252677b20915292615fd90a1300415220c754aa7d9db3059b4f0366255c85b1c4939"}},
+		{ID: "forever-agent@0.6.1", Name: "forever-agent", Version: "0.6.1", Locations: types.Locations{{StartLine: 576, EndLine: 580}}, Resolved: "https://registry.npmjs.org/forever-agent/-/forever-agent-0.6.1.tgz", Digests: []types.Digest{"sha1:fbc71f0c41adeb37f96c577ad1ed42d8fdacca91"}},
+		{ID: "form-data@2.3.3", Name: "form-data", Version: "2.3.3", Locations: types.Locations{{StartLine: 581, EndLine: 590}}, Resolved: "https://registry.npmjs.org/form-data/-/form-data-2.3.3.tgz", Digests: []types.Digest{"sha512:d652ca07632edda18fd50ff67823b1d1f35b44c7bb5ddc24b703abba17eaa9dd2b2095b03780e1f84de1acf4a50c25e7491ed4b59d4ddfcad55e6fbaf8c12125"}},
+		{ID: "forwarded@0.1.2", Name: "forwarded", Version: "0.1.2", Locations: types.Locations{{StartLine: 591, EndLine: 595}}, Resolved: "https://registry.npmjs.org/forwarded/-/forwarded-0.1.2.tgz", Digests: []types.Digest{"sha1:98c23dab1175657b8c0573e8ceccd91b0ff18c84"}},
+		{ID: "fresh@0.5.2", Name: "fresh", Version: "0.5.2", Locations: types.Locations{{StartLine: 596, EndLine: 600}}, Resolved: "https://registry.npmjs.org/fresh/-/fresh-0.5.2.tgz", Digests: []types.Digest{"sha1:3d8cadd90d976569fa835ab1f8e4b23a105605a7"}},
+		{ID: "getpass@0.1.7", Name: "getpass", Version: "0.1.7", Locations: types.Locations{{StartLine: 628, EndLine: 635}}, Resolved: "https://registry.npmjs.org/getpass/-/getpass-0.1.7.tgz", Digests: []types.Digest{"sha1:5eff8e3e684d569ae4cb2b1282604e8ba62149fa"}},
+		{ID: "har-schema@2.0.0", Name: "har-schema", Version: "2.0.0", Locations: types.Locations{{StartLine: 656, EndLine: 660}}, Resolved: "https://registry.npmjs.org/har-schema/-/har-schema-2.0.0.tgz", Digests: []types.Digest{"sha1:a94c2224ebcac04782a0d9035521f24735b7ec92"}},
+		{ID: "har-validator@5.1.3", Name: "har-validator", Version: "5.1.3", Locations: types.Locations{{StartLine: 661, EndLine: 669}}, Resolved: "https://registry.npmjs.org/har-validator/-/har-validator-5.1.3.tgz", Digests: []types.Digest{"sha512:b0dbce0b311036bfeaaef260737506fe40f842d947c9caf3c12fba99f4ebad2abdec1bda61c3d9648d594aa1923d1ef70b19f82ca4c3e0fb6d4707d4ee35aae6"}},
+		{ID: "has-flag@3.0.0", Name: "has-flag", Version: "3.0.0", Locations: types.Locations{{StartLine: 679, EndLine: 683}}, Resolved: "https://registry.npmjs.org/has-flag/-/has-flag-3.0.0.tgz", Digests: []types.Digest{"sha1:b5d454dc2199ae225699f3467e5a07f3b955bafd"}},
+		{ID: "http-errors@1.6.3", Name: "http-errors", Version: "1.6.3", Locations: types.Locations{{StartLine: 696, EndLine: 706}}, Resolved: "https://registry.npmjs.org/http-errors/-/http-errors-1.6.3.tgz", Digests: []types.Digest{"sha1:8b55680bb4be283a0b5bf4ea2e38580be1d9320d"}},
+		{ID: "http-signature@1.2.0", Name: "http-signature", Version: "1.2.0", Locations: types.Locations{{StartLine: 707, EndLine: 716}}, Resolved: "https://registry.npmjs.org/http-signature/-/http-signature-1.2.0.tgz", Digests: []types.Digest{"sha1:9aecd925114772f3d95b65a60abb8f7c18fbace1"}},
+		{ID: "iconv-lite@0.4.23", Name: "iconv-lite", Version: "0.4.23", Locations: types.Locations{{StartLine: 717, EndLine: 724}}, Resolved: "https://registry.npmjs.org/iconv-lite/-/iconv-lite-0.4.23.tgz", Digests: []types.Digest{"sha512:9dec9351516d6a18dfd260777594fbeeefbc3b4401f3d8c57670647793f526060f6cf6a26b78175ce54bd9fabc57253680c303ed268ded0ba44c1fe999762f0c"}},
+		{ID: "inherits@2.0.3", Name: "inherits", Version: "2.0.3", Locations: types.Locations{{StartLine: 735, EndLine: 739}}, Resolved: "https://registry.npmjs.org/inherits/-/inherits-2.0.3.tgz", Digests: []types.Digest{"sha1:633c2c83e3da42a502f52466022480f4208261de"}},
+		{ID: "ipaddr.js@1.9.0", Name: "ipaddr.js", Version: "1.9.0", Locations: types.Locations{{StartLine: 746, EndLine: 750}}, Resolved: "https://registry.npmjs.org/ipaddr.js/-/ipaddr.js-1.9.0.tgz", Digests: []types.Digest{"sha512:3384a39fa37ff8eebf217489b1e2aa1e815cfb915d189db6b17aa78d3a5d6707872bae0ccc43c0c90672114dd1fca46fd862e86bb9cdb60fc2d84bfa9bbcfe78"}},
+		{ID: "is-buffer@1.1.6", Name: "is-buffer", Version: "1.1.6", Locations: types.Locations{{StartLine: 110, EndLine: 114}}, Resolved: "https://registry.npmjs.org/is-buffer/-/is-buffer-1.1.6.tgz", Digests: []types.Digest{"sha512:35c7402f0a579139b966fbdb93ba303944af56f04a0e028fe7f7b07d71339e64057ece194666a739e2814e34558e46b7405a0de9727ef45dd44aa7c7a93694e7"}},
+		{ID: "is-typedarray@1.0.0", Name: "is-typedarray", Version: "1.0.0", Locations: types.Locations{{StartLine: 799, EndLine: 803}}, Resolved: "https://registry.npmjs.org/is-typedarray/-/is-typedarray-1.0.0.tgz", Digests: []types.Digest{"sha1:e479c80858df0c1b11ddda6940f96011fcda4a9a"}},
+		{ID: "isstream@0.1.2", Name: "isstream", Version: "0.1.2", Locations: types.Locations{{StartLine: 810, EndLine: 814}}, Resolved: "https://registry.npmjs.org/isstream/-/isstream-0.1.2.tgz", Digests: []types.Digest{"sha1:47e63f7af55afa6f92e1500e690eb8b8529c099a"}},
+		{ID: "jquery@3.4.0", Name: "jquery", Version: "3.4.0", Locations: types.Locations{{StartLine: 815, EndLine: 819}}, Resolved: "https://registry.npmjs.org/jquery/-/jquery-3.4.0.tgz", Digests: []types.Digest{"sha512:8204425e59fdcc4aafe8ea8019714472c845e5d481bc29338fa1a6da0cee4797d66b0697f2dedcc4a56493280a383ac303329da18c3797f7b7a66def953e086d"}},
+		{ID: "js-tokens@4.0.0", Name: "js-tokens", Version: "4.0.0", Locations: types.Locations{{StartLine: 820, EndLine: 824}}, Resolved: "https://registry.npmjs.org/js-tokens/-/js-tokens-4.0.0.tgz", Digests: []types.Digest{"sha512:45d2547e5704ddc5332a232a420b02bb4e853eef5474824ed1b7986cf84737893a6a9809b627dca02b53f5b7313a9601b690f690233a49bce0e026aeb16fcf29"}},
+		{ID: "jsbn@0.1.1", Name: "jsbn", Version: "0.1.1", Locations: types.Locations{{StartLine: 835, EndLine: 839}}, Resolved: "https://registry.npmjs.org/jsbn/-/jsbn-0.1.1.tgz", Digests: []types.Digest{"sha1:a5e654c2e5a2deb5f201d96cefbca80c0ef2f513"}},
+		{ID: "json-schema-traverse@0.4.1", Name: "json-schema-traverse", Version: "0.4.1", Locations: types.Locations{{StartLine: 845, EndLine: 849}}, Resolved: "https://registry.npmjs.org/json-schema-traverse/-/json-schema-traverse-0.4.1.tgz", Digests: []types.Digest{"sha512:c5b6c21f9742614e53f0b704861ba1ec727cf075ee5b7aac237634cce64529f6441dca5688753f271ce4eb6f41aec69bfe63221d0b62f7030ffbce3944f7b756"}},
+		{ID: "json-schema@0.2.3", Name: "json-schema", Version: "0.2.3", Locations: types.Locations{{StartLine: 840, EndLine: 844}}, Resolved: "https://registry.npmjs.org/json-schema/-/json-schema-0.2.3.tgz", Digests: []types.Digest{"sha1:b480c892e59a2f05954ce727bd3f2a4e882f9e13"}},
+		{ID: "json-stringify-safe@5.0.1", Name: "json-stringify-safe", Version: "5.0.1", Locations: types.Locations{{StartLine: 850, EndLine: 854}}, Resolved: "https://registry.npmjs.org/json-stringify-safe/-/json-stringify-safe-5.0.1.tgz", Digests: []types.Digest{"sha1:1296a2d58fd45f19a0f6ce01d65701e2c735b6eb"}},
+		{ID: "jsprim@1.4.1", Name: "jsprim", Version: "1.4.1", Locations: types.Locations{{StartLine: 855, EndLine: 865}}, Resolved: "https://registry.npmjs.org/jsprim/-/jsprim-1.4.1.tgz", Digests: []types.Digest{"sha1:313e66bc1e5cc06e438bc1b7499c2e5c56acb6a2"}},
+		{ID: "lodash@4.17.11", Name: "lodash", Version: "4.17.11", Locations: types.Locations{{StartLine: 885, EndLine: 889}}, Resolved: "https://registry.npmjs.org/lodash/-/lodash-4.17.11.tgz", Digests: []types.Digest{"sha512:7102a1f22828e5052167b960dfc0d8580c4cbe3480286d00f3019256298fd3b4885042b650ef9aad244a6d1656b5e94cb4de55d07930879af23ada3f4ac85822"}},
+		{ID: "loose-envify@1.4.0", Name: "loose-envify", Version: "1.4.0", Locations: types.Locations{{StartLine: 899, EndLine: 906}}, Resolved: "https://registry.npmjs.org/loose-envify/-/loose-envify-1.4.0.tgz", Digests: []types.Digest{"sha512:972bb13c6aff59f86b95e9b608bfd472751cd7372a280226043cee918ed8e45ff242235d928ebe7d12debe5c351e03324b0edfeb5d54218e34f04b71452a0add"}},
+		{ID: "media-typer@0.3.0", Name: "media-typer", Version: "0.3.0", Locations: types.Locations{{StartLine: 916, EndLine: 920}}, Resolved: "https://registry.npmjs.org/media-typer/-/media-typer-0.3.0.tgz", Digests: []types.Digest{"sha1:8710d7af0aa626f8fffa1ce00168545263255748"}},
+		{ID: "merge-descriptors@1.0.1", Name: "merge-descriptors", Version: "1.0.1", Locations: types.Locations{{StartLine: 932, EndLine: 936}}, Resolved: "https://registry.npmjs.org/merge-descriptors/-/merge-descriptors-1.0.1.tgz", Digests: []types.Digest{"sha1:b00aaa556dd8b44568150ec9d1b953f3f90cbb61"}},
+		{ID: "methods@1.1.2", Name: "methods", Version: "1.1.2", Locations: types.Locations{{StartLine: 937, EndLine: 941}}, Resolved: "https://registry.npmjs.org/methods/-/methods-1.1.2.tgz", Digests: []types.Digest{"sha1:5529a4d67654134edcc5266656835b0f851afcee"}},
+		{ID: "mime-db@1.40.0", Name: "mime-db", Version: "1.40.0", Locations: types.Locations{{StartLine: 947, EndLine: 951}}, Resolved: "https://registry.npmjs.org/mime-db/-/mime-db-1.40.0.tgz", Digests: []types.Digest{"sha512:8d875e38c3f2f6f9f112a151468e99bd367c77da0f6fe935f0f2a860d6147b6b2d54404f3f0b259ffa96cdd6e6688be7859f6fd8ff82b9e70af9fa547ec57698"}},
+		{ID: "mime-types@2.1.24", Name: "mime-types", Version: "2.1.24", Locations: types.Locations{{StartLine: 952, EndLine: 959}}, Resolved: "https://registry.npmjs.org/mime-types/-/mime-types-2.1.24.tgz", Digests: []types.Digest{"sha512:59a1474b73029797daa66de82f15387980f0efb21033600273143d448c5f682de8a1ce8f16e04c19966c629be85d2e43e504d63e27a8d638cb7409b74c13f771"}},
+		{ID: "mime@1.4.1", Name: "mime", Version: "1.4.1", Locations: types.Locations{{StartLine: 942, EndLine: 946}}, Resolved: "https://registry.npmjs.org/mime/-/mime-1.4.1.tgz", Digests: []types.Digest{"sha512:288d7ea8e66ee43716eb06b26074b347fb572820c2e4e9b8b35cf64098c350bccb7267f70efcd2ee896e6381c24eb73ef4588a99652078d2e0ed6dee210bf1b5"}},
+		{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0", Locations: types.Locations{{StartLine: 156, EndLine: 160}, {StartLine: 494, EndLine: 498}, {StartLine: 543, EndLine: 547}, {StartLine: 1388, EndLine: 1392}}, Resolved: "https://registry.npmjs.org/ms/-/ms-2.0.0.tgz", Digests: []types.Digest{"sha1:5608aeadfc00be6c2901df5f9861788de0d597c8"}},
+		{ID: "ms@2.1.1", Name: "ms", Version: "2.1.1", Locations: types.Locations{{StartLine: 1021, EndLine: 1025}}, Resolved: "https://registry.npmjs.org/ms/-/ms-2.1.1.tgz", Digests: []types.Digest{"sha512:b60a7e765e5c1a4dbcbad624b41b2b16a03b1ca82b8603ec83a67f11f856238825d47c2af01fc6998ff4a1767a9c5f210d57ac4bf1699d8683fe439685842fca"}},
+		{ID: "negotiator@0.6.1", Name: "negotiator", Version: "0.6.1", Locations: types.Locations{{StartLine: 1026, EndLine: 1030}}, Resolved: "https://registry.npmjs.org/negotiator/-/negotiator-0.6.1.tgz", Digests: []types.Digest{"sha1:2b327184e8992101177b28563fb5e7102acd0ca9"}},
+		{ID: "oauth-sign@0.9.0", Name: "oauth-sign", Version: "0.9.0", Locations: types.Locations{{StartLine: 1062, EndLine: 1066}}, Resolved: "https://registry.npmjs.org/oauth-sign/-/oauth-sign-0.9.0.tgz", Digests: []types.Digest{"sha512:7dec6150514f4c657cc9b02d48819b57a80e912bfc52d45b0c19c0c8b430e103ca920365b07d81c8f1ad314a9d5a4a2ce98091980a958b0819ac973f9910f365"}},
+		{ID: "object-assign@4.1.1", Name: "object-assign", Version: "4.1.1", Locations: types.Locations{{StartLine: 1067, EndLine: 1071}}, Resolved: "https://registry.npmjs.org/object-assign/-/object-assign-4.1.1.tgz", Digests: []types.Digest{"sha1:2109adc7965887cfc05cbbd442cac8bfbb360863"}},
+		{ID: "on-finished@2.3.0", Name: "on-finished", Version: "2.3.0", Locations: types.Locations{{StartLine: 1100, EndLine: 1107}}, Resolved: "https://registry.npmjs.org/on-finished/-/on-finished-2.3.0.tgz", Digests: []types.Digest{"sha1:20f1336481b083cd75337992a16971aa2d906947"}},
+		{ID: "parseurl@1.3.3", Name: "parseurl", Version: "1.3.3", Locations: types.Locations{{StartLine: 1170, EndLine: 1174}}, Resolved: "https://registry.npmjs.org/parseurl/-/parseurl-1.3.3.tgz", Digests: []types.Digest{"sha512:0a2c9e3b1153fc96723799b4cfd3df5f0e1208127a4b2833d43a65d30aa39610c418604fd469ec51510bd29eb78681b57dc8f77c7ca75e2f4d60ee2758e2fea9"}},
+		{ID: "path-to-regexp@0.1.7", Name: "path-to-regexp", Version: "0.1.7", Locations: types.Locations{{StartLine: 1193, EndLine: 1197}}, Resolved: "https://registry.npmjs.org/path-to-regexp/-/path-to-regexp-0.1.7.tgz", Digests: []types.Digest{"sha1:df604178005f522f15eb4490e7247a1bfaa67f8c"}},
+		{ID: "performance-now@2.1.0", Name: "performance-now", Version: "2.1.0", Locations: types.Locations{{StartLine: 1198, EndLine: 1202}}, Resolved: "https://registry.npmjs.org/performance-now/-/performance-now-2.1.0.tgz", Digests: []types.Digest{"sha1:6309f4e0e5fa913ec1c69307ae364b4b377c9e7b"}},
+		{ID: "promise@8.0.3", Name: "promise", Version: "8.0.3", Locations: types.Locations{{StartLine: 1203, EndLine: 1210}}, Resolved: "https://registry.npmjs.org/promise/-/promise-8.0.3.tgz", Digests: []types.Digest{"sha512:1de44350bd512622e1c80d3f82b9fe3d34a194101c2ee87fd41246b6bbe3c1bbd10c24cb2cc133f6b386095f91def1d8e0c8b122ea0c11df58abf5efb133dc8f"}},
+		{ID: "prop-types@15.7.2", Name: "prop-types", Version: "15.7.2", Locations: types.Locations{{StartLine: 1211, EndLine: 1220}}, Resolved: "https://registry.npmjs.org/prop-types/-/prop-types-15.7.2.tgz", Digests: []types.Digest{"sha512:f1042291d1fbfff476beeac8252bad675b261d84dc2e945610e9479f37161e6058ace194cac2b04acac2f3d0428858f709badf27f9d715d25ea4e56b6351821d"}},
+		{ID: "proxy-addr@2.0.5", Name: "proxy-addr", Version: "2.0.5", Locations: types.Locations{{StartLine: 1221, EndLine: 1229}}, Resolved: "https://registry.npmjs.org/proxy-addr/-/proxy-addr-2.0.5.tgz", Digests: []types.Digest{"sha512:b7fed1c475cf1fa709b4fd29446eac992afd40989d841fb7917bb42a05e76c660c833127531450e3f2c375f3b0644332221dffc476fc3d7dedfa57cbf73f9855"}},
+		{ID: "psl@1.1.31", Name: "psl", Version: "1.1.31", Locations: types.Locations{{StartLine: 1230, EndLine: 1234}}, Resolved: "https://registry.npmjs.org/psl/-/psl-1.1.31.tgz", Digests: []types.Digest{"sha512:ffaa6de3e0be4fec1952278a47adb43a9ccdfcb96734ab968f2d6214b43f506df926a1e547ff3d30fd5df7a7547e47fa0e77b74ee2d0cce60462c849f87c7c9b"}},
+		{ID: "punycode@1.4.1", Name: "punycode", Version: "1.4.1", Locations: types.Locations{{StartLine: 1519, EndLine: 1523}}, Resolved: "https://registry.npmjs.org/punycode/-/punycode-1.4.1.tgz", Digests: []types.Digest{"sha1:c0d5a63b2718800ad8e1eb0fa5269c84dd41845e"}},
+		{ID: "punycode@2.1.1", Name: "punycode", Version: "2.1.1", Locations: types.Locations{{StartLine: 1245, EndLine: 1249}}, Resolved: "https://registry.npmjs.org/punycode/-/punycode-2.1.1.tgz", Digests: []types.Digest{"sha512:5d1b118dd7fe8f99a5fb2ffa18a1cf65bac5ffca766206b424fb5da93218d977b9a2124f0fdb1a0c924b3efa7df8d481a6b56f7af7576726e78f672ff0e11dd0"}},
+		{ID: "qs@6.5.2", Name: "qs", Version: "6.5.2", Locations: types.Locations{{StartLine: 1250, EndLine: 1254}}, Resolved: "https://registry.npmjs.org/qs/-/qs-6.5.2.tgz", Digests: []types.Digest{"sha512:3796405f8fcbc49985fbbc0def8a540faa8087dff09ef750723abd4d98debef5f3494a3b6df9b0f75b1aa8c8f3192db1abdd7fa1d376756fd63a5eea40734318"}},
+		{ID: "range-parser@1.2.0", Name: "range-parser", Version: "1.2.0", Locations: types.Locations{{StartLine: 1255, EndLine: 1259}}, Resolved: "https://registry.npmjs.org/range-parser/-/range-parser-1.2.0.tgz", Digests: []types.Digest{"sha1:f49be6b487894ddc40dcc94a322f611092e00d5e"}},
+		{ID: "raw-body@2.3.3", Name: "raw-body", Version: "2.3.3", Locations: types.Locations{{StartLine: 1260, EndLine: 1270}}, Resolved: "https://registry.npmjs.org/raw-body/-/raw-body-2.3.3.tgz", Digests: []types.Digest{"sha512:f5eb22125bf506b668237ac20ee3ae2820516ee0291866833d07e349f946c5dcb8a32cea821c6eea4944924548bc18def85174057f5ca04bfc2aa5ba5ffee78f"}},
+		{ID: "react-is@16.8.6", Name: "react-is", Version: "16.8.6", Locations: types.Locations{{StartLine: 1282, EndLine: 1286}}, Resolved: "https://registry.npmjs.org/react-is/-/react-is-16.8.6.tgz", Digests: []types.Digest{"sha512:6949376c77d9d9b45254515b6de552e22fa534f66bdff58ce634f6279a26515575cf372cd6701a7f7979d5cb40e4516f0916e1ac7d1b740b1145075d14964fb4"}},
+		{ID: "react@16.8.6", Name: "react", Version: "16.8.6", Locations: types.Locations{{StartLine: 1271, EndLine: 1281}}, Resolved: "https://registry.npmjs.org/react/-/react-16.8.6.tgz", Digests: []types.Digest{"sha512:a42d2e32484b6879b5d5948950b7ce06a578b48664c7ced92ef6db418ba7362c4002f8e70beb272428345d05e7f5522cb6d56c6d93ff1ff7b0ce0b1de5fc4a5f"}},
+		{ID: "redux@4.0.1", Name: "redux", Version: "4.0.1", Locations: types.Locations{{StartLine: 1287, EndLine: 1295}}, Resolved: "https://registry.npmjs.org/redux/-/redux-4.0.1.tgz", Digests: []types.Digest{"sha512:47b6c0b52924ee763a3bf39831547d462048f978218c5f6b95b979f34e8725b4298742c954766b539a1a3b8abbd1e50a8aa311aa6e32d3b28b4e5319d819559a"}},
+		{ID: "request@2.88.0", Name: "request", Version: "2.88.0", Locations: types.Locations{{StartLine: 1296, EndLine: 1322}}, Resolved: "https://registry.npmjs.org/request/-/request-2.88.0.tgz", Digests: []types.Digest{"sha512:340a814ab8a318b65d33459936c2272c9a6426890bef65d88d4a670748b0b1183187b741e823ab1e74c137037413e9470c0273bbb90b0240de634f33dbf03486"}},
+		{ID: "safe-buffer@5.1.2", Name: "safe-buffer", Version: "5.1.2", Locations: types.Locations{{StartLine: 1335, EndLine: 1339}}, Resolved: "https://registry.npmjs.org/safe-buffer/-/safe-buffer-5.1.2.tgz", Digests: []types.Digest{"sha512:19dd94641243917958ec66c9c5fb04f3f9ef2a45045351b7f1cd6c88de903fa6bd3d3f4c98707c1a7a6c71298c252a05f0b388aedf2e77fc0fb688f2b381bafa"}},
+		{ID: "safer-buffer@2.1.2", Name: "safer-buffer", Version: "2.1.2", Locations: types.Locations{{StartLine: 1340, EndLine: 1344}}, Resolved: "https://registry.npmjs.org/safer-buffer/-/safer-buffer-2.1.2.tgz", Digests: []types.Digest{"sha512:619a372bcd920fb462ca2d04d4440fa232f3ee4a5ea6749023d2323db1c78355d75debdbe5d248eeda72376003c467106c71bbbdcc911e4d1c6f0a9c42b894b6"}},
+		{ID: "scheduler@0.13.6", Name: "scheduler", Version: "0.13.6", Locations: types.Locations{{StartLine: 1345, EndLine: 1353}}, Resolved: "https://registry.npmjs.org/scheduler/-/scheduler-0.13.6.tgz", Digests: []types.Digest{"sha512:2169ce6c7b78d77b9c0182ac0fd2754128542a46ca2d0407771472c3bdecc3814ddba896af70d8fc7df8c463dee2798bd43c17c969925a632b03d4df41b13f5d"}},
+		{ID: "send@0.16.2", Name: "send", Version: "0.16.2", Locations: types.Locations{{StartLine: 1360, EndLine: 1394}}, Resolved: "https://registry.npmjs.org/send/-/send-0.16.2.tgz", Digests: []types.Digest{"sha512:13ae1814f52cb051c4141be96db8ebe383422ed23502887143c6528898d02ec90074abab89810fe95c8612c4431fc49ca331a991a5f5046be16a7a82c2470467"}},
+		{ID: "serve-static@1.13.2", Name: "serve-static", Version: "1.13.2", Locations: types.Locations{{StartLine: 1395, EndLine: 1405}}, Resolved: "https://registry.npmjs.org/serve-static/-/serve-static-1.13.2.tgz", Digests: []types.Digest{"sha512:a7fb5d26b3b8537f3b47da0c8dbd688fba9231a31f98ec9de23f61385a3165ed9b690b338077125a3bb26bf0a24f9920659291b9d1cd380d296173a5f2b4399f"}},
+		{ID: "setprototypeof@1.1.0", Name: "setprototypeof", Version: "1.1.0", Locations: types.Locations{{StartLine: 1412, EndLine: 1416}}, Resolved: "https://registry.npmjs.org/setprototypeof/-/setprototypeof-1.1.0.tgz", Digests: []types.Digest{"sha512:06f13f4f0a595f8157131c4ec59c9119042feb9d4c4b09962991aabe63dc4488c3a96b9bebb9132ae20cc78ddc659ad2fdc041cf005c3435a8171b765c4148a5"}},
+		{ID: "sshpk@1.16.1", Name: "sshpk", Version: "1.16.1", Locations: types.Locations{{StartLine: 1444, EndLine: 1459}}, Resolved: "https://registry.npmjs.org/sshpk/-/sshpk-1.16.1.tgz", Digests: []types.Digest{"sha512:1d75ea554abbfa970a78baaa663ea61c550cbd7b4e26dd6ea14c74f69156eb4d758a74ccc6a23c040f0f33de66cab232c8ac1d9f38dd1632e213a2813d5b4922"}},
+		{ID: "statuses@1.4.0", Name: "statuses", Version: "1.4.0", Locations: types.Locations{{StartLine: 1460, EndLine: 1464}}, Resolved: "https://registry.npmjs.org/statuses/-/statuses-1.4.0.tgz", Digests: []types.Digest{"sha512:ce1482b6df2fd8d0eb4653d0a4236dc3f85e64bb5f503ab104cd6e76a8a46ff1db939d8b2b89d04b0af5d2eefb8a8a425b92ecc87a6e5d2d069c4738f262de7b"}},
+		{ID: "supports-color@5.5.0", Name: "supports-color", Version: "5.5.0", Locations: types.Locations{{StartLine: 205, EndLine: 212}}, Resolved: "https://registry.npmjs.org/supports-color/-/supports-color-5.5.0.tgz", Digests: []types.Digest{"sha512:423563c1d5c8b78d3c308880a825f8a142ac814d84a801b3b363e9926e1a4186e39be644584716e127c5353af8b8c35999ad1ecb87f99602eb901d1a5f440ca3"}},
+		{ID: "symbol-observable@1.2.0", Name: "symbol-observable", Version: "1.2.0", Locations: types.Locations{{StartLine: 1505, EndLine: 1509}}, Resolved: "https://registry.npmjs.org/symbol-observable/-/symbol-observable-1.2.0.tgz", Digests: []types.Digest{"sha512:7bdd349ccf1146d1a1955dfa286114f64eb92b798f6f5595ef439d8dfc651b6100b8cd67a22fc4fc1696fee3212fb6cf12cb0af10579eef3777ac8b18d4bdc5d"}},
+		{ID: "tough-cookie@2.4.3", Name: "tough-cookie", Version: "2.4.3", Locations: types.Locations{{StartLine: 1510, EndLine: 1525}}, Resolved: "https://registry.npmjs.org/tough-cookie/-/tough-cookie-2.4.3.tgz", Digests: []types.Digest{"sha512:439b2b93fe2f0cce78589b098a8dd7367e8adac086f8243c1b95b3e9b661459a007bff93c63581fc69450276046e461594e37d14c93f7c4de1d912e93d7a3895"}},
+		{ID: "tunnel-agent@0.6.0", Name: "tunnel-agent", Version: "0.6.0", Locations: types.Locations{{StartLine: 1526, EndLine: 1533}}, Resolved: "https://registry.npmjs.org/tunnel-agent/-/tunnel-agent-0.6.0.tgz", Digests: []types.Digest{"sha1:27a5dea06b36b04a0a9966774b290868f0fc40fd"}},
+		{ID: "tweetnacl@0.14.5", Name: "tweetnacl", Version: "0.14.5", Locations: types.Locations{{StartLine: 1534, EndLine: 1538}}, Resolved: "https://registry.npmjs.org/tweetnacl/-/tweetnacl-0.14.5.tgz", Digests: []types.Digest{"sha1:5ae68177f192d4456269d108afa93ff8743f4f64"}},
+		{ID: "type-is@1.6.18", Name: "type-is", Version: "1.6.18", Locations: types.Locations{{StartLine: 1539, EndLine: 1547}}, Resolved: "https://registry.npmjs.org/type-is/-/type-is-1.6.18.tgz", Digests: []types.Digest{"sha512:4e444aafdb144f1107f0c75fb8248fed58b3272cd134c8e3d89d9da3626bdcaca6e7df0955d124b2eccf4029e514f5b8932f50fa203e99af411a6d3a5d0072f2"}},
+		{ID: "unpipe@1.0.0", Name: "unpipe", Version: "1.0.0", Locations: types.Locations{{StartLine: 1548, EndLine: 1552}}, Resolved: "https://registry.npmjs.org/unpipe/-/unpipe-1.0.0.tgz", Digests: []types.Digest{"sha1:b2bf4ee8514aae6165b4817829d21b2ef49904ec"}},
+		{ID: "uri-js@4.2.2", Name: "uri-js", Version: "4.2.2", Locations: types.Locations{{StartLine: 1553, EndLine: 1560}}, Resolved: "https://registry.npmjs.org/uri-js/-/uri-js-4.2.2.tgz", Digests: []types.Digest{"sha512:298f45ae68abaa5f755f64208ebcb459de18f984ddadd661792f13170be46cb59ffc6e4a3490c287aa4a2f939972d116e3ed0169ae6274ad9942e10b4703f39d"}},
+		{ID: "utils-merge@1.0.1", Name: "utils-merge", Version: "1.0.1", Locations: types.Locations{{StartLine: 1561, EndLine: 1565}}, Resolved: "https://registry.npmjs.org/utils-merge/-/utils-merge-1.0.1.tgz", Digests: []types.Digest{"sha1:9f95710f50a267947b2ccc124741c1028427e713"}},
+		{ID: "uuid@3.3.2", Name: "uuid", Version: "3.3.2", Locations: types.Locations{{StartLine: 1566, EndLine: 1570}}, Resolved: "https://registry.npmjs.org/uuid/-/uuid-3.3.2.tgz", Digests: []types.Digest{"sha512:c9726678d6b0dc39e728038a244e75b0bfd96987d6251975a4af5daf5f58142bb439b4b6df5001d7f2dba93291010e64c3c03dd2b03a7ebe2b88e5294d9bd064"}},
+		{ID: "vary@1.1.2", Name: "vary", Version: "1.1.2", Locations: types.Locations{{StartLine: 1571, EndLine: 1575}}, Resolved: "https://registry.npmjs.org/vary/-/vary-1.1.2.tgz", Digests: []types.Digest{"sha1:2299f02c6ded30d4a5961b0b9f74524a18f634fc"}},
+		{ID: "verror@1.10.0", Name: "verror", Version: "1.10.0", Locations: types.Locations{{StartLine: 1576, EndLine: 1585}}, Resolved: "https://registry.npmjs.org/verror/-/verror-1.10.0.tgz", Digests: []types.Digest{"sha1:3a105ca17053af55d6e270c1f8288682e18da400"}},
+		{ID: "vue@2.6.10", Name: "vue", Version: "2.6.10", Locations: types.Locations{{StartLine: 1586, EndLine: 1590}}, Resolved: "https://registry.npmjs.org/vue/-/vue-2.6.10.tgz", Digests: []types.Digest{"sha512:2264e1a5e354f476dd64bdeeb60302ab4a22333024b7599c832dff13acd60bf1ba01a4287ae15db25f670e14c35375f547a14aee7b0852e44009572323e03619"}},
 	}
 
 	// manually created
 	npmNested = []types.Library{
-		{Name: "debug", Version: "2.0.0"},
-		{Name: "debug", Version: "2.6.9"},
-		{Name: "ms", Version: "0.6.2"},
-		{Name: "ms", Version: "2.0.0"},
-		{Name: "ms", Version: "2.1.0"},
-		{Name: "ms", Version: "2.1.1"},
-		{Name: "send", Version: "0.17.1"},
+		{ID: "debug@2.0.0", Name: "debug", Version: "2.0.0", Locations: types.Locations{{StartLine: 6, EndLine: 20}}, Resolved: "https://registry.npmjs.org/debug/-/debug-2.0.0.tgz", Digests: []types.Digest{"sha1:89bd9df6732b51256bc6705342bba02ed12131ef"}},
+		{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9", Locations: types.Locations{{StartLine: 46, EndLine: 60}}, Resolved: "https://registry.npmjs.org/debug/-/debug-2.6.9.tgz", Digests: []types.Digest{"sha512:6c2ec496b7496899cf6c03fed44a2d62fa99b1bdde725e708ba05f8ba0494d470da30a7a72fb298348d7ce74532838e6fc4ec076014155e00f54c35c286b0730"}},
+		{ID: "ms@0.6.2", Name: "ms", Version: "0.6.2", Locations: types.Locations{{StartLine: 14, EndLine: 18}}, Resolved: "https://registry.npmjs.org/ms/-/ms-0.6.2.tgz", Digests: []types.Digest{"sha1:d89c2124c6fdc1353d65a8b77bf1aac4b193708c"}},
+		{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0", Locations: types.Locations{{StartLine: 54, EndLine: 58}}, Resolved: "https://registry.npmjs.org/ms/-/ms-2.0.0.tgz", Digests: []types.Digest{"sha1:5608aeadfc00be6c2901df5f9861788de0d597c8"}},
+		{ID: "ms@2.1.0", Name: "ms", Version: "2.1.0", Locations: types.Locations{{StartLine: 21, EndLine: 25}}, Resolved: "https://registry.npmjs.org/ms/-/ms-2.0.0.tgz", Digests: []types.Digest{"sha1:5608aeadfc00be6c2901df5f9861788de0d597c8"}},
+		{ID: "ms@2.1.1", Name: "ms", Version: "2.1.1", Locations: types.Locations{{StartLine: 61, EndLine: 65}}, Resolved: "https://registry.npmjs.org/ms/-/ms-2.1.1.tgz", Digests: []types.Digest{"sha512:b60a7e765e5c1a4dbcbad624b41b2b16a03b1ca82b8603ec83a67f11f856238825d47c2af01fc6998ff4a1767a9c5f210d57ac4bf1699d8683fe439685842fca"}},
+		{ID: "send@0.17.1", Name: "send", Version: "0.17.1", Locations: types.Locations{{StartLine: 26, EndLine: 67}}, Resolved: "https://registry.npmjs.org/send/-/send-0.17.1.tgz", Digests: []types.Digest{"sha512:06c54ab2219c40c1704fc531ca9a1b50acafee2ac23511e4d53d06ebcd2f93cf327fa2c107219c649393242ad33f6c5537ac88f978cf25c36e1375787d3f6c02"}},
+	}
+
+	npmNormalDeps = []types.Dependency{
+		{ID: "promise@8.0.3", DependsOn: []string{"asap@2.0.6"}},
+	}
+
+	npmReactDeps = []types.Dependency{
+		{ID: "loose-envify@1.4.0", DependsOn: []string{"js-tokens@4.0.0"}},
+		{ID: "promise@8.0.3", DependsOn: []string{"asap@2.0.6"}},
+		{ID: "prop-types@15.7.2", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1", "react-is@16.8.6"}},
+		{
+			ID: "react@16.8.6",
+			DependsOn: []string{
+				"loose-envify@1.4.0",
+				"object-assign@4.1.1",
+				"prop-types@15.7.2",
+				"scheduler@0.13.6",
+			},
+		},
+		{ID: "redux@4.0.1", DependsOn: []string{"loose-envify@1.4.0", "symbol-observable@1.2.0"}},
+		{ID: "scheduler@0.13.6", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1"}},
+	}
+
+	npmWithDevDeps = []types.Dependency{
+		{ID: "loose-envify@1.4.0", DependsOn: []string{"js-tokens@4.0.0"}},
+		{ID: "promise@8.0.3", DependsOn: []string{"asap@2.0.6"}},
+		{ID: "prop-types@15.7.2", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1", "react-is@16.8.6"}},
+		{
+			ID: "react@16.8.6",
+			DependsOn: []string{
+				"loose-envify@1.4.0",
+				"object-assign@4.1.1",
+				"prop-types@15.7.2",
+				"scheduler@0.13.6",
+			},
+		},
+		{ID: "redux@4.0.1", DependsOn: []string{"loose-envify@1.4.0", "symbol-observable@1.2.0"}},
+		{ID: "scheduler@0.13.6", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1"}},
+	}
+
+	npmManyDeps = []types.Dependency{
+		{ID: "accepts@1.3.6", DependsOn: []string{"mime-types@2.1.24", "negotiator@0.6.1"}},
+		{
+			ID: "ajv@6.10.0",
+			DependsOn: []string{
+				"fast-deep-equal@2.0.1",
+				"fast-json-stable-stringify@2.0.0",
+				"json-schema-traverse@0.4.1",
+				"uri-js@4.2.2",
+			},
+		},
+		{ID: "ansi-styles@3.2.1", DependsOn: []string{"color-convert@1.9.3"}},
+		{ID: "asn1@0.2.4", DependsOn: []string{"safer-buffer@2.1.2"}},
+		{ID: "async@2.6.2", DependsOn: []string{"lodash@4.17.11"}},
+		{ID: "axios@0.18.0", DependsOn: []string{"follow-redirects@1.7.0", "is-buffer@1.1.6"}},
+		{ID: "bcrypt-pbkdf@1.0.2", DependsOn: []string{"tweetnacl@0.14.5"}},
+		{
+			ID: "body-parser@1.18.3",
+			DependsOn: []string{
+				"bytes@3.0.0",
+				"content-type@1.0.4",
+				"debug@2.6.9",
+				"depd@1.1.2",
+				"http-errors@1.6.3",
+				"iconv-lite@0.4.23",
+				"on-finished@2.3.0",
+				"qs@6.5.2",
+				"raw-body@2.3.3",
+				"type-is@1.6.18",
+			},
+		},
+		{ID: "chalk@2.4.2", DependsOn: []string{"ansi-styles@3.2.1", "escape-string-regexp@1.0.5", "supports-color@5.5.0"}},
+		{ID: "color-convert@1.9.3", DependsOn: []string{"color-name@1.1.3"}},
+		{ID: "combined-stream@1.0.7", DependsOn: []string{"delayed-stream@1.0.0"}},
+		{ID: "dashdash@1.14.1", DependsOn: []string{"assert-plus@1.0.0"}},
+		{ID: "debug@2.6.9", DependsOn: []string{"ms@2.0.0"}},
+		{ID: "debug@3.2.6", DependsOn: []string{"ms@2.1.1"}},
+		{ID: "ecc-jsbn@0.1.2", DependsOn: []string{"jsbn@0.1.1", "safer-buffer@2.1.2"}},
+		{
+			ID: "express@4.16.4",
+			DependsOn: []string{
+				"accepts@1.3.6",
+				"array-flatten@1.1.1",
+				"body-parser@1.18.3",
+				"content-disposition@0.5.2",
+				"content-type@1.0.4",
+				"cookie-signature@1.0.6",
+				"cookie@0.3.1",
+				"debug@2.6.9",
+				"depd@1.1.2",
+				"encodeurl@1.0.2",
+				"escape-html@1.0.3",
+				"etag@1.8.1",
+				"finalhandler@1.1.1",
+				"fresh@0.5.2",
+				"merge-descriptors@1.0.1",
+				"methods@1.1.2",
+				"on-finished@2.3.0",
+				"parseurl@1.3.3",
+				"path-to-regexp@0.1.7",
+				"proxy-addr@2.0.5",
+				"qs@6.5.2",
+				"range-parser@1.2.0",
+				"safe-buffer@5.1.2",
+				"send@0.16.2",
+				"serve-static@1.13.2",
+				"setprototypeof@1.1.0",
+				"statuses@1.4.0",
+				"type-is@1.6.18",
+				"utils-merge@1.0.1",
+				"vary@1.1.2",
+			},
+		},
+		{
+			ID: "finalhandler@1.1.1",
+			DependsOn: []string{
+				"debug@2.6.9",
+				"encodeurl@1.0.2",
+				"escape-html@1.0.3",
+				"on-finished@2.3.0",
+				"parseurl@1.3.3",
+				"statuses@1.4.0",
+				"unpipe@1.0.0",
+			},
+		},
+		{ID: "follow-redirects@1.7.0", DependsOn: []string{"debug@3.2.6"}},
+		{ID: "form-data@2.3.3", DependsOn: []string{"asynckit@0.4.0", "combined-stream@1.0.7", "mime-types@2.1.24"}},
+		{ID: "getpass@0.1.7", DependsOn: []string{"assert-plus@1.0.0"}},
+		{ID: "har-validator@5.1.3", DependsOn: []string{"ajv@6.10.0", "har-schema@2.0.0"}},
+		{
+			ID: "http-errors@1.6.3",
+			DependsOn: []string{
+				"depd@1.1.2",
+				"inherits@2.0.3",
+				"setprototypeof@1.1.0",
+				"statuses@1.4.0",
+			},
+		},
+		{ID: "http-signature@1.2.0", DependsOn: []string{"assert-plus@1.0.0", "jsprim@1.4.1", "sshpk@1.16.1"}},
+		{ID: "iconv-lite@0.4.23", DependsOn: []string{"safer-buffer@2.1.2"}},
+		{
+			ID: "jsprim@1.4.1",
+			DependsOn: []string{
+				"assert-plus@1.0.0",
+				"extsprintf@1.3.0",
+				"json-schema@0.2.3",
+				"verror@1.10.0",
+			},
+		},
+		{ID: "loose-envify@1.4.0", DependsOn: []string{"js-tokens@4.0.0"}},
+		{ID: "mime-types@2.1.24", DependsOn: []string{"mime-db@1.40.0"}},
+		{ID: "on-finished@2.3.0", DependsOn: []string{"ee-first@1.1.1"}},
+		{ID: "promise@8.0.3", DependsOn: []string{"asap@2.0.6"}},
+		{ID: "prop-types@15.7.2", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1", "react-is@16.8.6"}},
+		{ID: "proxy-addr@2.0.5", DependsOn: []string{"forwarded@0.1.2", "ipaddr.js@1.9.0"}},
+		{ID: "raw-body@2.3.3", DependsOn: []string{"bytes@3.0.0", "http-errors@1.6.3", "iconv-lite@0.4.23", "unpipe@1.0.0"}},
+		{
+			ID: "react@16.8.6",
+			DependsOn: []string{
+				"loose-envify@1.4.0",
+				"object-assign@4.1.1",
+				"prop-types@15.7.2",
+				"scheduler@0.13.6",
+			},
+		},
+		{ID: "redux@4.0.1", DependsOn: []string{"loose-envify@1.4.0", "symbol-observable@1.2.0"}},
+		{
+			ID: "request@2.88.0",
+			DependsOn: []string{
+				"aws-sign2@0.7.0",
+				"aws4@1.8.0",
+				"caseless@0.12.0",
+				"combined-stream@1.0.7",
+				"extend@3.0.2",
+				"forever-agent@0.6.1",
+				"form-data@2.3.3",
+				"har-validator@5.1.3",
+				"http-signature@1.2.0",
+				"is-typedarray@1.0.0",
+				"isstream@0.1.2",
+				"json-stringify-safe@5.0.1",
+				"mime-types@2.1.24",
+				"oauth-sign@0.9.0",
+				"performance-now@2.1.0",
+				"qs@6.5.2",
+				"safe-buffer@5.1.2",
+				"tough-cookie@2.4.3",
+				"tunnel-agent@0.6.0",
+				"uuid@3.3.2",
+			},
+		},
+		{ID: "scheduler@0.13.6", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1"}},
+		{
+			ID: "send@0.16.2",
+			DependsOn: []string{
+				"debug@2.6.9",
+				"depd@1.1.2",
+				"destroy@1.0.4",
+				"encodeurl@1.0.2",
+				"escape-html@1.0.3",
+				"etag@1.8.1",
+				"fresh@0.5.2",
+				"http-errors@1.6.3",
+				"mime@1.4.1",
+				"ms@2.0.0",
+				"on-finished@2.3.0",
+				"range-parser@1.2.0",
+				"statuses@1.4.0",
+			},
+		},
+		{
+			ID: "serve-static@1.13.2",
+			DependsOn: []string{
+				"encodeurl@1.0.2",
+				"escape-html@1.0.3",
+				"parseurl@1.3.3",
+				"send@0.16.2",
+			},
+		},
+		{
+			ID: "sshpk@1.16.1",
+			DependsOn: []string{
+				"asn1@0.2.4",
+				"assert-plus@1.0.0",
+				"bcrypt-pbkdf@1.0.2",
+				"dashdash@1.14.1",
+				"ecc-jsbn@0.1.2",
+				"getpass@0.1.7",
+				"jsbn@0.1.1",
+				"safer-buffer@2.1.2",
+				"tweetnacl@0.14.5",
+			},
+		},
+		{ID: "supports-color@5.5.0", DependsOn: []string{"has-flag@3.0.0"}},
+		{ID: "tough-cookie@2.4.3", DependsOn: []string{"psl@1.1.31", "punycode@1.4.1"}},
+		{ID: "tunnel-agent@0.6.0", DependsOn: []string{"safe-buffer@5.1.2"}},
+		{ID: "type-is@1.6.18", DependsOn: []string{"media-typer@0.3.0", "mime-types@2.1.24"}},
+		{ID: "uri-js@4.2.2", DependsOn: []string{"punycode@2.1.1"}},
+		{ID: "verror@1.10.0", DependsOn: []string{"assert-plus@1.0.0", "core-util-is@1.0.2", "extsprintf@1.3.0"}},
+	}
+
+	npmNestedDeps = []types.Dependency{
+		{ID: "debug@2.0.0", DependsOn: []string{"ms@0.6.2"}},
+		{ID: "debug@2.6.9", DependsOn: []string{"ms@2.0.0"}},
+		{ID: "send@0.17.1", DependsOn: []string{"debug@2.6.9", "ms@2.1.1"}},
+	}
+)
+
+var (
+	// lockfileVersion 3 with workspaces, an aliased package and dev, optional, devOptional and peer packages
+	npmV3 = []types.Library{
+		{ID: "@babel/helper-string-parser@7.19.4", Name: "@babel/helper-string-parser", Version: "7.19.4", Locations: types.Locations{{StartLine: 25, EndLine: 32}}, Resolved: "https://registry.npmjs.org/@babel/helper-string-parser/-/helper-string-parser-7.19.4.tgz", Digests: []types.Digest{"sha512:9c7b43a1072ea859b060d60fcf745a879a61da9f0f15e142b1993d03fe3c74f73fac6a1c279277840019ee96fbe95597ddf64abbeb84aff1611f98cbc7bba6af"}},
+		{ID: "ansi-regex@5.0.1", Name: "ansi-regex", Version: "5.0.1", Indirect: true, Locations: types.Locations{{StartLine: 33, EndLine: 37}}, Resolved: "https://registry.npmjs.org/ansi-regex/-/ansi-regex-5.0.1.tgz", Digests: []types.Digest{"sha512:aae2505e54d25062f62c7f52517a3c570b18e2ca1a9e1828e8b3529bce04d4b05c13cb373b4c29762473c91f73fd9649325316bf7eea38e6fda5d26531410a15"}},
+		{ID: "app@0.1.0", Name: "app", Version: "0.1.0", Locations: types.Locations{{StartLine: 120, EndLine: 126}}},
+		{ID: "debug@4.3.4", Name: "debug", Version: "4.3.4", Locations: types.Locations{{StartLine: 42, EndLine: 57}}, Resolved: "https://registry.npmjs.org/debug/-/debug-4.3.4.tgz", Digests: []types.Digest{"sha512:3d15851ee494dde0ed4093ef9cd63b25c91eb758f4b793ae3ac1733cfcec7a40f9d9997ca947c520f122b305ea22f1d61951ce817fbb1bfbc234d85e870c5f91"}},
+		{ID: "fsevents@2.3.2", Name: "fsevents", Version: "2.3.2", Locations: types.Locations{{StartLine: 58, EndLine: 66}}, Resolved: "https://registry.npmjs.org/fsevents/-/fsevents-2.3.2.tgz", Digests: []types.Digest{"sha512:c62a8c411e3101e1d3b81f6e5a6f9f1517083a02813223813fe7978b24fb8ec8150aad5b915ca0b74d28012a3007b11db6938769a3e02adf35d8ff5a6fe0c328"}},
+		{ID: "has-flag@4.0.0", Name: "has-flag", Version: "4.0.0", Indirect: true, Locations: types.Locations{{StartLine: 67, EndLine: 72}}, Resolved: "https://registry.npmjs.org/has-flag/-/has-flag-4.0.0.tgz", Digests: []types.Digest{"sha512:1329094ff4352a34d672da698080207d23b4b4a56e6548e180caf5ee4a93ba6325e807efdc421295e53ba99533a170c54c01d30c2e0d3a81bf67153712f94c3d"}},
+		{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0", Locations: types.Locations{{StartLine: 127, EndLine: 131}}, Resolved: "https://registry.npmjs.org/ms/-/ms-2.0.0.tgz"},
+		{ID: "ms@2.1.2", Name: "ms", Version: "2.1.2", Indirect: true, Locations: types.Locations{{StartLine: 88, EndLine: 92}}, Resolved: "https://registry.npmjs.org/ms/-/ms-2.1.2.tgz", Digests: []types.Digest{"sha512:b0690fc7e56332d980e8c5f6ee80381411442c50996784b85ea7863970afebcb53fa36f7be4fd1c9a2963f43d32b25ad98b48cd1bf9a7544c4bdbb353c4687db"}},
+		{ID: "string-width@4.2.3", Name: "string-width", Version: "4.2.3", Locations: types.Locations{{StartLine: 93, EndLine: 101}}, Resolved: "https://registry.npmjs.org/string-width/-/string-width-4.2.3.tgz", Digests: []types.Digest{"sha512:c0ac90450a63274b08a7ad84ad265d1ac8cc256b1aa79a1136284786ee86ec954effd8c807a5327af2feb57b8eaab9e0f23fdcc4a4d6c96530bd24eb8a2673fe"}},
+		{ID: "strip-ansi@6.0.1", Name: "strip-ansi", Version: "6.0.1", Indirect: true, Locations: types.Locations{{StartLine: 102, EndLine: 109}}, Resolved: "https://registry.npmjs.org/strip-ansi/-/strip-ansi-6.0.1.tgz", Digests: []types.Digest{"sha512:637f153d21dcaa416b0a916743dbee4979aabaebf9a1738aa46793e9a1abaf7a3619cf409556ba2417d448e0a76f118665067833c350672426a971d5e2b77843"}},
+		{ID: "supports-color@8.1.1", Name: "supports-color", Version: "8.1.1", Indirect: true, Locations: types.Locations{{StartLine: 110, EndLine: 119}}, Resolved: "https://registry.npmjs.org/supports-color/-/supports-color-8.1.1.tgz", Digests: []types.Digest{"sha512:3295043763a876d533c6f29097bd9c505ed14391221ec1af4ac546d226bd73945b5862f6088e02ec4a4f4bc513048a659e5cd988db95e7ac3e16e371cb7b72d9"}},
+	}
+	npmV3Deps = []types.Dependency{
+		{ID: "app@0.1.0", DependsOn: []string{"ms@2.0.0"}},
+		{ID: "debug@4.3.4", DependsOn: []string{"ms@2.1.2", "supports-color@8.1.1"}},
+		{ID: "string-width@4.2.3", DependsOn: []string{"strip-ansi@6.0.1"}},
+		{ID: "strip-ansi@6.0.1", DependsOn: []string{"ansi-regex@5.0.1"}},
+		{ID: "supports-color@8.1.1", DependsOn: []string{"has-flag@4.0.0"}},
 	}
 )
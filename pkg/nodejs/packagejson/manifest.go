@@ -0,0 +1,165 @@
+package packagejson
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/xerrors"
+)
+
+// Manifest holds package.json with the declared dependencies.
+// Ranges are kept as they are written. e.g. "^1.2.0", "npm:string-width@^4.2.0" and "workspace:*"
+// ref. https://docs.npmjs.com/cli/configuring-npm/package-json
+type Manifest struct {
+	Name    string
+	Version string
+	License string
+
+	Dependencies         map[string]string
+	DevDependencies      map[string]string
+	PeerDependencies     map[string]string
+	OptionalDependencies map[string]string
+	// BundledDependencies are the names of the bundled dependencies.
+	// All the dependencies are bundled if "bundledDependencies" is true.
+	BundledDependencies []string
+
+	// Overrides are flattened with ">" between the names. e.g. {"foo": {"bar": "1.0.0"}} => "foo>bar": "1.0.0"
+	// The range for the package itself in nested overrides is under the key of the package. e.g. {"foo": {".": "1.0.0"}} => "foo": "1.0.0"
+	Overrides map[string]string
+	// Resolutions are the ones of yarn. e.g. "**/foo": "1.0.0"
+	Resolutions map[string]string
+	// Workspaces are the glob patterns of the workspaces.
+	Workspaces []string
+}
+
+type manifestJSON struct {
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	License              interface{}       `json:"license"`
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	BundledDependencies  json.RawMessage   `json:"bundledDependencies"`
+	// "bundleDependencies" is also accepted by npm.
+	BundleDependencies json.RawMessage            `json:"bundleDependencies"`
+	Overrides          map[string]json.RawMessage `json:"overrides"`
+	Resolutions        map[string]string          `json:"resolutions"`
+	Workspaces         json.RawMessage            `json:"workspaces"`
+}
+
+// ParseManifest parses package.json with the declared dependencies.
+// Unlike Parse, name and version are not required as they are optional for private projects.
+func ParseManifest(r io.Reader) (Manifest, error) {
+	var data manifestJSON
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return Manifest{}, xerrors.Errorf("JSON decode error: %w", err)
+	}
+
+	manifest := Manifest{
+		Name:                 data.Name,
+		Version:              data.Version,
+		License:              parseLicense(data.License),
+		Dependencies:         data.Dependencies,
+		DevDependencies:      data.DevDependencies,
+		PeerDependencies:     data.PeerDependencies,
+		OptionalDependencies: data.OptionalDependencies,
+		Resolutions:          data.Resolutions,
+	}
+
+	var err error
+	bundled := data.BundledDependencies
+	if len(bundled) == 0 {
+		bundled = data.BundleDependencies
+	}
+	if manifest.BundledDependencies, err = parseBundledDependencies(bundled, data.Dependencies); err != nil {
+		return Manifest{}, xerrors.Errorf("bundledDependencies parse error: %w", err)
+	}
+
+	if len(data.Overrides) > 0 {
+		manifest.Overrides = map[string]string{}
+		if err = flattenOverrides("", data.Overrides, manifest.Overrides); err != nil {
+			return Manifest{}, xerrors.Errorf("overrides parse error: %w", err)
+		}
+	}
+
+	if manifest.Workspaces, err = parseWorkspaces(data.Workspaces); err != nil {
+		return Manifest{}, xerrors.Errorf("workspaces parse error: %w", err)
+	}
+	return manifest, nil
+}
+
+// parseBundledDependencies accepts an array of names or a boolean.
+func parseBundledDependencies(raw json.RawMessage, dependencies map[string]string) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var all bool
+	if err := json.Unmarshal(raw, &all); err == nil {
+		if !all {
+			return nil, nil
+		}
+		names := maps.Keys(dependencies)
+		sort.Strings(names)
+		return names, nil
+	}
+
+	var names []string
+	if err := json.Unmarshal(raw, &names); err != nil {
+		return nil, xerrors.Errorf("JSON decode error: %w", err)
+	}
+	return names, nil
+}
+
+// flattenOverrides converts nested overrides into a flat map.
+// e.g. {"foo": {".": "1.0.0", "bar": "2.0.0"}} => "foo": "1.0.0", "foo>bar": "2.0.0"
+func flattenOverrides(prefix string, overrides map[string]json.RawMessage, flattened map[string]string) error {
+	for name, raw := range overrides {
+		key := name
+		if prefix != "" {
+			key = prefix + ">" + name
+		}
+		if name == "." {
+			key = prefix
+		}
+
+		var rng string
+		if err := json.Unmarshal(raw, &rng); err == nil {
+			flattened[key] = rng
+			continue
+		}
+
+		var nested map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &nested); err != nil {
+			return xerrors.Errorf("invalid override of %s: %w", key, err)
+		}
+		if err := flattenOverrides(key, nested, flattened); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseWorkspaces accepts an array of patterns or an object with "packages" in yarn.
+// e.g. {"packages": ["packages/*"], "nohoist": ["**/react-native"]}
+func parseWorkspaces(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var patterns []string
+	if err := json.Unmarshal(raw, &patterns); err == nil {
+		return patterns, nil
+	}
+
+	var workspaces struct {
+		Packages []string `json:"packages"`
+	}
+	if err := json.Unmarshal(raw, &workspaces); err != nil {
+		return nil, xerrors.Errorf("JSON decode error: %w", err)
+	}
+	return workspaces.Packages, nil
+}
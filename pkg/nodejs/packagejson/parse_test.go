@@ -74,3 +74,88 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseManifest(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputFile string
+		want      packagejson.Manifest
+		wantErr   string
+	}{
+		{
+			name:      "happy path",
+			inputFile: "testdata/manifest_package.json",
+			want: packagejson.Manifest{
+				Name:    "app",
+				License: "Apache-2.0",
+				Dependencies: map[string]string{
+					"express":          "^4.18.2",
+					"lodash":           "https://github.com/lodash/lodash.git#4.17.21",
+					"shared":           "workspace:*",
+					"string-width-cjs": "npm:string-width@^4.2.0",
+				},
+				DevDependencies: map[string]string{
+					"jest": "~29.5.0",
+				},
+				PeerDependencies: map[string]string{
+					"react": ">=16.8.0 <19",
+				},
+				OptionalDependencies: map[string]string{
+					"fsevents": "2.x",
+				},
+				BundledDependencies: []string{"express"},
+				Overrides: map[string]string{
+					"semver":                    "7.5.2",
+					"express":                   "4.18.2",
+					"express>qs":                "6.11.0",
+					"express>body-parser>debug": "$debug",
+				},
+				Resolutions: map[string]string{
+					"**/minimist": "^1.2.6",
+				},
+				Workspaces: []string{"packages/*"},
+			},
+		},
+		{
+			name:      "all dependencies bundled",
+			inputFile: "testdata/bundled_all_package.json",
+			want: packagejson.Manifest{
+				Name:    "bundled",
+				Version: "1.0.0",
+				Dependencies: map[string]string{
+					"promise": "^8.0.3",
+					"asap":    "~2.0.6",
+				},
+				BundledDependencies: []string{"asap", "promise"},
+				Workspaces:          []string{"packages/a", "packages/b"},
+			},
+		},
+		{
+			name:      "sad path",
+			inputFile: "testdata/invalid_package.json",
+			wantErr:   "JSON decode error",
+		},
+		{
+			name:      "invalid overrides",
+			inputFile: "testdata/invalid_overrides_package.json",
+			wantErr:   "invalid override of semver",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.inputFile)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := packagejson.ParseManifest(f)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
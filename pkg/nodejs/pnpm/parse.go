@@ -0,0 +1,387 @@
+package pnpm
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/internal/lockfile"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// LockFile represents pnpm-lock.yaml.
+// ref. https://github.com/pnpm/spec/tree/master/lockfile
+type LockFile struct {
+	// e.g. 5.4 in lockfile v5, '6.0' in v6 and '9.0' in v9
+	LockfileVersion string `yaml:"lockfileVersion"`
+
+	// Importers are available in workspaces and lockfile v9.
+	// The top-level dependencies are used otherwise.
+	Importers            map[string]Importer    `yaml:"importers"`
+	Dependencies         map[string]Reference   `yaml:"dependencies"`
+	DevDependencies      map[string]Reference   `yaml:"devDependencies"`
+	OptionalDependencies map[string]Reference   `yaml:"optionalDependencies"`
+	Packages             map[string]PackageInfo `yaml:"packages"`
+
+	// Snapshots hold dependencies of packages in lockfile v9.
+	Snapshots map[string]Snapshot `yaml:"snapshots"`
+}
+
+// Importer is a project in the workspace. e.g. "." and "packages/app"
+type Importer struct {
+	Dependencies         map[string]Reference `yaml:"dependencies"`
+	DevDependencies      map[string]Reference `yaml:"devDependencies"`
+	OptionalDependencies map[string]Reference `yaml:"optionalDependencies"`
+}
+
+// Reference is a dependency of importers. It is a string in lockfile v5.
+// e.g. "17.0.2_react@17.0.2" in v5 and {specifier: ^17.0.2, version: 17.0.2(react@17.0.2)} in v6 and later
+type Reference struct {
+	Specifier string `yaml:"specifier"`
+	Version   string `yaml:"version"`
+}
+
+func (r *Reference) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		r.Version = node.Value
+		return nil
+	}
+	type reference Reference
+	return node.Decode((*reference)(r))
+}
+
+type PackageInfo struct {
+	// Name and Version are set for packages not from the registry. e.g. tarballs and git repositories
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+
+	// Dev is not available in lockfile v9. Packages used in both production and development don't have it.
+	Dev *bool `yaml:"dev"`
+
+	Resolution Resolution `yaml:"resolution"`
+
+	Dependencies         map[string]string `yaml:"dependencies"`
+	OptionalDependencies map[string]string `yaml:"optionalDependencies"`
+}
+
+// Resolution is where the package is fetched from. Packages from the registry have only the integrity.
+// e.g. {integrity: sha512-xxx}, {tarball: https://example.com/foo-1.0.0.tgz} and {type: git, repo: https://github.com/foo/bar, commit: abc}
+type Resolution struct {
+	Integrity string `yaml:"integrity"`
+	Tarball   string `yaml:"tarball"`
+	Repo      string `yaml:"repo"`
+	Commit    string `yaml:"commit"`
+	Directory string `yaml:"directory"`
+}
+
+func (r Resolution) resolved() string {
+	switch {
+	case r.Tarball != "":
+		return r.Tarball
+	case r.Repo != "":
+		return r.Repo + "#" + r.Commit
+	}
+	return r.Directory
+}
+
+type Snapshot struct {
+	Dependencies         map[string]string `yaml:"dependencies"`
+	OptionalDependencies map[string]string `yaml:"optionalDependencies"`
+}
+
+type parser struct {
+	// The major version of the lockfile
+	version int
+}
+
+// node is a package in the dependency graph.
+type node struct {
+	id         string
+	name       string
+	version    string
+	resolution Resolution
+	dev        bool
+	location   types.Location
+	dependsOn  []string // keys of the packages
+}
+
+func Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	var root yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, nil, xerrors.Errorf("decode error: %w", err)
+	}
+
+	var lockFile LockFile
+	if err := root.Decode(&lockFile); err != nil {
+		return nil, nil, xerrors.Errorf("decode error: %w", err)
+	}
+
+	version, err := strconv.ParseFloat(lockFile.LockfileVersion, 64)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("invalid lockfileVersion %q: %w", lockFile.LockfileVersion, err)
+	}
+
+	p := parser{version: int(version)}
+	libs, deps := p.parse(lockFile, packageLocations(&root))
+	return libs, deps, nil
+}
+
+func (p parser) parse(lockFile LockFile, locations map[string]types.Location) ([]types.Library, []types.Dependency) {
+	importers := lockFile.Importers
+	if len(importers) == 0 {
+		importers = map[string]Importer{
+			".": {
+				Dependencies:         lockFile.Dependencies,
+				DevDependencies:      lockFile.DevDependencies,
+				OptionalDependencies: lockFile.OptionalDependencies,
+			},
+		}
+	}
+
+	nodes := p.nodes(lockFile, locations)
+
+	// Packages which importers depend on are direct.
+	direct := map[string]struct{}{}
+	var prodKeys []string
+	for _, importer := range importers {
+		for _, refs := range []map[string]Reference{importer.Dependencies, importer.OptionalDependencies} {
+			for name, ref := range refs {
+				if key, ok := p.key(name, ref.Version); ok {
+					direct[key] = struct{}{}
+					prodKeys = append(prodKeys, key)
+				}
+			}
+		}
+		for name, ref := range importer.DevDependencies {
+			if key, ok := p.key(name, ref.Version); ok {
+				direct[key] = struct{}{}
+			}
+		}
+	}
+
+	// Lockfile v9 doesn't have "dev", so packages not reachable from production dependencies are dev.
+	if p.version >= 9 {
+		markDev(nodes, prodKeys)
+	}
+
+	var libs []types.Library
+	var deps []types.Dependency
+	index := map[string]int{}
+	for key, n := range nodes {
+		if n.dev {
+			continue
+		}
+
+		_, isDirect := direct[key]
+		if i, ok := index[n.id]; ok {
+			// The same package with different peer dependencies
+			libs[i].Indirect = libs[i].Indirect && !isDirect
+			libs[i].Locations = append(libs[i].Locations, n.location)
+		} else {
+			index[n.id] = len(libs)
+			libs = append(libs, types.Library{
+				ID:        n.id,
+				Name:      n.name,
+				Version:   n.version,
+				Indirect:  !isDirect,
+				Locations: types.Locations{n.location},
+				Resolved:  n.resolution.resolved(),
+				Digests:   lockfile.Digests(n.resolution.Integrity),
+			})
+		}
+
+		var dependsOn []string
+		for _, depKey := range n.dependsOn {
+			dep, ok := nodes[depKey]
+			if !ok {
+				log.Logger.Debugf("Unable to resolve the dependency: %s", depKey)
+				continue
+			} else if dep.dev {
+				continue
+			}
+			dependsOn = append(dependsOn, dep.id)
+		}
+		if len(dependsOn) > 0 {
+			deps = append(deps, types.Dependency{
+				ID:        n.id,
+				DependsOn: dependsOn,
+			})
+		}
+	}
+
+	for _, lib := range libs {
+		sort.Slice(lib.Locations, func(i, j int) bool {
+			return lib.Locations[i].StartLine < lib.Locations[j].StartLine
+		})
+	}
+	sort.Slice(libs, func(i, j int) bool {
+		return libs[i].ID < libs[j].ID
+	})
+	return libs, lockfile.UniqueDeps(deps)
+}
+
+// nodes returns packages keyed by "/name/version_peers" (v5), "/name@version(peers)" (v6)
+// or "name@version(peers)" (v9).
+func (p parser) nodes(lockFile LockFile, locations map[string]types.Location) map[string]*node {
+	nodes := map[string]*node{}
+	if p.version >= 9 {
+		for key, snapshot := range lockFile.Snapshots {
+			// Package info is keyed without peer dependencies.
+			pkgKey := trimPeerSuffix(key)
+			n := p.newNode(pkgKey, lockFile.Packages[pkgKey])
+			n.location = locations[pkgKey]
+			n.dependsOn = p.keys(snapshot.Dependencies, snapshot.OptionalDependencies)
+			nodes[key] = n
+		}
+		return nodes
+	}
+
+	for key, info := range lockFile.Packages {
+		n := p.newNode(key, info)
+		n.dev = info.Dev != nil && *info.Dev
+		n.location = locations[key]
+		n.dependsOn = p.keys(info.Dependencies, info.OptionalDependencies)
+		nodes[key] = n
+	}
+	return nodes
+}
+
+func (p parser) newNode(key string, info PackageInfo) *node {
+	name, version := p.parseKey(key)
+	if info.Name != "" {
+		name = info.Name
+	}
+	if info.Version != "" {
+		version = info.Version
+	}
+	return &node{
+		id:         utils.PackageID(name, version),
+		name:       name,
+		version:    version,
+		resolution: info.Resolution,
+	}
+}
+
+// keys converts dependencies into package keys.
+func (p parser) keys(dependencies ...map[string]string) []string {
+	var keys []string
+	for _, deps := range dependencies {
+		for name, ref := range deps {
+			if key, ok := p.key(name, ref); ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// key returns the package key of the dependency.
+// The reference is a version with peer dependencies, or a package key for aliases.
+// e.g. "react-dom" and "17.0.2(react@17.0.2)" => "/react-dom@17.0.2(react@17.0.2)" in v6
+// e.g. "string-width-cjs" and "string-width@4.2.3" => "string-width@4.2.3" in v9
+func (p parser) key(name, ref string) (string, bool) {
+	// Workspace packages are not libraries.
+	// e.g. link:../shared
+	if ref == "" || strings.HasPrefix(ref, "link:") {
+		return "", false
+	}
+
+	switch {
+	case p.version < 6:
+		if strings.HasPrefix(ref, "/") {
+			return ref, true
+		}
+		return "/" + name + "/" + ref, true
+	case p.version < 9:
+		if strings.HasPrefix(ref, "/") {
+			return ref, true
+		}
+		return "/" + name + "@" + ref, true
+	default:
+		if strings.LastIndex(trimPeerSuffix(ref), "@") > 0 {
+			return ref, true
+		}
+		return name + "@" + ref, true
+	}
+}
+
+// parseKey returns the name and version from the package key without peer dependencies.
+// e.g. "/@babel/core/7.19.6_supports-color@8.1.1" in v5 => "@babel/core", "7.19.6"
+// e.g. "/react-dom@17.0.2(react@17.0.2)" in v6 => "react-dom", "17.0.2"
+func (p parser) parseKey(key string) (string, string) {
+	key = strings.TrimPrefix(key, "/")
+	if p.version < 6 {
+		i := strings.LastIndex(key, "/")
+		if i < 0 {
+			return key, ""
+		}
+		name, version := key[:i], key[i+1:]
+		if j := strings.Index(version, "_"); j >= 0 {
+			version = version[:j]
+		}
+		return name, version
+	}
+
+	key = trimPeerSuffix(key)
+	i := strings.LastIndex(key, "@")
+	if i <= 0 {
+		return key, ""
+	}
+	return key[:i], key[i+1:]
+}
+
+// trimPeerSuffix removes peer dependencies.
+// e.g. "react-dom@17.0.2(react@17.0.2)" => "react-dom@17.0.2"
+func trimPeerSuffix(s string) string {
+	if i := strings.Index(s, "("); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// markDev marks packages which are not reachable from production dependencies.
+func markDev(nodes map[string]*node, prodKeys []string) {
+	for _, n := range nodes {
+		n.dev = true
+	}
+	stack := prodKeys
+	for len(stack) > 0 {
+		key := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		n, ok := nodes[key]
+		if !ok || !n.dev {
+			continue
+		}
+		n.dev = false
+		stack = append(stack, n.dependsOn...)
+	}
+}
+
+// packageLocations returns the line ranges of the "packages" entries.
+func packageLocations(root *yaml.Node) map[string]types.Location {
+	locations := map[string]types.Location{}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 {
+		return locations
+	}
+	doc := root.Content[0]
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value != "packages" {
+			continue
+		}
+		packages := doc.Content[i+1]
+		for j := 0; j+1 < len(packages.Content); j += 2 {
+			key, value := packages.Content[j], packages.Content[j+1]
+			locations[key.Value] = types.Location{
+				StartLine: key.Line,
+				EndLine:   lockfile.LastLine(value, key.Line),
+			}
+		}
+	}
+	return locations
+}
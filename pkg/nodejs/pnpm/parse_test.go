@@ -0,0 +1,59 @@
+package pnpm
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		file     string // Test input file
+		want     []types.Library
+		wantDeps []types.Dependency
+		wantErr  string
+	}{
+		{
+			file:     "testdata/pnpm-lock_v5.yaml",
+			want:     pnpmV5,
+			wantDeps: pnpmDeps,
+		},
+		{
+			file:     "testdata/pnpm-lock_v6.yaml",
+			want:     pnpmV6,
+			wantDeps: pnpmDeps,
+		},
+		{
+			file:     "testdata/pnpm-lock_v9.yaml",
+			want:     pnpmV9,
+			wantDeps: pnpmDeps,
+		},
+		{
+			file:    "testdata/pnpm-lock_invalid.yaml",
+			wantErr: "invalid lockfileVersion",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(path.Base(tt.file), func(t *testing.T) {
+			f, err := os.Open(tt.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, gotDeps, err := Parse(f)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDeps, gotDeps)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package pnpm
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// pnpm add @babel/helper-string-parser react react-dom
+	// pnpm add -D ms
+	pnpmV5 = []types.Library{
+		{ID: "@babel/helper-string-parser@7.19.4", Name: "@babel/helper-string-parser", Version: "7.19.4", Locations: types.Locations{{StartLine: 19, EndLine: 22}}, Digests: []types.Digest{"sha512:9c7b43a1072ea859b060d60fcf745a879a61da9f0f15e142b1993d03fe3c74f73fac6a1c279277840019ee96fbe95597ddf64abbeb84aff1611f98cbc7bba6af"}},
+		{ID: "js-tokens@4.0.0", Name: "js-tokens", Version: "4.0.0", Indirect: true, Locations: types.Locations{{StartLine: 24, EndLine: 26}}, Digests: []types.Digest{"sha512:45d2547e5704ddc5332a232a420b02bb4e853eef5474824ed1b7986cf84737893a6a9809b627dca02b53f5b7313a9601b690f690233a49bce0e026aeb16fcf29"}},
+		{ID: "loose-envify@1.4.0", Name: "loose-envify", Version: "1.4.0", Indirect: true, Locations: types.Locations{{StartLine: 28, EndLine: 33}}, Digests: []types.Digest{"sha512:972bb13c6aff59f86b95e9b608bfd472751cd7372a280226043cee918ed8e45ff242235d928ebe7d12debe5c351e03324b0edfeb5d54218e34f04b71452a0add"}},
+		{ID: "object-assign@4.1.1", Name: "object-assign", Version: "4.1.1", Indirect: true, Locations: types.Locations{{StartLine: 39, EndLine: 42}}, Digests: []types.Digest{"sha512:ac98134279149c7d6c170f324fa552537cc3dec5a6bbab19848b1e63c557f8646edcfe85ec5bbe24d0e85df9251256cb2529dcdc55101d57b8714e618fe05c52"}},
+		{ID: "react-dom@17.0.2", Name: "react-dom", Version: "17.0.2", Locations: types.Locations{{StartLine: 44, EndLine: 53}}, Digests: []types.Digest{"sha512:b3887de8ab4b0d4425b04361327d5aafcb766c46beabf600b63f293cf7488cf0c604321536cac3f5a5cd5aab2951ee80cca0881b40b51d964ba8b57baa938118"}},
+		{ID: "react@17.0.2", Name: "react", Version: "17.0.2", Locations: types.Locations{{StartLine: 55, EndLine: 61}}, Digests: []types.Digest{"sha512:82784fb7be62fddabfcf7ffaabfd1ab0fefc0f4bb9f760f92f5a5deccf0ff9d724e85bbf8c978bea25552b6ddfa6d494663f158dffbeef05c0f1435c94641c6c"}},
+		{ID: "scheduler@0.20.2", Name: "scheduler", Version: "0.20.2", Indirect: true, Locations: types.Locations{{StartLine: 63, EndLine: 68}}, Digests: []types.Digest{"sha512:d9e59f1a002aa96146aad74c99c2f9cc230ad54f0a957bfc4901468252f7084b5dd1a0d50d681e17f6280a6be59f9e66e734a4dc9ff6d214da48179239bb100d"}},
+	}
+
+	pnpmV6 = []types.Library{
+		{ID: "@babel/helper-string-parser@7.19.4", Name: "@babel/helper-string-parser", Version: "7.19.4", Locations: types.Locations{{StartLine: 25, EndLine: 28}}, Digests: []types.Digest{"sha512:9c7b43a1072ea859b060d60fcf745a879a61da9f0f15e142b1993d03fe3c74f73fac6a1c279277840019ee96fbe95597ddf64abbeb84aff1611f98cbc7bba6af"}},
+		{ID: "js-tokens@4.0.0", Name: "js-tokens", Version: "4.0.0", Indirect: true, Locations: types.Locations{{StartLine: 30, EndLine: 32}}, Digests: []types.Digest{"sha512:45d2547e5704ddc5332a232a420b02bb4e853eef5474824ed1b7986cf84737893a6a9809b627dca02b53f5b7313a9601b690f690233a49bce0e026aeb16fcf29"}},
+		{ID: "loose-envify@1.4.0", Name: "loose-envify", Version: "1.4.0", Indirect: true, Locations: types.Locations{{StartLine: 34, EndLine: 39}}, Digests: []types.Digest{"sha512:972bb13c6aff59f86b95e9b608bfd472751cd7372a280226043cee918ed8e45ff242235d928ebe7d12debe5c351e03324b0edfeb5d54218e34f04b71452a0add"}},
+		{ID: "object-assign@4.1.1", Name: "object-assign", Version: "4.1.1", Indirect: true, Locations: types.Locations{{StartLine: 45, EndLine: 48}}, Digests: []types.Digest{"sha512:ac98134279149c7d6c170f324fa552537cc3dec5a6bbab19848b1e63c557f8646edcfe85ec5bbe24d0e85df9251256cb2529dcdc55101d57b8714e618fe05c52"}},
+		{ID: "react-dom@17.0.2", Name: "react-dom", Version: "17.0.2", Locations: types.Locations{{StartLine: 50, EndLine: 59}}, Digests: []types.Digest{"sha512:b3887de8ab4b0d4425b04361327d5aafcb766c46beabf600b63f293cf7488cf0c604321536cac3f5a5cd5aab2951ee80cca0881b40b51d964ba8b57baa938118"}},
+		{ID: "react@17.0.2", Name: "react", Version: "17.0.2", Locations: types.Locations{{StartLine: 61, EndLine: 67}}, Digests: []types.Digest{"sha512:82784fb7be62fddabfcf7ffaabfd1ab0fefc0f4bb9f760f92f5a5deccf0ff9d724e85bbf8c978bea25552b6ddfa6d494663f158dffbeef05c0f1435c94641c6c"}},
+		{ID: "scheduler@0.20.2", Name: "scheduler", Version: "0.20.2", Indirect: true, Locations: types.Locations{{StartLine: 69, EndLine: 74}}, Digests: []types.Digest{"sha512:d9e59f1a002aa96146aad74c99c2f9cc230ad54f0a957bfc4901468252f7084b5dd1a0d50d681e17f6280a6be59f9e66e734a4dc9ff6d214da48179239bb100d"}},
+	}
+
+	pnpmDeps = []types.Dependency{
+		{ID: "loose-envify@1.4.0", DependsOn: []string{"js-tokens@4.0.0"}},
+		{ID: "react-dom@17.0.2", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1", "react@17.0.2", "scheduler@0.20.2"}},
+		{ID: "react@17.0.2", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1"}},
+		{ID: "scheduler@0.20.2", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1"}},
+	}
+
+	// Workspace with the root project and packages/app
+	pnpmV9 = []types.Library{
+		{ID: "@babel/helper-string-parser@7.19.4", Name: "@babel/helper-string-parser", Version: "7.19.4", Locations: types.Locations{{StartLine: 36, EndLine: 38}}, Digests: []types.Digest{"sha512:9c7b43a1072ea859b060d60fcf745a879a61da9f0f15e142b1993d03fe3c74f73fac6a1c279277840019ee96fbe95597ddf64abbeb84aff1611f98cbc7bba6af"}},
+		{ID: "js-tokens@4.0.0", Name: "js-tokens", Version: "4.0.0", Indirect: true, Locations: types.Locations{{StartLine: 40, EndLine: 41}}, Digests: []types.Digest{"sha512:45d2547e5704ddc5332a232a420b02bb4e853eef5474824ed1b7986cf84737893a6a9809b627dca02b53f5b7313a9601b690f690233a49bce0e026aeb16fcf29"}},
+		{ID: "loose-envify@1.4.0", Name: "loose-envify", Version: "1.4.0", Indirect: true, Locations: types.Locations{{StartLine: 43, EndLine: 45}}, Digests: []types.Digest{"sha512:972bb13c6aff59f86b95e9b608bfd472751cd7372a280226043cee918ed8e45ff242235d928ebe7d12debe5c351e03324b0edfeb5d54218e34f04b71452a0add"}},
+		{ID: "object-assign@4.1.1", Name: "object-assign", Version: "4.1.1", Indirect: true, Locations: types.Locations{{StartLine: 50, EndLine: 52}}, Digests: []types.Digest{"sha512:ac98134279149c7d6c170f324fa552537cc3dec5a6bbab19848b1e63c557f8646edcfe85ec5bbe24d0e85df9251256cb2529dcdc55101d57b8714e618fe05c52"}},
+		{ID: "react-dom@17.0.2", Name: "react-dom", Version: "17.0.2", Locations: types.Locations{{StartLine: 54, EndLine: 57}}, Digests: []types.Digest{"sha512:b3887de8ab4b0d4425b04361327d5aafcb766c46beabf600b63f293cf7488cf0c604321536cac3f5a5cd5aab2951ee80cca0881b40b51d964ba8b57baa938118"}},
+		{ID: "react@17.0.2", Name: "react", Version: "17.0.2", Locations: types.Locations{{StartLine: 59, EndLine: 61}}, Digests: []types.Digest{"sha512:82784fb7be62fddabfcf7ffaabfd1ab0fefc0f4bb9f760f92f5a5deccf0ff9d724e85bbf8c978bea25552b6ddfa6d494663f158dffbeef05c0f1435c94641c6c"}},
+		{ID: "scheduler@0.20.2", Name: "scheduler", Version: "0.20.2", Indirect: true, Locations: types.Locations{{StartLine: 63, EndLine: 64}}, Digests: []types.Digest{"sha512:d9e59f1a002aa96146aad74c99c2f9cc230ad54f0a957bfc4901468252f7084b5dd1a0d50d681e17f6280a6be59f9e66e734a4dc9ff6d214da48179239bb100d"}},
+		{ID: "string-width@4.2.3", Name: "string-width", Version: "4.2.3", Locations: types.Locations{{StartLine: 66, EndLine: 68}}, Digests: []types.Digest{"sha512:c0ac90450a63274b08a7ad84ad265d1ac8cc256b1aa79a1136284786ee86ec954effd8c807a5327af2feb57b8eaab9e0f23fdcc4a4d6c96530bd24eb8a2673fe"}},
+	}
+)
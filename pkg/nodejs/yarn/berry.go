@@ -0,0 +1,151 @@
+package yarn
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/internal/lockfile"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// Protocol represents where a package comes from in yarn v2 and later.
+// ref. https://yarnpkg.com/protocols
+type Protocol string
+
+const (
+	ProtocolNpm       Protocol = "npm"
+	ProtocolWorkspace Protocol = "workspace"
+	ProtocolPatch     Protocol = "patch"
+	ProtocolGit       Protocol = "git"
+	ProtocolFile      Protocol = "file"
+	ProtocolPortal    Protocol = "portal"
+	ProtocolLink      Protocol = "link"
+	ProtocolExec      Protocol = "exec"
+	ProtocolHTTP      Protocol = "http"
+)
+
+type berryPackage struct {
+	Version          string                    `yaml:"version"`
+	Resolution       string                    `yaml:"resolution"`
+	Checksum         string                    `yaml:"checksum"`
+	LanguageName     string                    `yaml:"languageName"`
+	LinkType         string                    `yaml:"linkType"`
+	Dependencies     map[string]string         `yaml:"dependencies"`
+	DependenciesMeta map[string]dependencyMeta `yaml:"dependenciesMeta"`
+}
+
+type dependencyMeta struct {
+	Optional bool `yaml:"optional"`
+}
+
+// ParseBerry parses the lockfile of yarn v2 and later and returns all the entries except "__metadata".
+// Names and protocols are taken from the resolution, so that npm aliases and patches return the original package.
+func ParseBerry(r io.Reader) ([]Entry, error) {
+	var root yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", err)
+	}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil, xerrors.New("yarn.lock must be a mapping")
+	}
+
+	var entries []Entry
+	doc := root.Content[0]
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		key, value := doc.Content[i], doc.Content[i+1]
+		if key.Value == "__metadata" {
+			continue
+		}
+
+		var pkg berryPackage
+		if err := value.Decode(&pkg); err != nil {
+			return nil, xerrors.Errorf("line %d: decode error: %w", key.Line, err)
+		}
+
+		entry, err := newBerryEntry(key.Value, pkg)
+		if err != nil {
+			return nil, xerrors.Errorf("line %d: %w", key.Line, err)
+		}
+		entry.Location = types.Location{
+			StartLine: key.Line,
+			EndLine:   lockfile.LastLine(value, key.Line),
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func newBerryEntry(key string, pkg berryPackage) (Entry, error) {
+	if pkg.Resolution == "" {
+		return Entry{}, xerrors.Errorf("resolution is missing: %s", key)
+	}
+	name, protocol, rng := splitLocator(pkg.Resolution)
+	entry := Entry{
+		Locators:     strings.Split(key, ", "),
+		Name:         name,
+		Version:      pkg.Version,
+		Resolution:   pkg.Resolution,
+		Protocol:     berryProtocol(protocol, rng),
+		Checksum:     pkg.Checksum,
+		LanguageName: pkg.LanguageName,
+		LinkType:     pkg.LinkType,
+	}
+
+	// Patched packages are reported as the npm package they patch.
+	// e.g. "fsevents@patch:fsevents@npm%3A2.1.3#builtin<compat/fsevents>::version=2.1.3&hash=87eb42"
+	if entry.Protocol == ProtocolPatch {
+		source, _, _ := strings.Cut(rng, "#")
+		source, err := url.PathUnescape(source)
+		if err != nil {
+			return Entry{}, xerrors.Errorf("invalid patch resolution %s: %w", pkg.Resolution, err)
+		}
+		if _, p, version := splitLocator(source); p == string(ProtocolNpm) {
+			entry.Version = version
+		}
+	}
+
+	for dep, rng := range pkg.Dependencies {
+		if pkg.DependenciesMeta[dep].Optional {
+			if entry.OptionalDependencies == nil {
+				entry.OptionalDependencies = map[string]string{}
+			}
+			entry.OptionalDependencies[dep] = rng
+			continue
+		}
+		if entry.Dependencies == nil {
+			entry.Dependencies = map[string]string{}
+		}
+		entry.Dependencies[dep] = rng
+	}
+	return entry, nil
+}
+
+// berryProtocol returns the protocol of the resolution.
+// Git repositories are resolved to URLs with the commit.
+// e.g. "lodash@https://github.com/lodash/lodash.git#commit=2da024c3b4f9947a48517639de7560457cd4ec6c"
+func berryProtocol(protocol, rng string) Protocol {
+	switch protocol {
+	case "git", "git+ssh", "git+https", "git+http", "git+file", "github", "ssh":
+		return ProtocolGit
+	case "http", "https":
+		if strings.Contains(rng, "#commit=") || strings.Contains(rng, ".git#") {
+			return ProtocolGit
+		}
+		return ProtocolHTTP
+	}
+	return Protocol(protocol)
+}
+
+// isBerry returns true if the lockfile is generated by yarn v2 or later, which has the "__metadata" block.
+func isBerry(content []byte) bool {
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.HasPrefix(line, "__metadata:") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,312 @@
+package yarn
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// Entry is a block of yarn.lock. Several locators can share one entry.
+// e.g.
+//
+//	"@babel/code-frame@7.0.0", "@babel/code-frame@^7.0.0":
+//	  version "7.0.0"
+//	  resolved "https://registry.yarnpkg.com/@babel/code-frame/-/code-frame-7.0.0.tgz#06e2ab19bdb535385559aabb5ba59729482800f8"
+//	  integrity sha512-OfC2uemaknXr87bdLUkWog7nYuliM9Ij5HUcajsVcMCpQrcLmtxRbVFTIqmcSkSeYRBFBRxs2FiUqFJDLdiebA==
+//	  dependencies:
+//	    "@babel/highlight" "^7.0.0"
+type Entry struct {
+	// Locators are the keys of the entry. e.g. "@babel/code-frame@^7.0.0"
+	Locators []string
+	// Name is the package name. The real name is returned for npm aliases.
+	// e.g. "string-width" for "string-width-cjs@npm:string-width@^4.2.0"
+	Name      string
+	Version   string
+	Resolved  string
+	Integrity string
+
+	// The fields below are available in yarn v2 and later.
+	// e.g. "asap@npm:2.0.6"
+	Resolution   string
+	Protocol     Protocol
+	Checksum     string
+	LanguageName string
+	LinkType     string
+
+	Dependencies         map[string]string
+	OptionalDependencies map[string]string
+	Location             types.Location
+}
+
+// ParseV1 parses yarn.lock v1 and returns all the entries.
+// ref. https://github.com/yarnpkg/yarn/blob/master/src/lockfile/parse.js
+func ParseV1(r io.Reader) ([]Entry, error) {
+	lines, err := tokenize(r)
+	if err != nil {
+		return nil, xerrors.Errorf("tokenize error: %w", err)
+	}
+
+	p := &lockParser{lines: lines}
+	root, err := p.parseObject(0)
+	if err != nil {
+		return nil, xerrors.Errorf("parse error: %w", err)
+	}
+
+	var entries []Entry
+	for _, pair := range root {
+		if pair.object == nil {
+			return nil, xerrors.Errorf("line %d: entry must be an object", pair.startLine)
+		}
+		entry := Entry{
+			Locators: pair.keys,
+			Name:     locatorName(pair.keys[0]),
+			Location: types.Location{
+				StartLine: pair.startLine,
+				EndLine:   pair.endLine,
+			},
+		}
+		for _, field := range pair.object {
+			switch field.keys[0] {
+			case "version":
+				entry.Version = field.value
+			case "resolved":
+				entry.Resolved = field.value
+			case "integrity":
+				entry.Integrity = field.value
+			case "dependencies":
+				entry.Dependencies = field.toMap()
+			case "optionalDependencies":
+				entry.OptionalDependencies = field.toMap()
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// locatorName returns the package name of the locator.
+// e.g. "@babel/code-frame@^7.0.0" => "@babel/code-frame"
+// e.g. "string-width-cjs@npm:string-width@^4.2.0" => "string-width"
+func locatorName(locator string) string {
+	name, protocol, rng := splitLocator(locator)
+	if protocol == "npm" {
+		// Aliases
+		if aliased, _, _ := splitLocator(rng); aliased != "" && aliased != rng {
+			return aliased
+		}
+	}
+	return name
+}
+
+// splitLocator splits the locator into the name, protocol and range.
+// The protocol is empty for semver ranges.
+// e.g. "jquery@git+https://github.com/jquery/jquery" => "jquery", "git+https", "//github.com/jquery/jquery"
+func splitLocator(locator string) (name, protocol, rng string) {
+	// The first character can be "@" for scoped packages.
+	i := strings.Index(locator[min(1, len(locator)):], "@")
+	if i < 0 {
+		return locator, "", ""
+	}
+	i++
+	name, rng = locator[:i], locator[i+1:]
+	if protocol, rest, ok := strings.Cut(rng, ":"); ok {
+		return name, protocol, rest
+	}
+	return name, "", rng
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+type tokenKind int
+
+const (
+	tokenString tokenKind = iota
+	tokenColon
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+// line is a non-empty line of the lockfile.
+type line struct {
+	number int
+	indent int
+	tokens []token
+}
+
+// tokenize splits the lockfile into lines of tokens. Comments and empty lines are removed.
+func tokenize(r io.Reader) ([]line, error) {
+	var lines []line
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	var lineNumber int
+	for scanner.Scan() {
+		lineNumber++
+		text := strings.TrimRight(scanner.Text(), "\r")
+
+		indent := len(text) - len(strings.TrimLeft(text, " "))
+		if strings.HasPrefix(text[indent:], "\t") {
+			return nil, xerrors.Errorf("line %d: tabs are not allowed for indentation", lineNumber)
+		}
+
+		var tokens []token
+		for i := indent; i < len(text); {
+			switch c := text[i]; {
+			case c == ' ' || c == '\t':
+				i++
+			case c == '#':
+				i = len(text)
+			case c == ':':
+				tokens = append(tokens, token{kind: tokenColon})
+				i++
+			case c == ',':
+				tokens = append(tokens, token{kind: tokenComma})
+				i++
+			case c == '"':
+				end, err := quotedStringEnd(text, i)
+				if err != nil {
+					return nil, xerrors.Errorf("line %d: %w", lineNumber, err)
+				}
+				s, err := strconv.Unquote(text[i:end])
+				if err != nil {
+					return nil, xerrors.Errorf("line %d: invalid string %s: %w", lineNumber, text[i:end], err)
+				}
+				tokens = append(tokens, token{kind: tokenString, value: s})
+				i = end
+			default:
+				// Unquoted strings end with a colon, comma or whitespace.
+				// e.g. asap@~2.0.6:
+				end := i
+				for end < len(text) && !strings.ContainsRune(": \t,", rune(text[end])) {
+					end++
+				}
+				tokens = append(tokens, token{kind: tokenString, value: text[i:end]})
+				i = end
+			}
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+		lines = append(lines, line{
+			number: lineNumber,
+			indent: indent,
+			tokens: tokens,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+	return lines, nil
+}
+
+// quotedStringEnd returns the index after the closing quote.
+func quotedStringEnd(text string, start int) (int, error) {
+	for i := start + 1; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+	return 0, xerrors.New("unterminated string")
+}
+
+// pair is a key-value pair or a key-object pair.
+type pair struct {
+	keys      []string
+	value     string
+	object    []pair
+	startLine int
+	endLine   int
+}
+
+func (p pair) toMap() map[string]string {
+	m := map[string]string{}
+	for _, child := range p.object {
+		for _, key := range child.keys {
+			m[key] = child.value
+		}
+	}
+	return m
+}
+
+type lockParser struct {
+	lines []line
+	pos   int
+}
+
+// parseObject parses lines with the same indentation.
+func (p *lockParser) parseObject(indent int) ([]pair, error) {
+	var pairs []pair
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		} else if l.indent > indent {
+			return nil, xerrors.Errorf("line %d: unexpected indentation", l.number)
+		}
+		p.pos++
+
+		// Keys separated by commas
+		// e.g. "@babel/code-frame@7.0.0", "@babel/code-frame@^7.0.0":
+		var keys []string
+		tokens := l.tokens
+		for len(tokens) > 0 && tokens[0].kind == tokenString {
+			keys = append(keys, tokens[0].value)
+			tokens = tokens[1:]
+			if len(tokens) == 0 || tokens[0].kind != tokenComma {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if len(keys) == 0 {
+			return nil, xerrors.Errorf("line %d: key is missing", l.number)
+		}
+
+		pr := pair{
+			keys:      keys,
+			startLine: l.number,
+			endLine:   l.number,
+		}
+
+		if len(tokens) > 0 && tokens[0].kind == tokenColon {
+			tokens = tokens[1:]
+			if len(tokens) == 0 {
+				// Nested object
+				if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+					object, err := p.parseObject(p.lines[p.pos].indent)
+					if err != nil {
+						return nil, err
+					}
+					pr.object = object
+					pr.endLine = p.lines[p.pos-1].number
+				}
+				pairs = append(pairs, pr)
+				continue
+			}
+		}
+
+		// Key and value
+		// e.g. version "2.0.6"
+		if len(tokens) != 1 || tokens[0].kind != tokenString || len(keys) != 1 {
+			return nil, xerrors.Errorf("line %d: invalid key-value pair", l.number)
+		}
+		pr.value = tokens[0].value
+		pairs = append(pairs, pr)
+	}
+	return pairs, nil
+}
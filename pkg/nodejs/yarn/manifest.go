@@ -0,0 +1,178 @@
+package yarn
+
+import (
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/packagejson"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// Library is a package in yarn.lock with the dependency types it is used as.
+// A library used as both a dependency and a dev dependency has both Prod and Dev.
+type Library struct {
+	types.Library
+	// Prod is true if the library is reachable from "dependencies" or "optionalDependencies".
+	Prod bool
+	// Dev is true if the library is reachable from "devDependencies".
+	Dev bool
+}
+
+// ParseWithManifests parses yarn.lock and classifies packages by walking the dependency graph
+// from "dependencies" and "devDependencies" of package.json separately.
+// The manifests are package.json in the same directory as yarn.lock and package.json of the workspaces.
+// Packages are the same as Parse returns.
+func ParseWithManifests(r io.Reader, manifests ...io.Reader) ([]Library, error) {
+	entries, berry, err := parseEntries(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var libs []types.Library
+	if berry {
+		libs = berryLibraries(entries)
+	} else if libs, err = v1Libraries(entries); err != nil {
+		return nil, err
+	}
+
+	g := newGraph(entries)
+	var prodDeps, devDeps []map[string]string
+	for i, r := range manifests {
+		m, err := packagejson.ParseManifest(r)
+		if err != nil {
+			return nil, xerrors.Errorf("package.json #%d parse error: %w", i+1, err)
+		}
+		if m.Name != "" {
+			g.workspaces[m.Name] = m
+		}
+		prodDeps = append(prodDeps, m.Dependencies, m.OptionalDependencies)
+		devDeps = append(devDeps, m.DevDependencies)
+	}
+
+	prod := g.reachable(prodDeps...)
+	dev := g.reachable(devDeps...)
+
+	var results []Library
+	index := map[string]int{}
+	for _, lib := range libs {
+		index[utils.PackageID(lib.Name, lib.Version)] = len(results)
+		results = append(results, Library{Library: lib})
+	}
+	for i, entry := range entries {
+		j, ok := index[utils.PackageID(entry.Name, entry.Version)]
+		if !ok {
+			continue
+		}
+		_, isProd := prod[i]
+		_, isDev := dev[i]
+		results[j].Prod = results[j].Prod || isProd
+		results[j].Dev = results[j].Dev || isDev
+	}
+	return results, nil
+}
+
+// graph resolves dependencies to the entries of yarn.lock.
+type graph struct {
+	entries []Entry
+	// locator => index of entries
+	locators map[string]int
+	// Workspaces are resolved with package.json as yarn.lock includes dev dependencies of workspaces.
+	workspaces map[string]packagejson.Manifest
+}
+
+func newGraph(entries []Entry) graph {
+	g := graph{
+		entries:    entries,
+		locators:   map[string]int{},
+		workspaces: map[string]packagejson.Manifest{},
+	}
+	for i, entry := range entries {
+		for _, locator := range entry.Locators {
+			g.locators[locator] = i
+		}
+	}
+
+	// Aliases of locators which don't add entries
+	for i, entry := range entries {
+		for _, locator := range entry.Locators {
+			for _, alias := range locatorAliases(locator) {
+				if _, ok := g.locators[alias]; !ok {
+					g.locators[alias] = i
+				}
+			}
+		}
+	}
+	return g
+}
+
+// locatorAliases returns locators which package.json refers to in yarn v2 and later.
+// e.g. "local-lib@file:./local-lib::locator=app%40workspace%3A." => "local-lib@file:./local-lib"
+// e.g. "fsevents@patch:fsevents@~2.3.2#~builtin<compat/fsevents>" => "fsevents@npm:~2.3.2"
+func locatorAliases(locator string) []string {
+	var aliases []string
+	if before, _, ok := strings.Cut(locator, "::"); ok {
+		aliases = append(aliases, before)
+	}
+	if name, protocol, rng := splitLocator(locator); protocol == string(ProtocolPatch) {
+		source, _, _ := strings.Cut(rng, "#")
+		if _, p, r := splitLocator(source); p == "" {
+			aliases = append(aliases, name+"@npm:"+r)
+		} else {
+			aliases = append(aliases, name+"@"+p+":"+r)
+		}
+	}
+	return aliases
+}
+
+// resolve returns the index of the entry which the dependency resolves to.
+// The npm protocol is omitted in package.json and yarn.lock v1.
+func (g graph) resolve(name, rng string) (int, bool) {
+	for _, locator := range []string{name + "@" + rng, name + "@npm:" + rng} {
+		if i, ok := g.locators[locator]; ok {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// reachable returns the indices of entries reachable from the dependencies.
+func (g graph) reachable(dependencies ...map[string]string) map[int]struct{} {
+	visited := map[int]struct{}{}
+	var stack []int
+	push := func(deps map[string]string) {
+		for name, rng := range deps {
+			i, ok := g.resolve(name, rng)
+			if !ok {
+				log.Logger.Debugf("Unable to resolve the dependency: %s@%s", name, rng)
+				continue
+			}
+			stack = append(stack, i)
+		}
+	}
+	for _, deps := range dependencies {
+		push(deps)
+	}
+
+	for len(stack) > 0 {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, ok := visited[i]; ok {
+			continue
+		}
+		visited[i] = struct{}{}
+
+		entry := g.entries[i]
+		if m, ok := g.workspaces[entry.Name]; ok && entry.Protocol == ProtocolWorkspace {
+			push(m.Dependencies)
+			push(m.OptionalDependencies)
+			continue
+		}
+		push(entry.Dependencies)
+		push(entry.OptionalDependencies)
+	}
+	return visited
+}
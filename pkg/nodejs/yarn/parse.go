@@ -1,19 +1,15 @@
 package yarn
 
 import (
-	"bufio"
-	"fmt"
+	"bytes"
 	"io"
-	"regexp"
 	"strings"
 
-	"github.com/aquasecurity/go-dep-parser/pkg/types"
 	"golang.org/x/xerrors"
-)
 
-var (
-	yarnLocatorRegexp = regexp.MustCompile(`"?(?P<package>.+?)@(?:(?P<protocol>.+?):)?.+`)
-	yarnVersionRegexp = regexp.MustCompile(`\s+"?version:?"?\s+"?(?P<version>[^"]+)"?`)
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 type LockFile struct {
@@ -21,36 +17,11 @@ type LockFile struct {
 }
 type Dependency struct {
 	Version string
-	// TODO : currently yarn can't recognize Dev flag.
-	// That need to parse package.json for Dev flag
+	// Dev requires package.json. See ParseWithManifests.
 	Dev          bool
 	Dependencies map[string]Dependency
 }
 
-func parsePackageLocator(target string) (packagename, protocol string, err error) {
-	capture := yarnLocatorRegexp.FindStringSubmatch(target)
-	if len(capture) < 2 {
-		return "", "", xerrors.New("not package format")
-	}
-	for i, group := range yarnLocatorRegexp.SubexpNames() {
-		switch group {
-		case "package":
-			packagename = capture[i]
-		case "protocol":
-			protocol = capture[i]
-		}
-	}
-	return
-}
-
-func getVersion(target string) (version string, err error) {
-	capture := yarnVersionRegexp.FindStringSubmatch(target)
-	if len(capture) < 2 {
-		return "", xerrors.New("not version")
-	}
-	return capture[len(capture)-1], nil
-}
-
 func validProtocol(protocol string) (valid bool) {
 	switch protocol {
 	// only scan npm packages
@@ -60,55 +31,125 @@ func validProtocol(protocol string) (valid bool) {
 	return false
 }
 
-func Parse(r io.Reader) (libs []types.Library, err error) {
-	scanner := bufio.NewScanner(r)
+// Parse returns packages in yarn.lock. The same package is returned once even if several entries resolve to it.
+func Parse(r io.Reader) ([]types.Library, error) {
+	entries, berry, err := parseEntries(r)
+	if err != nil {
+		return nil, err
+	}
+	if berry {
+		return berryLibraries(entries), nil
+	}
+	return v1Libraries(entries)
+}
+
+// parseEntries parses yarn.lock in the format of the yarn version which generated it.
+func parseEntries(r io.Reader) ([]Entry, bool, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, false, xerrors.Errorf("read error: %w", err)
+	}
+
+	berry := isBerry(content)
+	parse := ParseV1
+	if berry {
+		parse = ParseBerry
+	}
+	entries, err := parse(bytes.NewReader(content))
+	if err != nil {
+		return nil, false, xerrors.Errorf("yarn.lock parse error: %w", err)
+	}
+	return entries, berry, nil
+}
+
+// v1Libraries returns packages from the npm registry.
+func v1Libraries(entries []Entry) ([]types.Library, error) {
+	var libs []types.Library
 	unique := map[string]struct{}{}
-	var lib types.Library
-	var skipPackage bool
-	for scanner.Scan() {
-		line := scanner.Text()
-		if len(line) < 1 {
+	for _, entry := range entries {
+		if _, protocol, _ := splitLocator(entry.Locators[0]); !validProtocol(protocol) {
+			continue
+		} else if entry.Version == "" {
+			return nil, xerrors.Errorf("version is missing at line %d", entry.Location.StartLine)
+		}
+
+		id := utils.PackageID(entry.Name, entry.Version)
+		if _, ok := unique[id]; ok {
 			continue
 		}
+		unique[id] = struct{}{}
+		libs = append(libs, newLibrary(entry))
+	}
+	return libs, nil
+}
 
-		// parse version
-		var version string
-		if version, err = getVersion(line); err == nil {
-			if skipPackage {
+// berryLibraries returns packages except the root workspace and links, which are not packages.
+// Patched packages are returned only when the original package is missing, as they have the same name and version.
+func berryLibraries(entries []Entry) []types.Library {
+	var libs []types.Library
+	unique := map[string]struct{}{}
+	for _, patch := range []bool{false, true} {
+		for _, entry := range entries {
+			if (entry.Protocol == ProtocolPatch) != patch {
 				continue
 			}
-			if lib.Name == "" {
-				return nil, xerrors.New("Invalid yarn.lock format")
+			switch {
+			case entry.Protocol == ProtocolLink, entry.Protocol == ProtocolExec:
+				continue
+			case entry.Protocol == ProtocolWorkspace && strings.HasSuffix(entry.Resolution, "@workspace:."):
+				continue
 			}
-			// fetch between version prefix and last double-quote
-			symbol := fmt.Sprintf("%s@%s", lib.Name, version)
-			if _, ok := unique[symbol]; ok {
-				lib = types.Library{}
+
+			id := utils.PackageID(entry.Name, entry.Version)
+			if _, ok := unique[id]; ok {
 				continue
 			}
+			unique[id] = struct{}{}
+			libs = append(libs, newLibrary(entry))
+		}
+	}
+	return libs
+}
 
-			lib.Version = version
-			libs = append(libs, lib)
-			lib = types.Library{}
-			unique[symbol] = struct{}{}
-			continue
+func newLibrary(entry Entry) types.Library {
+	lib := types.Library{
+		Name:      entry.Name,
+		Version:   entry.Version,
+		Locations: types.Locations{entry.Location},
+		Resolved:  entry.Resolved,
+	}
+	if entry.Resolution != "" {
+		lib.Resolved = entry.Resolution
+	}
+
+	var err error
+	switch {
+	case entry.Integrity != "":
+		lib.Digests, err = types.ParseIntegrity(entry.Integrity)
+	case entry.Checksum != "":
+		// The hash of the zip archive in the Yarn cache, prefixed with the cache key since yarn v4.
+		// e.g. "10c0/3d314f8c..."
+		checksum := entry.Checksum
+		if _, after, ok := strings.Cut(checksum, "/"); ok {
+			checksum = after
 		}
-		// skip __metadata block
-		if skipPackage = strings.HasPrefix(line, "__metadata"); skipPackage {
-			continue
+		var d types.Digest
+		if d, err = types.NewDigestFromHex(types.YarnChecksum, checksum); err == nil {
+			lib.Digests = []types.Digest{d}
 		}
-		// packagename line start 1 char
-		if line[:1] != " " && line[:1] != "#" {
-			var name string
-			var protocol string
-			if name, protocol, err = parsePackageLocator(line); err != nil {
-				continue
-			}
-			if skipPackage = !validProtocol(protocol); skipPackage {
-				continue
+	default:
+		// Old yarn.lock v1 has only SHA-1 in the fragment of the tarball URL.
+		// e.g. https://registry.yarnpkg.com/asap/-/asap-2.0.6.tgz#e50347611d7e690943208bbdafebcbc2fb866d46
+		if _, fragment, ok := strings.Cut(entry.Resolved, "#"); ok && len(fragment) == 40 {
+			var d types.Digest
+			if d, err = types.NewDigestFromHex(types.SHA1, fragment); err == nil {
+				lib.Digests = []types.Digest{d}
 			}
-			lib.Name = name
 		}
 	}
-	return libs, nil
+	if err != nil {
+		log.Logger.Debugf("Invalid hash of %s@%s: %s", entry.Name, entry.Version, err)
+		lib.Digests = nil
+	}
+	return lib
 }
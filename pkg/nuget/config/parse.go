@@ -4,9 +4,11 @@ import (
 	"encoding/xml"
 	"io"
 
+	"golang.org/x/exp/maps"
 	"golang.org/x/xerrors"
 
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 type cfgPackageReference struct {
@@ -28,7 +30,7 @@ func Parse(r io.Reader) ([]types.Library, error) {
 		return nil, xerrors.Errorf("failed to decode .config file: %w", err)
 	}
 
-	uniqueLibs := map[types.Library]struct{}{}
+	uniqueLibs := map[string]types.Library{}
 	for _, pkg := range cfgData.Packages {
 		if pkg.ID == "" || pkg.DevDependency {
 			continue
@@ -38,13 +40,8 @@ func Parse(r io.Reader) ([]types.Library, error) {
 			Name:    pkg.ID,
 			Version: pkg.Version,
 		}
-		uniqueLibs[lib] = struct{}{}
+		uniqueLibs[utils.PackageID(lib.Name, lib.Version)] = lib
 	}
 
-	var libs []types.Library
-	for lib := range uniqueLibs {
-		libs = append(libs, lib)
-	}
-
-	return libs, nil
+	return maps.Values(uniqueLibs), nil
 }
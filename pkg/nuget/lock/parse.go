@@ -4,9 +4,12 @@ import (
 	"encoding/json"
 	"io"
 
+	"golang.org/x/exp/maps"
 	"golang.org/x/xerrors"
 
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 type LockFile struct {
@@ -19,6 +22,8 @@ type Dependencies map[string]Dependency
 type Dependency struct {
 	Type     string
 	Resolved string
+	// ContentHash is SHA-512 of the package in base64.
+	ContentHash string
 }
 
 func Parse(r io.Reader) ([]types.Library, error) {
@@ -29,7 +34,7 @@ func Parse(r io.Reader) ([]types.Library, error) {
 		return nil, xerrors.Errorf("failed to decode packages.lock.json: %w", err)
 	}
 
-	uniqueLibs := map[types.Library]struct{}{}
+	uniqueLibs := map[string]types.Library{}
 	for _, targetContent := range lockFile.Targets {
 		for packageName, packageContent := range targetContent {
 			// If package type is "project", it is the actual project, and we skip it.
@@ -41,14 +46,16 @@ func Parse(r io.Reader) ([]types.Library, error) {
 				Name:    packageName,
 				Version: packageContent.Resolved,
 			}
-			uniqueLibs[lib] = struct{}{}
+			if packageContent.ContentHash != "" {
+				if d, err := types.NewDigestFromBase64(types.SHA512, packageContent.ContentHash); err != nil {
+					log.Logger.Debugf("Invalid contentHash of %s: %s", packageName, err)
+				} else {
+					lib.Digests = []types.Digest{d}
+				}
+			}
+			uniqueLibs[utils.PackageID(lib.Name, lib.Version)] = lib
 		}
 	}
 
-	var libraries []types.Library
-	for lib := range uniqueLibs {
-		libraries = append(libraries, lib)
-	}
-
-	return libraries, nil
+	return maps.Values(uniqueLibs), nil
 }
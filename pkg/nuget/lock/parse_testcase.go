@@ -12,8 +12,8 @@ var (
 	// dotnet restore --use-lock-file
 	// cat packages.lock.json | jq -rc '.dependencies[] | keys[] as $k | "{\"\($k)\", \"\(.[$k] | .resolved)\", \"\"},"'
 	nuGetSimple = []types.Library{
-		{Name: "Newtonsoft.Json", Version: "12.0.3"},
-		{Name: "NuGet.Frameworks", Version: "5.7.0"},
+		{Name: "Newtonsoft.Json", Version: "12.0.3", Digests: []types.Digest{"sha512:ea68237e7441e2328c9731d297e543fa851cd4879b39969b91bc968f64624e05b060f3ee68ad47f7b1b5b07a86c0f952e67a621794343abc4dd709e2da7e505a"}},
+		{Name: "NuGet.Frameworks", Version: "5.7.0", Digests: []types.Digest{"sha512:ed0ff05280778c205cabdce804e0461c585eefc0b5de35623e6be3bf34f0b61555f0302331fa579ea018b60c1d6912c93244d7aed74bc5f3c12051619a5b2721"}},
 	}
 
 	// docker run --rm -i -t mcr.microsoft.com/dotnet/sdk:latest
@@ -25,14 +25,14 @@ var (
 	// dotnet restore --use-lock-file
 	// cat packages.lock.json | jq -rc '.dependencies[] | keys[] as $k | "{\"\($k)\", \"\(.[$k] | .resolved)\", \"\"},"'
 	nuGetSubDependencies = []types.Library{
-		{Name: "Microsoft.Extensions.ApiDescription.Server", Version: "3.0.0"},
-		{Name: "Microsoft.OpenApi", Version: "1.1.4"},
-		{Name: "Newtonsoft.Json", Version: "12.0.3"},
-		{Name: "NuGet.Frameworks", Version: "5.7.0"},
-		{Name: "Swashbuckle.AspNetCore", Version: "5.5.1"},
-		{Name: "Swashbuckle.AspNetCore.Swagger", Version: "5.5.1"},
-		{Name: "Swashbuckle.AspNetCore.SwaggerGen", Version: "5.5.1"},
-		{Name: "Swashbuckle.AspNetCore.SwaggerUI", Version: "5.5.1"},
+		{Name: "Microsoft.Extensions.ApiDescription.Server", Version: "3.0.0", Digests: []types.Digest{"sha512:2c7e0e13fefa17ab0e0ac9627fbf978777d2ff0514ac4e6bc9e5c031ca029eec0e4064f94a6c34a79ec880387fa47807686cff7be0261106fba5181bfbec2dd3"}},
+		{Name: "Microsoft.OpenApi", Version: "1.1.4", Digests: []types.Digest{"sha512:e925b4b696c9b2573c2c06355e78917cb55c25aedb2546dbc2fa36fd946a7e431b26bb2a223f74e39be0dd24ed6610e16112a1a58823a86535d5e796e0fcf2ae"}},
+		{Name: "Newtonsoft.Json", Version: "12.0.3", Digests: []types.Digest{"sha512:ea68237e7441e2328c9731d297e543fa851cd4879b39969b91bc968f64624e05b060f3ee68ad47f7b1b5b07a86c0f952e67a621794343abc4dd709e2da7e505a"}},
+		{Name: "NuGet.Frameworks", Version: "5.7.0", Digests: []types.Digest{"sha512:ed0ff05280778c205cabdce804e0461c585eefc0b5de35623e6be3bf34f0b61555f0302331fa579ea018b60c1d6912c93244d7aed74bc5f3c12051619a5b2721"}},
+		{Name: "Swashbuckle.AspNetCore", Version: "5.5.1", Digests: []types.Digest{"sha512:33509dae9b700f0ffd12cfd6d6dc3033ac365b4234d7dba1303039ab72d4428e136196e50e2a6efe83b982d5297b4b4487550e76bc6565e4018662524ed02d9c"}},
+		{Name: "Swashbuckle.AspNetCore.Swagger", Version: "5.5.1", Digests: []types.Digest{"sha512:625ae746220541d8e1f8c7c1b625333cfb11c24e7be3976fd43796c203417a13773f066322cfa073355a4b487928a3fd38df9ef0633789e6a357a05566d73f75"}},
+		{Name: "Swashbuckle.AspNetCore.SwaggerGen", Version: "5.5.1", Digests: []types.Digest{"sha512:49fb4ba1fcc8034eb7cbbdeb9f5ed809fe1d497ae065dc4b06283d13f1d3e08219f6696c9b5d8a9ac34a5177f853e5f4f4a3bcb15ab50f6f577e356d0baf5e02"}},
+		{Name: "Swashbuckle.AspNetCore.SwaggerUI", Version: "5.5.1", Digests: []types.Digest{"sha512:6b6626f4362bbd367f62df0655f9fa33564ffbe45adca01f08d7b8a53b643621e90605a1b185944f74f4ed112d14899db8187ddc6007b88b59a65b52399575dc"}},
 	}
 
 	// mcr.microsoft.com/dotnet/sdk:latest
@@ -44,8 +44,8 @@ var (
 	// dotnet restore --use-lock-file
 	// cat packages.lock.json | jq -rc '.dependencies[] | keys[] as $k | "{\"\($k)\", \"\(.[$k] | .resolved)\", \"\"},"'
 	nuGetLegacy = []types.Library{
-		{Name: "AWSSDK.Core", Version: "3.5.1.30"},
-		{Name: "Newtonsoft.Json", Version: "12.0.3"},
+		{Name: "AWSSDK.Core", Version: "3.5.1.30", Digests: []types.Digest{"sha512:90dab363fa72e290e55bd68f9f64a99c6ef2eccaf86748e0fc17641e246bf562edee4fe5c0632627985ddf1fd91c392ff2421d8e596456bae16245ef8fa02408"}},
+		{Name: "Newtonsoft.Json", Version: "12.0.3", Digests: []types.Digest{"sha512:ea68237e7441e2328c9731d297e543fa851cd4879b39969b91bc968f64624e05b060f3ee68ad47f7b1b5b07a86c0f952e67a621794343abc4dd709e2da7e505a"}},
 	}
 
 	// docker run --rm -i -t mcr.microsoft.com/dotnet/sdk:latest
@@ -58,45 +58,45 @@ var (
 	// dotnet add package AWSSDK.Core
 	// cat packages.lock.json | jq -rc '.dependencies[] | keys[] as $k | "{\"\($k)\", \"\(.[$k] | .resolved)\", \"\"},"' | sort -u
 	nuGetMultiTarget = []types.Library{
-		{Name: "AWSSDK.Core", Version: "3.5.1.30"},
-		{Name: "Microsoft.Bcl.AsyncInterfaces", Version: "1.1.0"},
-		{Name: "Microsoft.CSharp", Version: "4.3.0"},
-		{Name: "Microsoft.NETCore.Platforms", Version: "1.1.0"},
-		{Name: "Microsoft.NETCore.Targets", Version: "1.1.0"},
-		{Name: "Microsoft.NETFramework.ReferenceAssemblies", Version: "1.0.0"},
-		{Name: "Microsoft.NETFramework.ReferenceAssemblies.net20", Version: "1.0.0"},
-		{Name: "Microsoft.NETFramework.ReferenceAssemblies.net40", Version: "1.0.0"},
-		{Name: "NETStandard.Library", Version: "1.6.1"},
-		{Name: "NETStandard.Library", Version: "2.0.3"},
-		{Name: "Newtonsoft.Json", Version: "12.0.3"},
-		{Name: "System.Collections", Version: "4.3.0"},
-		{Name: "System.ComponentModel", Version: "4.3.0"},
-		{Name: "System.ComponentModel.Primitives", Version: "4.3.0"},
-		{Name: "System.ComponentModel.TypeConverter", Version: "4.3.0"},
-		{Name: "System.Diagnostics.Debug", Version: "4.3.0"},
-		{Name: "System.Diagnostics.Tools", Version: "4.3.0"},
-		{Name: "System.Dynamic.Runtime", Version: "4.3.0"},
-		{Name: "System.Globalization", Version: "4.3.0"},
-		{Name: "System.IO", Version: "4.3.0"},
-		{Name: "System.Linq", Version: "4.3.0"},
-		{Name: "System.Linq.Expressions", Version: "4.3.0"},
-		{Name: "System.Net.Primitives", Version: "4.3.0"},
-		{Name: "System.ObjectModel", Version: "4.3.0"},
-		{Name: "System.Reflection", Version: "4.3.0"},
-		{Name: "System.Reflection.Extensions", Version: "4.3.0"},
-		{Name: "System.Reflection.Primitives", Version: "4.3.0"},
-		{Name: "System.Resources.ResourceManager", Version: "4.3.0"},
-		{Name: "System.Runtime", Version: "4.3.0"},
-		{Name: "System.Runtime.CompilerServices.Unsafe", Version: "4.5.2"},
-		{Name: "System.Runtime.Extensions", Version: "4.3.0"},
-		{Name: "System.Runtime.Serialization.Primitives", Version: "4.3.0"},
-		{Name: "System.Text.Encoding", Version: "4.3.0"},
-		{Name: "System.Text.Encoding.Extensions", Version: "4.3.0"},
-		{Name: "System.Text.RegularExpressions", Version: "4.3.0"},
-		{Name: "System.Threading", Version: "4.3.0"},
-		{Name: "System.Threading.Tasks", Version: "4.3.0"},
-		{Name: "System.Threading.Tasks.Extensions", Version: "4.5.2"},
-		{Name: "System.Xml.ReaderWriter", Version: "4.3.0"},
-		{Name: "System.Xml.XDocument", Version: "4.3.0"},
+		{Name: "AWSSDK.Core", Version: "3.5.1.30", Digests: []types.Digest{"sha512:90dab363fa72e290e55bd68f9f64a99c6ef2eccaf86748e0fc17641e246bf562edee4fe5c0632627985ddf1fd91c392ff2421d8e596456bae16245ef8fa02408"}},
+		{Name: "Microsoft.Bcl.AsyncInterfaces", Version: "1.1.0", Digests: []types.Digest{"sha512:d409ba9785699f7fcadf675a12a648f8516bf7a3a56648302b62dc4f7a59db35ae6d1e734cf5b7fc590ed516adf646fba1039ae2bc6097d2c91dce6db290967e"}},
+		{Name: "Microsoft.CSharp", Version: "4.3.0", Digests: []types.Digest{"sha512:3fe301848334617f89a913ae7fb8b7d3a64b2448d0600f6e53244313e3aac14239b21e357b665b3d05772df00f87edbd72671e135a547df5ec19f47878c63728"}},
+		{Name: "Microsoft.NETCore.Platforms", Version: "1.1.0", Digests: []types.Digest{"sha512:933d0f116da586aca07a123f77a5ec3c24330fb7dfee050969518f5444d7eb5d5e69d1ac03703cefb19d4a55342d154c0931fff8fde8da20d36a4f92d3c576f8"}},
+		{Name: "Microsoft.NETCore.Targets", Version: "1.1.0", Digests: []types.Digest{"sha512:68e640dc159fcfd4578e9cedd2c44924c8c0b1c0149b71e86b851601f71e57d513631830675959b799ceda6c857fefe37ad610a38b933fbcd2f2c258ebb041c6"}},
+		{Name: "Microsoft.NETFramework.ReferenceAssemblies", Version: "1.0.0", Digests: []types.Digest{"sha512:ec3d9332e7e31a2a309add04f78d645684c84be19337368fa29bb3335ff52d268901d25d06b54fd129195bb0200ddd1ad94d438ec21e68a1b5c3119504d2c333"}},
+		{Name: "Microsoft.NETFramework.ReferenceAssemblies.net20", Version: "1.0.0", Digests: []types.Digest{"sha512:4de7cf154b6a226c03ad1ccceb04d99d6a6f90ea2da8ae0a4ff2e74a2ef3b09b0d06b9d0efa9ee1b860a32b10f7ce2be4362a6b0087e9b9bd65ca9213bd96885"}},
+		{Name: "Microsoft.NETFramework.ReferenceAssemblies.net40", Version: "1.0.0", Digests: []types.Digest{"sha512:78bcbb1ef12feeadd2d388d5f98796434241d21de232b849fcde1f57ea48dd0fb222faaac6b51475ca7634002afc4cb5562837ff288f4bff16f9a7737c9a2a86"}},
+		{Name: "NETStandard.Library", Version: "1.6.1", Digests: []types.Digest{"sha512:59c4a9dfebcffb21cd812f0457927ba59f48469783b804413cddbc6f2f33a9f7f5c094179b6e8f554f0bdff7d82c125553b06d0f2a8d556ab62a5095bd2491e0"}},
+		{Name: "NETStandard.Library", Version: "2.0.3", Digests: []types.Digest{"sha512:b2de3b3e8b19487ae3102763788cd941bce2bd804916fe8fda7bf8723db2a5d236d380cefaf67b979ada18c897e1e5cc279dd17ce220fbfb380c7559e7836ed8"}},
+		{Name: "Newtonsoft.Json", Version: "12.0.3", Digests: []types.Digest{"sha512:ea68237e7441e2328c9731d297e543fa851cd4879b39969b91bc968f64624e05b060f3ee68ad47f7b1b5b07a86c0f952e67a621794343abc4dd709e2da7e505a"}},
+		{Name: "System.Collections", Version: "4.3.0", Digests: []types.Digest{"sha512:dc3723f39fd305d5692f966bfa0104054b857b689c3a72da966121f617dc9353d361b6f25ae6608787e69b6cac08b4eba8b430eaddd3813c89f952e9f906fe2f"}},
+		{Name: "System.ComponentModel", Version: "4.3.0", Digests: []types.Digest{"sha512:5721a7d631916557f19e1f0476f0c28bbd6fddb317aecbbc69824ec2857b48d0cb561884ab03fcea93ccc911ac0ecc615c09b66f7a3d388a9e1137cde6a7decf"}},
+		{Name: "System.ComponentModel.Primitives", Version: "4.3.0", Digests: []types.Digest{"sha512:8fc194902a4cf15e1de2f84b208a012c67b2d99e5b0a4315363119b1ec80966e27e5aadcb0939e237ce450ffb3bd9833b1b2d3621e2561e3ff643fe074800faf"}},
+		{Name: "System.ComponentModel.TypeConverter", Version: "4.3.0", Digests: []types.Digest{"sha512:d7aa50e8ff84761717ccf8842b891b03de7716ed0c346da8bf14d953cd7faac09dd733d1b0a737ba27f9360be5942639f7c93a6c8d0a532296f1f6a795f68342"}},
+		{Name: "System.Diagnostics.Debug", Version: "4.3.0", Digests: []types.Digest{"sha512:65485439daa6686e499375dd6fcc62e64232418000e0f9d33651f1d66bbd658deabf810b21d29b9cbfda91b19a2a2d919cd516699b00b37d6bbf315d7b04e3da"}},
+		{Name: "System.Diagnostics.Tools", Version: "4.3.0", Digests: []types.Digest{"sha512:514be425f49824c33ac79dbb74983657258f491a88541d19edd6e31ecb75ce6c135f3e42717498245591a6e8a07db3b52dfef27d988868451eb1f9e5fe0e44c8"}},
+		{Name: "System.Dynamic.Runtime", Version: "4.3.0", Digests: []types.Digest{"sha512:48d562d44fef7d6500b3f5982a113dfaa952e8aa8ad185619e54f41d0b6bf2930803c617de5c32dee3cca309c3c1d608481766005ff6868944225755a7ce566a"}},
+		{Name: "System.Globalization", Version: "4.3.0", Digests: []types.Digest{"sha512:9187557767f63c074519b97316cc04e2190d00d2412919ac7dad97e4b1b601c584d5ceffe2dd2961a7b52fcbdf679c6f1369cafd1f49a6bb53a00eb53921d622"}},
+		{Name: "System.IO", Version: "4.3.0", Digests: []types.Digest{"sha512:dea8da1efc503c3a523982028d4a13b26a2ae6ee902401515084e0793ff882a905d5b6a36d299bd64c12c4403c0079687ea81c28970cf2e76089e44d85a2790a"}},
+		{Name: "System.Linq", Version: "4.3.0", Digests: []types.Digest{"sha512:e436ea214a6c0e9d1d15fb72b73b8c99cd2878c750c2370ffc45b1b24b08cffc354dc151919df228acf43ea89814c984c0f496c3ea8d56a0fb3c9f3cf49cc76f"}},
+		{Name: "System.Linq.Expressions", Version: "4.3.0", Digests: []types.Digest{"sha512:3c62a4addda4846e029e5c89c31c306965a24a25853411a5817bc9a5e3b4c425eb67cf4e0eb43ab631164bf90ea99f06c04394013b4ab73a7579182660d7dc96"}},
+		{Name: "System.Net.Primitives", Version: "4.3.0", Digests: []types.Digest{"sha512:a8ebbe843c05c2864f6f33efc2eb76a804dedf2823790043423f75c65b1aa86150508e62e199d96fccb242e2c6a431a2bc43c8b7c10991dd415735683f7d455c"}},
+		{Name: "System.ObjectModel", Version: "4.3.0", Digests: []types.Digest{"sha512:6dd5fef3478abfd6cde8ae0df9defb39a9e42871a7e821fbd756ba7dc3a9310bb615c929fc5b782ff916f56ce71e9c91d0d440bc9badcce30734d9411afc50cd"}},
+		{Name: "System.Reflection", Version: "4.3.0", Digests: []types.Digest{"sha512:28c8801685bb31f2466bd9c314d71fbbe16911d887a56813712d8774ca43bedf6c68adf2fc6e06c29acfcb3aa3147f4d4da18f41e58d1d4fa20e50c82e3f7a69"}},
+		{Name: "System.Reflection.Extensions", Version: "4.3.0", Digests: []types.Digest{"sha512:ac992b243de4048e41ef5d9a46ee03a522221d1b6be9095f65242c6f48581eb0c264e4570858d07e88a9a362da3141e84fd8b507b8fb3277da10a58316615035"}},
+		{Name: "System.Reflection.Primitives", Version: "4.3.0", Digests: []types.Digest{"sha512:e515c8b50cf902ce31376fd8503c5da6c124321bf0ddee9a36f7855d49f81e5fee74d4c23619caa7c953f5f9dcdcc86f18a8756da6a4e42a2fa6e4145c702520"}},
+		{Name: "System.Resources.ResourceManager", Version: "4.3.0", Digests: []types.Digest{"sha512:ff3adc3e4916759988e05f7680bfd33ee98ff7c0150eefd6c6bdc248919043e5cdeb06d165cc9f48a5683e8d7b8a56f788eaf470246a2489c6c0d32896a852f0"}},
+		{Name: "System.Runtime", Version: "4.3.0", Digests: []types.Digest{"sha512:26e7d08b4bcf434c469c0733475dc05058250f2558b782aa9f3d4065a88a679f86202ab4ff5307fe63bf780247b7f9875b5ce328125dee4576e92af175d0218b"}},
+		{Name: "System.Runtime.CompilerServices.Unsafe", Version: "4.5.2", Digests: []types.Digest{"sha512:c29ad21607b1b7c730ab2982861ac12eeeb62cc83fd6ef766d4f953b0c9f0629923d5157b6c36a1160bdd8f7fda1fcc916593820798903be440c99f56f682801"}},
+		{Name: "System.Runtime.Extensions", Version: "4.3.0", Digests: []types.Digest{"sha512:82e5b4b8ad1f9f97dc249d6d2555d877bff587917ea5e6b5afb14b48ecff7fcbcf12a6d1d990249ee443bd343c3f3022943bde3b136349faf40877c841f164f2"}},
+		{Name: "System.Runtime.Serialization.Primitives", Version: "4.3.0", Digests: []types.Digest{"sha512:5b3fb428eba42460255e3b4aafee57a6ec5ff3e73cef7f512350be0360684194fec0c082a0c0c374ef3fe084477da54836a2fbf063bc756f06da55bf7b8e4c73"}},
+		{Name: "System.Text.Encoding", Version: "4.3.0", Digests: []types.Digest{"sha512:062220f8a59a4833884f37ba8c64329f183ae276803eda861c1c03acb682b62c6c6b96ca891f1da4f38703b81edc2d092508b3244fac7e4cf5c15f8100a01867"}},
+		{Name: "System.Text.Encoding.Extensions", Version: "4.3.0", Digests: []types.Digest{"sha512:61530ad01b7f038dd19b08b3268676d9e8b69e6ae1a1b81e898c05cc2e1800dfa7b9ef1117a7635c33288345029fe6eb7ab428615c9a4be821cbd3ff31455c9b"}},
+		{Name: "System.Text.RegularExpressions", Version: "4.3.0", Digests: []types.Digest{"sha512:4694f60c0f8bebad1c06dd45b2c204f42006a4b15d14fba1781ee92e92a99fa65735bcbb8c31117bc51afcd7b6c468b02d51b624eab38a26950860e89f9b0a14"}},
+		{Name: "System.Threading", Version: "4.3.0", Digests: []types.Digest{"sha512:564512d243817147f75b09b44d26eb7af0c367a06533e6ff1d189aa511568cce4ed0d4b42ef886d2094a9852be8613c37755c5792754d469a52e16f60a856923"}},
+		{Name: "System.Threading.Tasks", Version: "4.3.0", Digests: []types.Digest{"sha512:2db4b128474e52155ef017b307ff6e3861a9a6dfa765fe9ed55172c3abf70cdea5aa2b66d0e4a7dae5cc39db4fd0cdd6e2231ca9c8af9b627a520aa2c309d788"}},
+		{Name: "System.Threading.Tasks.Extensions", Version: "4.5.2", Digests: []types.Digest{"sha512:046fd33710c5bf4b2f033c7c3a23170e5b077c6c3adb7059f2d097c3860b843143bc384d504579f2329830646791b24d9bb73724d3490e237b241333c51051db"}},
+		{Name: "System.Xml.ReaderWriter", Version: "4.3.0", Digests: []types.Digest{"sha512:1aba6b03e6744545da47837bfde5bbd63d6b80c9c49c45658228b8f466720234c7eee962327ace5371cd141afa7c40c108908876554dabd8476da0d1eb6d5704"}},
+		{Name: "System.Xml.XDocument", Version: "4.3.0", Digests: []types.Digest{"sha512:e732745c3c40220f22cbeb7868c9d002ed0ca956eacaf7e8515975c8357ad71768dd5b61e39a00d85a18e293e4c58007e5ff22c69993a977842326bde71d1a09"}},
 	}
 )
@@ -2,10 +2,13 @@ package composer
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 
-	"github.com/aquasecurity/go-dep-parser/pkg/types"
 	"golang.org/x/xerrors"
+
+	djson "github.com/aquasecurity/go-dep-parser/pkg/json"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
 )
 
 type lockFile struct {
@@ -17,19 +20,31 @@ type packageInfo struct {
 }
 
 func Parse(r io.Reader) ([]types.Library, error) {
-	var lockFile lockFile
-	decoder := json.NewDecoder(r)
-	err := decoder.Decode(&lockFile)
+	data, err := io.ReadAll(r)
 	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+
+	var lockFile lockFile
+	if err = json.Unmarshal(data, &lockFile); err != nil {
 		return nil, xerrors.Errorf("decode error: %w", err)
 	}
 
+	locations, err := djson.Locations(data)
+	if err != nil {
+		return nil, xerrors.Errorf("location error: %w", err)
+	}
+
 	var libs []types.Library
-	for _, pkg := range lockFile.Packages {
-		libs = append(libs, types.Library{
+	for i, pkg := range lockFile.Packages {
+		lib := types.Library{
 			Name:    pkg.Name,
 			Version: pkg.Version,
-		})
+		}
+		if loc, ok := locations[fmt.Sprintf("/packages/%d", i)]; ok {
+			lib.Locations = types.Locations{loc}
+		}
+		libs = append(libs, lib)
 	}
 	return libs, nil
 }
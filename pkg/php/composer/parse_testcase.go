@@ -7,8 +7,8 @@ var (
 	// composer require pear/log
 	// composer show -i -f json | jq -rc '.installed[] | "{\"\(.name)\", \"\(.version)\", },"'
 	ComposerNormal = []types.Library{
-		{Name: "pear/log", Version: "1.13.1"},
-		{Name: "pear/pear_exception", Version: "v1.0.0"},
+		{Name: "pear/log", Version: "1.13.1", Locations: types.Locations{{StartLine: 9, EndLine: 61}}},
+		{Name: "pear/pear_exception", Version: "v1.0.0", Locations: types.Locations{{StartLine: 62, EndLine: 116}}},
 	}
 
 	// docker run --name composer --rm -it composer:1.8 bash
@@ -16,20 +16,20 @@ var (
 	// composer require "laravel/installer"
 	// composer show -i -f json | jq -rc '.installed[] | "{\"\(.name)\", \"\(.version)\", },"'
 	ComposerLaravel = []types.Library{
-		{Name: "guzzlehttp/guzzle", Version: "6.3.3"},
-		{Name: "guzzlehttp/promises", Version: "v1.3.1"},
-		{Name: "guzzlehttp/psr7", Version: "1.5.2"},
-		{Name: "laravel/installer", Version: "v2.0.1"},
-		{Name: "pear/log", Version: "1.13.1"},
-		{Name: "pear/pear_exception", Version: "v1.0.0"},
-		{Name: "psr/http-message", Version: "1.0.1"},
-		{Name: "ralouphie/getallheaders", Version: "2.0.5"},
-		{Name: "symfony/console", Version: "v4.2.7"},
-		{Name: "symfony/contracts", Version: "v1.0.2"},
-		{Name: "symfony/filesystem", Version: "v4.2.7"},
-		{Name: "symfony/polyfill-ctype", Version: "v1.11.0"},
-		{Name: "symfony/polyfill-mbstring", Version: "v1.11.0"},
-		{Name: "symfony/process", Version: "v4.2.7"},
+		{Name: "guzzlehttp/guzzle", Version: "6.3.3", Locations: types.Locations{{StartLine: 9, EndLine: 73}}},
+		{Name: "guzzlehttp/promises", Version: "v1.3.1", Locations: types.Locations{{StartLine: 74, EndLine: 124}}},
+		{Name: "guzzlehttp/psr7", Version: "1.5.2", Locations: types.Locations{{StartLine: 125, EndLine: 191}}},
+		{Name: "laravel/installer", Version: "v2.0.1", Locations: types.Locations{{StartLine: 192, EndLine: 237}}},
+		{Name: "pear/log", Version: "1.13.1", Locations: types.Locations{{StartLine: 238, EndLine: 290}}},
+		{Name: "pear/pear_exception", Version: "v1.0.0", Locations: types.Locations{{StartLine: 291, EndLine: 345}}},
+		{Name: "psr/http-message", Version: "1.0.1", Locations: types.Locations{{StartLine: 346, EndLine: 395}}},
+		{Name: "ralouphie/getallheaders", Version: "2.0.5", Locations: types.Locations{{StartLine: 396, EndLine: 435}}},
+		{Name: "symfony/console", Version: "v4.2.7", Locations: types.Locations{{StartLine: 436, EndLine: 507}}},
+		{Name: "symfony/contracts", Version: "v1.0.2", Locations: types.Locations{{StartLine: 508, EndLine: 575}}},
+		{Name: "symfony/filesystem", Version: "v4.2.7", Locations: types.Locations{{StartLine: 576, EndLine: 625}}},
+		{Name: "symfony/polyfill-ctype", Version: "v1.11.0", Locations: types.Locations{{StartLine: 626, EndLine: 683}}},
+		{Name: "symfony/polyfill-mbstring", Version: "v1.11.0", Locations: types.Locations{{StartLine: 684, EndLine: 742}}},
+		{Name: "symfony/process", Version: "v4.2.7", Locations: types.Locations{{StartLine: 743, EndLine: 791}}},
 	}
 
 	// docker run --name composer --rm -it composer:1.8 bash
@@ -38,34 +38,34 @@ var (
 	// composer require "symfony/symfony"
 	// composer show -i -f json | jq -rc '.installed[] | "{\"\(.name)\", \"\(.version)\", },"'
 	ComposerSymfony = []types.Library{
-		{Name: "doctrine/annotations", Version: "v1.6.1"},
-		{Name: "doctrine/cache", Version: "v1.8.0"},
-		{Name: "doctrine/collections", Version: "v1.6.1"},
-		{Name: "doctrine/event-manager", Version: "v1.0.0"},
-		{Name: "doctrine/lexer", Version: "v1.0.1"},
-		{Name: "doctrine/persistence", Version: "1.1.1"},
-		{Name: "doctrine/reflection", Version: "v1.0.0"},
-		{Name: "fig/link-util", Version: "1.0.0"},
-		{Name: "guzzlehttp/guzzle", Version: "6.3.3"},
-		{Name: "guzzlehttp/promises", Version: "v1.3.1"},
-		{Name: "guzzlehttp/psr7", Version: "1.5.2"},
-		{Name: "laravel/installer", Version: "v2.0.1"},
-		{Name: "pear/log", Version: "1.13.1"},
-		{Name: "pear/pear_exception", Version: "v1.0.0"},
-		{Name: "psr/cache", Version: "1.0.1"},
-		{Name: "psr/container", Version: "1.0.0"},
-		{Name: "psr/http-message", Version: "1.0.1"},
-		{Name: "psr/link", Version: "1.0.0"},
-		{Name: "psr/log", Version: "1.1.0"},
-		{Name: "psr/simple-cache", Version: "1.0.1"},
-		{Name: "ralouphie/getallheaders", Version: "2.0.5"},
-		{Name: "symfony/contracts", Version: "v1.0.2"},
-		{Name: "symfony/polyfill-ctype", Version: "v1.11.0"},
-		{Name: "symfony/polyfill-intl-icu", Version: "v1.11.0"},
-		{Name: "symfony/polyfill-mbstring", Version: "v1.11.0"},
-		{Name: "symfony/polyfill-php72", Version: "v1.11.0"},
-		{Name: "symfony/symfony", Version: "v4.2.7"},
-		{Name: "twig/twig", Version: "v2.9.0"},
+		{Name: "doctrine/annotations", Version: "v1.6.1", Locations: types.Locations{{StartLine: 9, EndLine: 76}}},
+		{Name: "doctrine/cache", Version: "v1.8.0", Locations: types.Locations{{StartLine: 77, EndLine: 151}}},
+		{Name: "doctrine/collections", Version: "v1.6.1", Locations: types.Locations{{StartLine: 152, EndLine: 221}}},
+		{Name: "doctrine/event-manager", Version: "v1.0.0", Locations: types.Locations{{StartLine: 222, EndLine: 295}}},
+		{Name: "doctrine/lexer", Version: "v1.0.1", Locations: types.Locations{{StartLine: 296, EndLine: 349}}},
+		{Name: "doctrine/persistence", Version: "1.1.1", Locations: types.Locations{{StartLine: 350, EndLine: 431}}},
+		{Name: "doctrine/reflection", Version: "v1.0.0", Locations: types.Locations{{StartLine: 432, EndLine: 506}}},
+		{Name: "fig/link-util", Version: "1.0.0", Locations: types.Locations{{StartLine: 507, EndLine: 560}}},
+		{Name: "guzzlehttp/guzzle", Version: "6.3.3", Locations: types.Locations{{StartLine: 561, EndLine: 625}}},
+		{Name: "guzzlehttp/promises", Version: "v1.3.1", Locations: types.Locations{{StartLine: 626, EndLine: 676}}},
+		{Name: "guzzlehttp/psr7", Version: "1.5.2", Locations: types.Locations{{StartLine: 677, EndLine: 743}}},
+		{Name: "laravel/installer", Version: "v2.0.1", Locations: types.Locations{{StartLine: 744, EndLine: 789}}},
+		{Name: "pear/log", Version: "1.13.1", Locations: types.Locations{{StartLine: 790, EndLine: 842}}},
+		{Name: "pear/pear_exception", Version: "v1.0.0", Locations: types.Locations{{StartLine: 843, EndLine: 897}}},
+		{Name: "psr/cache", Version: "1.0.1", Locations: types.Locations{{StartLine: 898, EndLine: 943}}},
+		{Name: "psr/container", Version: "1.0.0", Locations: types.Locations{{StartLine: 944, EndLine: 992}}},
+		{Name: "psr/http-message", Version: "1.0.1", Locations: types.Locations{{StartLine: 993, EndLine: 1042}}},
+		{Name: "psr/link", Version: "1.0.0", Locations: types.Locations{{StartLine: 1043, EndLine: 1091}}},
+		{Name: "psr/log", Version: "1.1.0", Locations: types.Locations{{StartLine: 1092, EndLine: 1138}}},
+		{Name: "psr/simple-cache", Version: "1.0.1", Locations: types.Locations{{StartLine: 1139, EndLine: 1186}}},
+		{Name: "ralouphie/getallheaders", Version: "2.0.5", Locations: types.Locations{{StartLine: 1187, EndLine: 1226}}},
+		{Name: "symfony/contracts", Version: "v1.0.2", Locations: types.Locations{{StartLine: 1227, EndLine: 1294}}},
+		{Name: "symfony/polyfill-ctype", Version: "v1.11.0", Locations: types.Locations{{StartLine: 1295, EndLine: 1352}}},
+		{Name: "symfony/polyfill-intl-icu", Version: "v1.11.0", Locations: types.Locations{{StartLine: 1353, EndLine: 1410}}},
+		{Name: "symfony/polyfill-mbstring", Version: "v1.11.0", Locations: types.Locations{{StartLine: 1411, EndLine: 1469}}},
+		{Name: "symfony/polyfill-php72", Version: "v1.11.0", Locations: types.Locations{{StartLine: 1470, EndLine: 1524}}},
+		{Name: "symfony/symfony", Version: "v4.2.7", Locations: types.Locations{{StartLine: 1525, EndLine: 1685}}},
+		{Name: "twig/twig", Version: "v2.9.0", Locations: types.Locations{{StartLine: 1686, EndLine: 1752}}},
 	}
 
 	// docker run --name composer --rm -it composer:1.8 bash
@@ -75,33 +75,33 @@ var (
 	// composer require fzaninotto/faker --dev
 	// composer show -i -f json | jq -rc '.installed[] | "{\"\(.name)\", \"\(.version)\", },"'
 	ComposerWithDev = []types.Library{
-		{Name: "doctrine/annotations", Version: "v1.6.1"},
-		{Name: "doctrine/cache", Version: "v1.8.0"},
-		{Name: "doctrine/collections", Version: "v1.6.1"},
-		{Name: "doctrine/event-manager", Version: "v1.0.0"},
-		{Name: "doctrine/lexer", Version: "v1.0.1"},
-		{Name: "doctrine/persistence", Version: "1.1.1"},
-		{Name: "doctrine/reflection", Version: "v1.0.0"},
-		{Name: "fig/link-util", Version: "1.0.0"},
-		{Name: "guzzlehttp/guzzle", Version: "6.3.3"},
-		{Name: "guzzlehttp/promises", Version: "v1.3.1"},
-		{Name: "guzzlehttp/psr7", Version: "1.5.2"},
-		{Name: "laravel/installer", Version: "v2.0.1"},
-		{Name: "pear/log", Version: "1.13.1"},
-		{Name: "pear/pear_exception", Version: "v1.0.0"},
-		{Name: "psr/cache", Version: "1.0.1"},
-		{Name: "psr/container", Version: "1.0.0"},
-		{Name: "psr/http-message", Version: "1.0.1"},
-		{Name: "psr/link", Version: "1.0.0"},
-		{Name: "psr/log", Version: "1.1.0"},
-		{Name: "psr/simple-cache", Version: "1.0.1"},
-		{Name: "ralouphie/getallheaders", Version: "2.0.5"},
-		{Name: "symfony/contracts", Version: "v1.0.2"},
-		{Name: "symfony/polyfill-ctype", Version: "v1.11.0"},
-		{Name: "symfony/polyfill-intl-icu", Version: "v1.11.0"},
-		{Name: "symfony/polyfill-mbstring", Version: "v1.11.0"},
-		{Name: "symfony/polyfill-php72", Version: "v1.11.0"},
-		{Name: "symfony/symfony", Version: "v4.2.7"},
-		{Name: "twig/twig", Version: "v2.9.0"},
+		{Name: "doctrine/annotations", Version: "v1.6.1", Locations: types.Locations{{StartLine: 9, EndLine: 76}}},
+		{Name: "doctrine/cache", Version: "v1.8.0", Locations: types.Locations{{StartLine: 77, EndLine: 151}}},
+		{Name: "doctrine/collections", Version: "v1.6.1", Locations: types.Locations{{StartLine: 152, EndLine: 221}}},
+		{Name: "doctrine/event-manager", Version: "v1.0.0", Locations: types.Locations{{StartLine: 222, EndLine: 295}}},
+		{Name: "doctrine/lexer", Version: "v1.0.1", Locations: types.Locations{{StartLine: 296, EndLine: 349}}},
+		{Name: "doctrine/persistence", Version: "1.1.1", Locations: types.Locations{{StartLine: 350, EndLine: 431}}},
+		{Name: "doctrine/reflection", Version: "v1.0.0", Locations: types.Locations{{StartLine: 432, EndLine: 506}}},
+		{Name: "fig/link-util", Version: "1.0.0", Locations: types.Locations{{StartLine: 507, EndLine: 560}}},
+		{Name: "guzzlehttp/guzzle", Version: "6.3.3", Locations: types.Locations{{StartLine: 561, EndLine: 625}}},
+		{Name: "guzzlehttp/promises", Version: "v1.3.1", Locations: types.Locations{{StartLine: 626, EndLine: 676}}},
+		{Name: "guzzlehttp/psr7", Version: "1.5.2", Locations: types.Locations{{StartLine: 677, EndLine: 743}}},
+		{Name: "laravel/installer", Version: "v2.0.1", Locations: types.Locations{{StartLine: 744, EndLine: 789}}},
+		{Name: "pear/log", Version: "1.13.1", Locations: types.Locations{{StartLine: 790, EndLine: 842}}},
+		{Name: "pear/pear_exception", Version: "v1.0.0", Locations: types.Locations{{StartLine: 843, EndLine: 897}}},
+		{Name: "psr/cache", Version: "1.0.1", Locations: types.Locations{{StartLine: 898, EndLine: 943}}},
+		{Name: "psr/container", Version: "1.0.0", Locations: types.Locations{{StartLine: 944, EndLine: 992}}},
+		{Name: "psr/http-message", Version: "1.0.1", Locations: types.Locations{{StartLine: 993, EndLine: 1042}}},
+		{Name: "psr/link", Version: "1.0.0", Locations: types.Locations{{StartLine: 1043, EndLine: 1091}}},
+		{Name: "psr/log", Version: "1.1.0", Locations: types.Locations{{StartLine: 1092, EndLine: 1138}}},
+		{Name: "psr/simple-cache", Version: "1.0.1", Locations: types.Locations{{StartLine: 1139, EndLine: 1186}}},
+		{Name: "ralouphie/getallheaders", Version: "2.0.5", Locations: types.Locations{{StartLine: 1187, EndLine: 1226}}},
+		{Name: "symfony/contracts", Version: "v1.0.2", Locations: types.Locations{{StartLine: 1227, EndLine: 1294}}},
+		{Name: "symfony/polyfill-ctype", Version: "v1.11.0", Locations: types.Locations{{StartLine: 1295, EndLine: 1352}}},
+		{Name: "symfony/polyfill-intl-icu", Version: "v1.11.0", Locations: types.Locations{{StartLine: 1353, EndLine: 1410}}},
+		{Name: "symfony/polyfill-mbstring", Version: "v1.11.0", Locations: types.Locations{{StartLine: 1411, EndLine: 1469}}},
+		{Name: "symfony/polyfill-php72", Version: "v1.11.0", Locations: types.Locations{{StartLine: 1470, EndLine: 1524}}},
+		{Name: "symfony/symfony", Version: "v4.2.7", Locations: types.Locations{{StartLine: 1525, EndLine: 1685}}},
+		{Name: "twig/twig", Version: "v2.9.0", Locations: types.Locations{{StartLine: 1686, EndLine: 1752}}},
 	}
 )
@@ -0,0 +1,411 @@
+package purl
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// Package URL types
+// ref. https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst
+const (
+	TypeNPM      = "npm"
+	TypePyPI     = "pypi"
+	TypeGem      = "gem"
+	TypeCargo    = "cargo"
+	TypeGolang   = "golang"
+	TypeMaven    = "maven"
+	TypeNuGet    = "nuget"
+	TypeComposer = "composer"
+
+	scheme = "pkg"
+)
+
+var (
+	ErrUnsupportedEcosystem = xerrors.New("unsupported ecosystem")
+	ErrInvalidPackageURL    = xerrors.New("invalid package URL")
+
+	// ref. https://peps.python.org/pep-0503/#normalized-names
+	pypiNameRegexp = regexp.MustCompile(`[-_.]+`)
+)
+
+// PackageURL represents a package URL.
+// ref. https://github.com/package-url/purl-spec/blob/master/PURL-SPECIFICATION.rst
+type PackageURL struct {
+	Type       string
+	Namespace  string
+	Name       string
+	Version    string
+	Qualifiers Qualifiers
+	Subpath    string
+}
+
+// Qualifier is a key/value pair such as "classifier=sources"
+type Qualifier struct {
+	Key   string
+	Value string
+}
+
+type Qualifiers []Qualifier
+
+// Get returns the value of the key. It returns an empty string if the key doesn't exist.
+func (qs Qualifiers) Get(key string) string {
+	for _, q := range qs {
+		if q.Key == key {
+			return q.Value
+		}
+	}
+	return ""
+}
+
+// NewPackageURL converts the library into a package URL of the ecosystem.
+func NewPackageURL(ecosystem types.Ecosystem, lib types.Library) (PackageURL, error) {
+	p := PackageURL{
+		Name:    lib.Name,
+		Version: lib.Version,
+	}
+
+	switch ecosystem {
+	case types.Npm:
+		// e.g. @babel/core => namespace: @babel, name: core
+		p.Type = TypeNPM
+		p.Namespace, p.Name = splitLast(lib.Name, "/")
+	case types.PyPI:
+		p.Type = TypePyPI
+		p.Name = normalizePyPIName(lib.Name)
+	case types.RubyGems:
+		p.Type = TypeGem
+	case types.Cargo:
+		p.Type = TypeCargo
+	case types.Go:
+		// e.g. github.com/aquasecurity/go-dep-parser => namespace: github.com/aquasecurity, name: go-dep-parser
+		p.Type = TypeGolang
+		p.Namespace, p.Name = splitLast(lib.Name, "/")
+	case types.Maven:
+		// e.g. org.example:example-api => namespace: org.example, name: example-api
+		p.Type = TypeMaven
+		p.Namespace, p.Name, p.Qualifiers = splitMavenName(lib.Name)
+	case types.NuGet:
+		p.Type = TypeNuGet
+	case types.Composer:
+		// e.g. pear/log => namespace: pear, name: log
+		p.Type = TypeComposer
+		p.Namespace, p.Name = splitLast(strings.ToLower(lib.Name), "/")
+	default:
+		return PackageURL{}, xerrors.Errorf("%s: %w", ecosystem, ErrUnsupportedEcosystem)
+	}
+
+	if p.Name == "" {
+		return PackageURL{}, xerrors.Errorf("empty package name: %w", ErrInvalidPackageURL)
+	}
+	return p, nil
+}
+
+// Ecosystem returns the ecosystem corresponding to the package URL type.
+func (p PackageURL) Ecosystem() (types.Ecosystem, error) {
+	switch p.Type {
+	case TypeNPM:
+		return types.Npm, nil
+	case TypePyPI:
+		return types.PyPI, nil
+	case TypeGem:
+		return types.RubyGems, nil
+	case TypeCargo:
+		return types.Cargo, nil
+	case TypeGolang:
+		return types.Go, nil
+	case TypeMaven:
+		return types.Maven, nil
+	case TypeNuGet:
+		return types.NuGet, nil
+	case TypeComposer:
+		return types.Composer, nil
+	}
+	return "", xerrors.Errorf("%s: %w", p.Type, ErrUnsupportedEcosystem)
+}
+
+// Library converts the package URL into the library with the same naming as parsers.
+// The type and the classifier of Maven are restored into the name as splitMavenName splits them.
+func (p PackageURL) Library() types.Library {
+	name := p.Name
+	if p.Namespace != "" {
+		sep := "/"
+		if p.Type == TypeMaven {
+			sep = ":"
+		}
+		name = p.Namespace + sep + p.Name
+	}
+	if p.Type == TypeMaven {
+		name = joinMavenName(name, p.Qualifiers)
+	}
+	return types.Library{
+		Name:    name,
+		Version: p.Version,
+	}
+}
+
+// String returns the canonical form of the package URL.
+// e.g. pkg:npm/%40babel/core@7.14.6
+func (p PackageURL) String() string {
+	var b strings.Builder
+	b.WriteString(scheme + ":" + strings.ToLower(p.Type) + "/")
+
+	if p.Namespace != "" {
+		for _, segment := range strings.Split(p.Namespace, "/") {
+			if segment == "" {
+				continue
+			}
+			b.WriteString(escape(segment) + "/")
+		}
+	}
+	b.WriteString(escape(p.Name))
+
+	if p.Version != "" {
+		b.WriteString("@" + escape(p.Version))
+	}
+
+	qualifiers := make(Qualifiers, 0, len(p.Qualifiers))
+	for _, q := range p.Qualifiers {
+		if q.Value == "" {
+			continue
+		}
+		qualifiers = append(qualifiers, Qualifier{Key: strings.ToLower(q.Key), Value: q.Value})
+	}
+	sort.Slice(qualifiers, func(i, j int) bool {
+		return qualifiers[i].Key < qualifiers[j].Key
+	})
+	for i, q := range qualifiers {
+		if i == 0 {
+			b.WriteString("?")
+		} else {
+			b.WriteString("&")
+		}
+		b.WriteString(q.Key + "=" + escape(q.Value))
+	}
+
+	if p.Subpath != "" {
+		var segments []string
+		for _, segment := range strings.Split(p.Subpath, "/") {
+			if segment == "" || segment == "." || segment == ".." {
+				continue
+			}
+			segments = append(segments, escape(segment))
+		}
+		b.WriteString("#" + strings.Join(segments, "/"))
+	}
+
+	return b.String()
+}
+
+// Parse parses the package URL string.
+func Parse(s string) (PackageURL, error) {
+	var p PackageURL
+
+	// Subpath
+	s, subpath, _ := cut(s, "#", true)
+	if subpath != "" {
+		var segments []string
+		for _, segment := range strings.Split(strings.Trim(subpath, "/"), "/") {
+			if segment == "" || segment == "." || segment == ".." {
+				continue
+			}
+			unescaped, err := url.PathUnescape(segment)
+			if err != nil {
+				return PackageURL{}, xerrors.Errorf("subpath error: %w", err)
+			}
+			segments = append(segments, unescaped)
+		}
+		p.Subpath = strings.Join(segments, "/")
+	}
+
+	// Qualifiers
+	s, rawQualifiers, _ := cut(s, "?", true)
+	if rawQualifiers != "" {
+		for _, pair := range strings.Split(rawQualifiers, "&") {
+			key, value, ok := cut(pair, "=", false)
+			if !ok || key == "" {
+				return PackageURL{}, xerrors.Errorf("invalid qualifier %q: %w", pair, ErrInvalidPackageURL)
+			}
+			unescaped, err := url.PathUnescape(value)
+			if err != nil {
+				return PackageURL{}, xerrors.Errorf("qualifier error: %w", err)
+			}
+			if unescaped == "" {
+				continue
+			}
+			p.Qualifiers = append(p.Qualifiers, Qualifier{Key: strings.ToLower(key), Value: unescaped})
+		}
+	}
+
+	// Scheme
+	s, ok := trimScheme(s)
+	if !ok {
+		return PackageURL{}, xerrors.Errorf("scheme must be %q: %w", scheme, ErrInvalidPackageURL)
+	}
+	s = strings.Trim(s, "/")
+
+	// Type
+	typ, s, ok := cut(s, "/", false)
+	if !ok || typ == "" {
+		return PackageURL{}, xerrors.Errorf("type is required: %w", ErrInvalidPackageURL)
+	}
+	p.Type = strings.ToLower(typ)
+
+	// Version. "@" can be in the namespace such as npm scopes, so it is looked up only in the name.
+	s, version := splitVersion(s)
+	if version != "" {
+		unescaped, err := url.PathUnescape(version)
+		if err != nil {
+			return PackageURL{}, xerrors.Errorf("version error: %w", err)
+		}
+		p.Version = unescaped
+	}
+
+	// Namespace and name
+	var segments []string
+	for _, segment := range strings.Split(s, "/") {
+		if segment == "" {
+			continue
+		}
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return PackageURL{}, xerrors.Errorf("name error: %w", err)
+		}
+		segments = append(segments, unescaped)
+	}
+	if len(segments) == 0 {
+		return PackageURL{}, xerrors.Errorf("name is required: %w", ErrInvalidPackageURL)
+	}
+	p.Name = segments[len(segments)-1]
+	p.Namespace = strings.Join(segments[:len(segments)-1], "/")
+
+	return p, nil
+}
+
+// ToLibrary parses the package URL string and returns the ecosystem and library.
+func ToLibrary(s string) (types.Ecosystem, types.Library, error) {
+	p, err := Parse(s)
+	if err != nil {
+		return "", types.Library{}, xerrors.Errorf("package URL parse error: %w", err)
+	}
+	ecosystem, err := p.Ecosystem()
+	if err != nil {
+		return "", types.Library{}, err
+	}
+	return ecosystem, p.Library(), nil
+}
+
+// FromLibrary returns the package URL string of the library.
+func FromLibrary(ecosystem types.Ecosystem, lib types.Library) (string, error) {
+	p, err := NewPackageURL(ecosystem, lib)
+	if err != nil {
+		return "", err
+	}
+	return p.String(), nil
+}
+
+func trimScheme(s string) (string, bool) {
+	prefix := scheme + ":"
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return "", false
+	}
+	return s[len(prefix):], true
+}
+
+// cut slices s around the first (or last if "last" is true) instance of sep.
+// When sep is not found, it returns s and an empty string.
+func cut(s, sep string, last bool) (before, after string, found bool) {
+	i := strings.Index(s, sep)
+	if last {
+		i = strings.LastIndex(s, sep)
+	}
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
+
+// splitVersion splits the namespace and name from the version after "@" in the last segment.
+func splitVersion(s string) (string, string) {
+	i := strings.LastIndex(s, "/") + 1
+	if j := strings.LastIndex(s[i:], "@"); j >= 0 {
+		return s[:i+j], s[i+j+1:]
+	}
+	return s, ""
+}
+
+// splitMavenName splits the name into the namespace, the name and the qualifiers.
+// The type and the classifier may follow the artifact ID like Maven coordinates.
+// e.g. org.example:example-api:test-jar:tests => type: test-jar, classifier: tests
+func splitMavenName(name string) (string, string, Qualifiers) {
+	parts := strings.SplitN(name, ":", 4)
+	if len(parts) < 3 {
+		namespace, name := splitLast(name, ":")
+		return namespace, name, nil
+	}
+
+	var qualifiers Qualifiers
+	// "jar" is the default type.
+	if typ := parts[2]; typ != "" && typ != "jar" {
+		qualifiers = append(qualifiers, Qualifier{Key: "type", Value: typ})
+	}
+	if len(parts) == 4 && parts[3] != "" {
+		qualifiers = append(qualifiers, Qualifier{Key: "classifier", Value: parts[3]})
+	}
+	return parts[0], parts[1], qualifiers
+}
+
+// joinMavenName appends the type and the classifier in the qualifiers to the name.
+// e.g. org.example:example-api, type: test-jar, classifier: tests => org.example:example-api:test-jar:tests
+func joinMavenName(name string, qualifiers Qualifiers) string {
+	typ, classifier := qualifiers.Get("type"), qualifiers.Get("classifier")
+	switch {
+	case classifier != "":
+		// "jar" is the default type.
+		if typ == "" {
+			typ = "jar"
+		}
+		return name + ":" + typ + ":" + classifier
+	case typ != "" && typ != "jar":
+		return name + ":" + typ
+	}
+	return name
+}
+
+// splitLast splits the name into the namespace and the name by the last separator.
+func splitLast(s, sep string) (string, string) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return "", s
+	}
+	return s[:i], s[i+len(sep):]
+}
+
+func normalizePyPIName(name string) string {
+	return pypiNameRegexp.ReplaceAllString(strings.ToLower(name), "-")
+}
+
+// escape percent-encodes the string except unreserved characters and ':'.
+func escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isUnreserved(c) || c == ':' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
@@ -0,0 +1,342 @@
+package purl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestFromLibrary(t *testing.T) {
+	tests := []struct {
+		name      string
+		ecosystem types.Ecosystem
+		lib       types.Library
+		want      string
+		wantErr   string
+	}{
+		{
+			name:      "npm",
+			ecosystem: types.Npm,
+			lib:       types.Library{Name: "lodash", Version: "4.17.21"},
+			want:      "pkg:npm/lodash@4.17.21",
+		},
+		{
+			name:      "npm with scope",
+			ecosystem: types.Npm,
+			lib:       types.Library{Name: "@babel/core", Version: "7.14.6"},
+			want:      "pkg:npm/%40babel/core@7.14.6",
+		},
+		{
+			name:      "pypi",
+			ecosystem: types.PyPI,
+			lib:       types.Library{Name: "Flask_SQLAlchemy", Version: "2.5.1"},
+			want:      "pkg:pypi/flask-sqlalchemy@2.5.1",
+		},
+		{
+			name:      "gem",
+			ecosystem: types.RubyGems,
+			lib:       types.Library{Name: "rails", Version: "6.1.4"},
+			want:      "pkg:gem/rails@6.1.4",
+		},
+		{
+			name:      "cargo",
+			ecosystem: types.Cargo,
+			lib:       types.Library{Name: "serde", Version: "1.0.126"},
+			want:      "pkg:cargo/serde@1.0.126",
+		},
+		{
+			name:      "golang",
+			ecosystem: types.Go,
+			lib:       types.Library{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.0-20211224170007-df43bca6b6ff"},
+			want:      "pkg:golang/github.com/aquasecurity/go-dep-parser@0.0.0-20211224170007-df43bca6b6ff",
+		},
+		{
+			name:      "maven",
+			ecosystem: types.Maven,
+			lib:       types.Library{Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1"},
+			want:      "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+		},
+		{
+			name:      "maven with type",
+			ecosystem: types.Maven,
+			lib:       types.Library{Name: "org.example:example-web:war", Version: "1.0.0"},
+			want:      "pkg:maven/org.example/example-web@1.0.0?type=war",
+		},
+		{
+			name:      "maven with classifier",
+			ecosystem: types.Maven,
+			lib:       types.Library{Name: "org.example:example-api:jar:sources", Version: "1.0.0"},
+			want:      "pkg:maven/org.example/example-api@1.0.0?classifier=sources",
+		},
+		{
+			name:      "maven with type and classifier",
+			ecosystem: types.Maven,
+			lib:       types.Library{Name: "org.apache.xmlgraphics:batik-anim:zip:dist", Version: "1.9.1"},
+			want:      "pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?classifier=dist&type=zip",
+		},
+		{
+			name:      "nuget",
+			ecosystem: types.NuGet,
+			lib:       types.Library{Name: "Newtonsoft.Json", Version: "12.0.3"},
+			want:      "pkg:nuget/Newtonsoft.Json@12.0.3",
+		},
+		{
+			name:      "composer",
+			ecosystem: types.Composer,
+			lib:       types.Library{Name: "Laravel/Framework", Version: "8.0.0"},
+			want:      "pkg:composer/laravel/framework@8.0.0",
+		},
+		{
+			name:      "version with plus",
+			ecosystem: types.Cargo,
+			lib:       types.Library{Name: "wasi", Version: "0.10.2+wasi-snapshot-preview1"},
+			want:      "pkg:cargo/wasi@0.10.2%2Bwasi-snapshot-preview1",
+		},
+		{
+			name:      "unsupported ecosystem",
+			ecosystem: types.WordPress,
+			lib:       types.Library{Name: "wordpress", Version: "4.9.4"},
+			wantErr:   "unsupported ecosystem",
+		},
+		{
+			name:      "empty name",
+			ecosystem: types.Npm,
+			lib:       types.Library{Name: "@babel/", Version: "7.14.6"},
+			wantErr:   "empty package name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := purl.FromLibrary(tt.ecosystem, tt.lib)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    purl.PackageURL
+		wantErr string
+	}{
+		{
+			name:  "npm with scope",
+			input: "pkg:npm/%40babel/core@7.14.6",
+			want: purl.PackageURL{
+				Type:      purl.TypeNPM,
+				Namespace: "@babel",
+				Name:      "core",
+				Version:   "7.14.6",
+			},
+		},
+		{
+			name:  "npm with unescaped scope",
+			input: "pkg:npm/@babel/core@7.14.6",
+			want: purl.PackageURL{
+				Type:      purl.TypeNPM,
+				Namespace: "@babel",
+				Name:      "core",
+				Version:   "7.14.6",
+			},
+		},
+		{
+			name:  "npm with unescaped scope and no version",
+			input: "pkg:npm/@babel/core",
+			want: purl.PackageURL{
+				Type:      purl.TypeNPM,
+				Namespace: "@babel",
+				Name:      "core",
+			},
+		},
+		{
+			name:  "maven with qualifiers",
+			input: "pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?type=zip&classifier=dist",
+			want: purl.PackageURL{
+				Type:      purl.TypeMaven,
+				Namespace: "org.apache.xmlgraphics",
+				Name:      "batik-anim",
+				Version:   "1.9.1",
+				Qualifiers: purl.Qualifiers{
+					{Key: "type", Value: "zip"},
+					{Key: "classifier", Value: "dist"},
+				},
+			},
+		},
+		{
+			name:  "golang with subpath",
+			input: "pkg:golang/google.golang.org/genproto#googleapis/api/annotations",
+			want: purl.PackageURL{
+				Type:      purl.TypeGolang,
+				Namespace: "google.golang.org",
+				Name:      "genproto",
+				Subpath:   "googleapis/api/annotations",
+			},
+		},
+		{
+			name:  "upper case scheme and type",
+			input: "PKG:Gem/rails@6.1.4",
+			want: purl.PackageURL{
+				Type:    purl.TypeGem,
+				Name:    "rails",
+				Version: "6.1.4",
+			},
+		},
+		{
+			name:    "invalid scheme",
+			input:   "npm/lodash@4.17.21",
+			wantErr: "scheme must be",
+		},
+		{
+			name:    "no type",
+			input:   "pkg:lodash",
+			wantErr: "type is required",
+		},
+		{
+			name:    "no name",
+			input:   "pkg:npm/@4.17.21",
+			wantErr: "name is required",
+		},
+		{
+			name:    "invalid qualifier",
+			input:   "pkg:npm/lodash@4.17.21?foo",
+			wantErr: "invalid qualifier",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := purl.Parse(tt.input)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPackageURL_String(t *testing.T) {
+	p := purl.PackageURL{
+		Type:      purl.TypeMaven,
+		Namespace: "org.apache.xmlgraphics",
+		Name:      "batik-anim",
+		Version:   "1.9.1",
+		Qualifiers: purl.Qualifiers{
+			{Key: "type", Value: "zip"},
+			{Key: "classifier", Value: "dist"},
+			{Key: "empty", Value: ""},
+		},
+	}
+	assert.Equal(t, "pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?classifier=dist&type=zip", p.String())
+}
+
+func TestToLibrary(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		wantEcosystem types.Ecosystem
+		wantLib       types.Library
+		wantErr       string
+	}{
+		{
+			name:          "npm with scope",
+			input:         "pkg:npm/%40babel/core@7.14.6",
+			wantEcosystem: types.Npm,
+			wantLib:       types.Library{Name: "@babel/core", Version: "7.14.6"},
+		},
+		{
+			name:          "maven",
+			input:         "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1?type=jar",
+			wantEcosystem: types.Maven,
+			wantLib:       types.Library{Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1"},
+		},
+		{
+			name:          "maven with type and classifier",
+			input:         "pkg:maven/org.example/example-api@1.0.0?classifier=tests&type=test-jar",
+			wantEcosystem: types.Maven,
+			wantLib:       types.Library{Name: "org.example:example-api:test-jar:tests", Version: "1.0.0"},
+		},
+		{
+			name:          "maven with classifier",
+			input:         "pkg:maven/org.example/example-api@1.0.0?classifier=sources",
+			wantEcosystem: types.Maven,
+			wantLib:       types.Library{Name: "org.example:example-api:jar:sources", Version: "1.0.0"},
+		},
+		{
+			name:          "golang",
+			input:         "pkg:golang/github.com/aquasecurity/go-dep-parser@0.0.0-20211224170007-df43bca6b6ff",
+			wantEcosystem: types.Go,
+			wantLib:       types.Library{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.0-20211224170007-df43bca6b6ff"},
+		},
+		{
+			name:          "composer",
+			input:         "pkg:composer/laravel/framework@8.0.0",
+			wantEcosystem: types.Composer,
+			wantLib:       types.Library{Name: "laravel/framework", Version: "8.0.0"},
+		},
+		{
+			name:          "cargo with escaped version",
+			input:         "pkg:cargo/wasi@0.10.2%2Bwasi-snapshot-preview1",
+			wantEcosystem: types.Cargo,
+			wantLib:       types.Library{Name: "wasi", Version: "0.10.2+wasi-snapshot-preview1"},
+		},
+		{
+			name:    "unsupported type",
+			input:   "pkg:deb/debian/curl@7.50.3-1",
+			wantErr: "unsupported ecosystem",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotEcosystem, gotLib, err := purl.ToLibrary(tt.input)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEcosystem, gotEcosystem)
+			assert.Equal(t, tt.wantLib, gotLib)
+		})
+	}
+}
+
+func TestFromLibrary_ToLibrary(t *testing.T) {
+	tests := []struct {
+		ecosystem types.Ecosystem
+		lib       types.Library
+	}{
+		{ecosystem: types.Npm, lib: types.Library{Name: "@babel/core", Version: "7.14.6"}},
+		{ecosystem: types.PyPI, lib: types.Library{Name: "requests", Version: "2.26.0"}},
+		{ecosystem: types.Go, lib: types.Library{Name: "github.com/aquasecurity/go-dep-parser", Version: "0.0.1"}},
+		{ecosystem: types.Maven, lib: types.Library{Name: "org.example:example-api", Version: "1.0.0"}},
+		{ecosystem: types.Maven, lib: types.Library{Name: "org.example:example-api:pom", Version: "1.0.0"}},
+		{ecosystem: types.Maven, lib: types.Library{Name: "org.example:example-api:jar:sources", Version: "1.0.0"}},
+		{ecosystem: types.Maven, lib: types.Library{Name: "org.example:example-api:test-jar:tests", Version: "1.0.0"}},
+		{ecosystem: types.Composer, lib: types.Library{Name: "pear/log", Version: "1.13.1"}},
+		{ecosystem: types.Cargo, lib: types.Library{Name: "wasi", Version: "0.10.2+wasi-snapshot-preview1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lib.Name, func(t *testing.T) {
+			s, err := purl.FromLibrary(tt.ecosystem, tt.lib)
+			require.NoError(t, err)
+
+			gotEcosystem, gotLib, err := purl.ToLibrary(s)
+			require.NoError(t, err)
+			assert.Equal(t, tt.ecosystem, gotEcosystem)
+			assert.Equal(t, tt.lib, gotLib)
+		})
+	}
+}
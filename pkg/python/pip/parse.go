@@ -6,6 +6,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
 	"golang.org/x/xerrors"
 )
@@ -14,33 +15,103 @@ const (
 	commentMarker string = "#"
 	endColon      string = ";"
 	hashMarker    string = "--"
+	hashOption    string = "--hash"
+	continuation  string = `\`
 )
 
 func Parse(r io.Reader) ([]types.Library, error) {
 	scanner := bufio.NewScanner(r)
 	var libs []types.Library
+	var lineNumber, startLine int
+	var logicalLine string
 	for scanner.Scan() {
-		line := scanner.Text()
-		line = strings.ReplaceAll(line, " ", "")
-		line = strings.ReplaceAll(line, `\`, "")
-		line = rStripByKey(line, commentMarker)
-		line = rStripByKey(line, endColon)
-		line = rStripByKey(line, hashMarker)
-		s := strings.Split(line, "==")
-		if len(s) != 2 {
+		lineNumber++
+		text := strings.TrimRightFunc(scanner.Text(), unicode.IsSpace)
+
+		// A requirement can span lines ending with a backslash.
+		// e.g. FooProject == 1.2 \
+		//        --hash=sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824
+		// Comment lines are never continued as pip does.
+		if logicalLine == "" {
+			startLine = lineNumber
+		}
+		isComment := strings.HasPrefix(strings.TrimSpace(text), commentMarker)
+		if strings.HasSuffix(text, continuation) && !isComment {
+			logicalLine += strings.TrimSuffix(text, continuation) + " "
 			continue
 		}
-		libs = append(libs, types.Library{
-			Name:    s[0],
-			Version: s[1],
-		})
+		if lib, ok := parseLine(logicalLine+text, startLine, lineNumber); ok {
+			libs = append(libs, lib)
+		}
+		logicalLine = ""
 	}
 	if err := scanner.Err(); err != nil {
 		return nil, xerrors.Errorf("scan error: %w", err)
 	}
+
+	// The last line may end with a backslash.
+	if logicalLine != "" {
+		if lib, ok := parseLine(logicalLine, startLine, lineNumber); ok {
+			libs = append(libs, lib)
+		}
+	}
 	return libs, nil
 }
 
+func parseLine(line string, startLine, endLine int) (types.Library, bool) {
+	line = rStripByKey(line, commentMarker)
+	digests := parseHashes(line)
+	line = strings.ReplaceAll(line, " ", "")
+	line = strings.ReplaceAll(line, continuation, "")
+	line = rStripByKey(line, endColon)
+	line = rStripByKey(line, hashMarker)
+	s := strings.Split(line, "==")
+	if len(s) != 2 {
+		return types.Library{}, false
+	}
+	return types.Library{
+		Name:    s[0],
+		Version: s[1],
+		Locations: types.Locations{
+			{
+				StartLine: startLine,
+				EndLine:   endLine,
+			},
+		},
+		Digests: digests,
+	}, true
+}
+
+// parseHashes returns the hashes in the "--hash" options. The value can also be separated by a space.
+// e.g. --hash=sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824
+// ref. https://pip.pypa.io/en/stable/topics/secure-installs/#hash-checking-mode
+func parseHashes(line string) []types.Digest {
+	var digests []types.Digest
+	fields := strings.Fields(line)
+	for i, field := range fields {
+		switch {
+		case strings.HasPrefix(field, hashOption+"="):
+			field = strings.TrimPrefix(field, hashOption+"=")
+		case field == hashOption && i+1 < len(fields):
+			field = fields[i+1]
+		default:
+			continue
+		}
+		algorithm, value, ok := strings.Cut(field, ":")
+		if !ok {
+			log.Logger.Debugf("Invalid hash: %s", field)
+			continue
+		}
+		d, err := types.NewDigestFromHex(types.DigestAlgorithm(algorithm), value)
+		if err != nil {
+			log.Logger.Debugf("Invalid hash %s: %s", field, err)
+			continue
+		}
+		digests = append(digests, d)
+	}
+	return digests
+}
+
 func rStripByKey(line string, key string) string {
 	if pos := strings.Index(line, key); pos >= 0 {
 		line = strings.TrimRightFunc((line)[:pos], unicode.IsSpace)
@@ -4,44 +4,78 @@ import "github.com/aquasecurity/go-dep-parser/pkg/types"
 
 var (
 	requirementsFlask = []types.Library{
-		{Name: "click", Version: "8.0.0"},
-		{Name: "Flask", Version: "2.0.0"},
-		{Name: "itsdangerous", Version: "2.0.0"},
-		{Name: "Jinja2", Version: "3.0.0"},
-		{Name: "MarkupSafe", Version: "2.0.0"},
-		{Name: "Werkzeug", Version: "2.0.0"},
+		{Name: "click", Version: "8.0.0", Locations: types.Locations{{StartLine: 1, EndLine: 1}}},
+		{Name: "Flask", Version: "2.0.0", Locations: types.Locations{{StartLine: 2, EndLine: 2}}},
+		{Name: "itsdangerous", Version: "2.0.0", Locations: types.Locations{{StartLine: 3, EndLine: 3}}},
+		{Name: "Jinja2", Version: "3.0.0", Locations: types.Locations{{StartLine: 4, EndLine: 4}}},
+		{Name: "MarkupSafe", Version: "2.0.0", Locations: types.Locations{{StartLine: 5, EndLine: 5}}},
+		{Name: "Werkzeug", Version: "2.0.0", Locations: types.Locations{{StartLine: 6, EndLine: 6}}},
 	}
 
 	requirementsComments = []types.Library{
-		{Name: "click", Version: "8.0.0"},
-		{Name: "Flask", Version: "2.0.0"},
-		{Name: "Jinja2", Version: "3.0.0"},
-		{Name: "MarkupSafe", Version: "2.0.0"},
+		{Name: "click", Version: "8.0.0", Locations: types.Locations{{StartLine: 4, EndLine: 4}}},
+		{Name: "Flask", Version: "2.0.0", Locations: types.Locations{{StartLine: 5, EndLine: 5}}},
+		{Name: "Jinja2", Version: "3.0.0", Locations: types.Locations{{StartLine: 6, EndLine: 6}}},
+		{Name: "MarkupSafe", Version: "2.0.0", Locations: types.Locations{{StartLine: 7, EndLine: 7}}},
+		{Name: "requests", Version: "2.26.0", Locations: types.Locations{{StartLine: 10, EndLine: 10}}},
 	}
 
 	requirementsSpaces = []types.Library{
-		{Name: "click", Version: "8.0.0"},
-		{Name: "Flask", Version: "2.0.0"},
-		{Name: "itsdangerous", Version: "2.0.0"},
-		{Name: "Jinja2", Version: "3.0.0"},
+		{Name: "click", Version: "8.0.0", Locations: types.Locations{{StartLine: 1, EndLine: 1}}},
+		{Name: "Flask", Version: "2.0.0", Locations: types.Locations{{StartLine: 2, EndLine: 2}}},
+		{Name: "itsdangerous", Version: "2.0.0", Locations: types.Locations{{StartLine: 3, EndLine: 3}}},
+		{Name: "Jinja2", Version: "3.0.0", Locations: types.Locations{{StartLine: 5, EndLine: 5}}},
 	}
 
 	requirementsNoVersion = []types.Library{
-		{Name: "Flask", Version: "2.0.0"},
+		{Name: "Flask", Version: "2.0.0", Locations: types.Locations{{StartLine: 1, EndLine: 1}}},
 	}
 
 	requirementsOperator = []types.Library{
-		{Name: "Django", Version: "2.3.4"},
-		{Name: "SomeProject", Version: "5.4"},
+		{Name: "Django", Version: "2.3.4", Locations: types.Locations{{StartLine: 4, EndLine: 4}}},
+		{Name: "SomeProject", Version: "5.4", Locations: types.Locations{{StartLine: 5, EndLine: 5}}},
 	}
 
 	requirementsHash = []types.Library{
-		{Name: "FooProject", Version: "1.2"},
-		{Name: "Jinja2", Version: "3.0.0"},
+		{
+			Name:      "FooProject",
+			Version:   "1.2",
+			Locations: types.Locations{{StartLine: 1, EndLine: 2}},
+			Digests: []types.Digest{
+				"sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+				"sha256:486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7",
+			},
+		},
+		{
+			Name:      "Jinja2",
+			Version:   "3.0.0",
+			Locations: types.Locations{{StartLine: 4, EndLine: 6}},
+			Digests: []types.Digest{
+				"sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+				"sha256:486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7",
+			},
+		},
+		{
+			Name:      "Werkzeug",
+			Version:   "2.0.1",
+			Locations: types.Locations{{StartLine: 8, EndLine: 10}},
+			Digests: []types.Digest{
+				"sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+				"sha256:486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7",
+			},
+		},
+		{
+			Name:      "click",
+			Version:   "8.0.1",
+			Locations: types.Locations{{StartLine: 12, EndLine: 12}},
+			Digests: []types.Digest{
+				"sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+			},
+		},
 	}
 
 	requirementsHyphens = []types.Library{
-		{Name: "oauth2-client", Version: "4.0.0"},
-		{Name: "python-gitlab", Version: "2.0.0"},
+		{Name: "oauth2-client", Version: "4.0.0", Locations: types.Locations{{StartLine: 1, EndLine: 1}}},
+		{Name: "python-gitlab", Version: "2.0.0", Locations: types.Locations{{StartLine: 2, EndLine: 2}}},
 	}
 )
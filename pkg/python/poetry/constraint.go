@@ -0,0 +1,362 @@
+package poetry
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// constraint is a union of version intervals. It is used only to choose among the locked versions of a package,
+// so "!=" clauses are ignored when checking whether two constraints overlap.
+type constraint []interval
+
+type interval struct {
+	lower    bound
+	upper    bound
+	excluded []string
+}
+
+// bound is unbounded when the version is empty.
+type bound struct {
+	version   string
+	inclusive bool
+}
+
+var (
+	// e.g. ">= 1.0" => ">=1.0"
+	operatorSpaceRegexp = regexp.MustCompile(`([<>=!~^]+)\s+`)
+	// e.g. python_version >= "3.6"
+	markerRegexp = regexp.MustCompile(`^(python_version|python_full_version)\s*(<=|>=|==|!=|<|>|~=)\s*["']([^"']+)["']$`)
+
+	operators = []string{"===", "==", "!=", "~=", ">=", "<=", ">", "<", "^", "~", "="}
+)
+
+// parseConstraint parses the version constraints of Poetry and PEP 440.
+// e.g. ">=2.7,!=3.0.*", "^1.2 || ~0.9", "*"
+func parseConstraint(s string) constraint {
+	s = operatorSpaceRegexp.ReplaceAllString(s, "$1")
+
+	var c constraint
+	for _, alt := range strings.Split(s, "||") {
+		var iv interval
+		for _, clause := range strings.FieldsFunc(alt, func(r rune) bool { return r == ',' || r == ' ' }) {
+			iv.restrict(clause)
+		}
+		c = append(c, iv)
+	}
+	return c
+}
+
+// markerConstraint converts the Python versions in the environment markers to a constraint.
+// The other markers, such as sys_platform, are ignored. Markers with parentheses are not supported and match any version.
+func markerConstraint(marker string) constraint {
+	if strings.Contains(marker, "(") {
+		return parseConstraint("")
+	}
+
+	var alts []string
+	for _, or := range strings.Split(marker, " or ") {
+		var clauses []string
+		for _, and := range strings.Split(or, " and ") {
+			m := markerRegexp.FindStringSubmatch(strings.TrimSpace(and))
+			if m == nil {
+				continue
+			}
+			op, v := m[2], m[3]
+			// "python_version" has only the major and minor versions.
+			// e.g. python_version > "2.7" => >=2.8
+			if m[1] == "python_version" {
+				switch op {
+				case ">":
+					op, v = ">=", bump(v, len(releaseOf(v))-1)
+				case "<=":
+					op, v = "<", bump(v, len(releaseOf(v))-1)
+				case "==", "!=":
+					v += ".*"
+				}
+			}
+			clauses = append(clauses, op+v)
+		}
+		alts = append(alts, strings.Join(clauses, ","))
+	}
+	return parseConstraint(strings.Join(alts, "||"))
+}
+
+// contains returns true if the version satisfies any interval.
+func (c constraint) contains(v string) bool {
+	for _, iv := range c {
+		if iv.contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// intersects returns true if some version can satisfy both constraints.
+func (c constraint) intersects(other constraint) bool {
+	for _, a := range c {
+		for _, b := range other {
+			iv := a
+			iv.restrictLower(b.lower)
+			iv.restrictUpper(b.upper)
+			if !iv.empty() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (iv *interval) restrict(clause string) {
+	op := "=="
+	for _, o := range operators {
+		if strings.HasPrefix(clause, o) {
+			op = o
+			break
+		}
+	}
+	v := strings.TrimPrefix(clause, op)
+	if v == "" || v == "*" {
+		return
+	}
+
+	release := releaseOf(v)
+	switch op {
+	case ">=":
+		iv.restrictLower(bound{v, true})
+	case ">":
+		iv.restrictLower(bound{v, false})
+	case "<=":
+		iv.restrictUpper(bound{v, true})
+	case "<":
+		iv.restrictUpper(bound{v, false})
+	case "!=":
+		iv.excluded = append(iv.excluded, v)
+	case "^":
+		// e.g. ^1.2.3 => >=1.2.3,<2.0.0, ^0.2.3 => >=0.2.3,<0.3.0
+		i := 0
+		for i < len(release)-1 && release[i] == 0 {
+			i++
+		}
+		iv.restrictLower(bound{v, true})
+		iv.restrictUpper(bound{bump(v, i), false})
+	case "~":
+		// e.g. ~1.2.3 => >=1.2.3,<1.3.0, ~1 => >=1,<2
+		i := 1
+		if len(release) == 1 {
+			i = 0
+		}
+		iv.restrictLower(bound{v, true})
+		iv.restrictUpper(bound{bump(v, i), false})
+	case "~=":
+		// e.g. ~=1.4.5 => >=1.4.5,<1.5
+		i := len(release) - 2
+		if i < 0 {
+			i = 0
+		}
+		iv.restrictLower(bound{v, true})
+		iv.restrictUpper(bound{bump(v, i), false})
+	default:
+		// e.g. ==3.0.* => >=3.0,<3.1
+		if prefix := strings.TrimSuffix(v, ".*"); prefix != v {
+			iv.restrictLower(bound{prefix, true})
+			iv.restrictUpper(bound{bump(prefix, len(releaseOf(prefix))-1), false})
+			return
+		}
+		iv.restrictLower(bound{v, true})
+		iv.restrictUpper(bound{v, true})
+	}
+}
+
+func (iv *interval) restrictLower(b bound) {
+	if b.version == "" {
+		return
+	}
+	if iv.lower.version == "" {
+		iv.lower = b
+		return
+	}
+	if c := compareVersions(b.version, iv.lower.version); c > 0 || (c == 0 && !b.inclusive) {
+		iv.lower = b
+	}
+}
+
+func (iv *interval) restrictUpper(b bound) {
+	if b.version == "" {
+		return
+	}
+	if iv.upper.version == "" {
+		iv.upper = b
+		return
+	}
+	if c := compareVersions(b.version, iv.upper.version); c < 0 || (c == 0 && !b.inclusive) {
+		iv.upper = b
+	}
+}
+
+func (iv interval) empty() bool {
+	if iv.lower.version == "" || iv.upper.version == "" {
+		return false
+	}
+	c := compareVersions(iv.lower.version, iv.upper.version)
+	return c > 0 || (c == 0 && !(iv.lower.inclusive && iv.upper.inclusive))
+}
+
+func (iv interval) contains(v string) bool {
+	if iv.lower.version != "" {
+		if c := compareVersions(v, iv.lower.version); c < 0 || (c == 0 && !iv.lower.inclusive) {
+			return false
+		}
+	}
+	if iv.upper.version != "" {
+		if c := compareVersions(v, iv.upper.version); c > 0 || (c == 0 && !iv.upper.inclusive) {
+			return false
+		}
+	}
+	for _, e := range iv.excluded {
+		if prefix := strings.TrimSuffix(e, ".*"); prefix != e {
+			if hasReleasePrefix(v, prefix) {
+				return false
+			}
+		} else if compareVersions(v, e) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// compareVersions compares PEP 440 versions by the release segments and then the pre-, post- and dev-release phases.
+// Epochs and local versions are ignored.
+func compareVersions(a, b string) int {
+	ra, rb := releaseOf(a), releaseOf(b)
+	for i := 0; i < len(ra) || i < len(rb); i++ {
+		var x, y int
+		if i < len(ra) {
+			x = ra[i]
+		}
+		if i < len(rb) {
+			y = rb[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	pa, na := phaseOf(a)
+	pb, nb := phaseOf(b)
+	switch {
+	case pa != pb:
+		if pa < pb {
+			return -1
+		}
+		return 1
+	case na != nb:
+		if na < nb {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// Release phases in order
+const (
+	phaseDev = iota
+	phaseAlpha
+	phaseBeta
+	phaseRC
+	phaseFinal
+	phasePost
+)
+
+var phases = []struct {
+	prefix string
+	phase  int
+}{
+	// Longer prefixes come first.
+	{"preview", phaseRC},
+	{"alpha", phaseAlpha},
+	{"beta", phaseBeta},
+	{"post", phasePost},
+	{"pre", phaseRC},
+	{"dev", phaseDev},
+	{"rev", phasePost},
+	{"rc", phaseRC},
+	{"a", phaseAlpha},
+	{"b", phaseBeta},
+	{"c", phaseRC},
+	{"r", phasePost},
+}
+
+// phaseOf returns the release phase and its number. e.g. 1.0rc2 => phaseRC, 2
+func phaseOf(v string) (int, int) {
+	_, suffix := splitVersion(v)
+	suffix = strings.TrimLeft(strings.ToLower(suffix), ".-_")
+	for _, p := range phases {
+		if rest := strings.TrimPrefix(suffix, p.prefix); rest != suffix {
+			n, _ := strconv.Atoi(strings.TrimLeft(rest, ".-_"))
+			return p.phase, n
+		}
+	}
+	return phaseFinal, 0
+}
+
+// releaseOf returns the release segments. e.g. 1.2.3rc1 => [1, 2, 3]
+func releaseOf(v string) []int {
+	release, _ := splitVersion(v)
+	var segments []int
+	for _, s := range strings.Split(release, ".") {
+		n, _ := strconv.Atoi(s)
+		segments = append(segments, n)
+	}
+	return segments
+}
+
+// splitVersion splits the version into the release and the rest. e.g. v1.2.3rc1+local => "1.2.3", "rc1"
+func splitVersion(v string) (string, string) {
+	v = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(v)), "v")
+	if _, after, ok := strings.Cut(v, "!"); ok {
+		v = after
+	}
+	v, _, _ = strings.Cut(v, "+")
+
+	i := 0
+	for i < len(v) && (v[i] >= '0' && v[i] <= '9' || v[i] == '.' && i+1 < len(v) && v[i+1] >= '0' && v[i+1] <= '9') {
+		i++
+	}
+	return v[:i], v[i:]
+}
+
+// bump increments the release segment at the index and drops the following ones. e.g. bump("1.2.3", 1) => "1.3"
+func bump(v string, i int) string {
+	release := releaseOf(v)
+	if i >= len(release) {
+		i = len(release) - 1
+	}
+	release[i]++
+
+	var segments []string
+	for _, n := range release[:i+1] {
+		segments = append(segments, strconv.Itoa(n))
+	}
+	return strings.Join(segments, ".")
+}
+
+func hasReleasePrefix(v, prefix string) bool {
+	release, p := releaseOf(v), releaseOf(prefix)
+	for i, n := range p {
+		if i >= len(release) {
+			if n != 0 {
+				return false
+			}
+			continue
+		}
+		if release[i] != n {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package poetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_constraint_contains(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{constraint: "*", version: "1.0", want: true},
+		{constraint: ">=1.21.1,<1.25", version: "1.24.3", want: true},
+		{constraint: ">=1.21.1,<1.25", version: "1.25.2"},
+		{constraint: ">=1.21.1,<1.25.0 || >1.25.1,<1.26", version: "1.25.2", want: true},
+		{constraint: ">= 2.7, !=3.0.*", version: "3.0.1"},
+		{constraint: "^1.2.3", version: "1.9", want: true},
+		{constraint: "^1.2.3", version: "2.0.0"},
+		{constraint: "^0.2.3", version: "0.3.0"},
+		{constraint: "~1.2.3", version: "1.2.9", want: true},
+		{constraint: "~1.2.3", version: "1.3.0"},
+		{constraint: "~=2.2", version: "2.9", want: true},
+		{constraint: "~=2.2", version: "3.0"},
+		{constraint: "==3.0.*", version: "3.0.1", want: true},
+		{constraint: "1.0", version: "1.0.0", want: true},
+		{constraint: ">=1.0", version: "1.0rc1"},
+		{constraint: "<1.0", version: "1.0.dev1", want: true},
+		{constraint: ">1.0", version: "1.0.post1", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseConstraint(tt.constraint).contains(tt.version))
+		})
+	}
+}
+
+func Test_markerConstraint(t *testing.T) {
+	tests := []struct {
+		marker string
+		python string
+		want   bool
+	}{
+		{marker: `python_version <= "2.7"`, python: "<2.8", want: true},
+		{marker: `python_version <= "2.7"`, python: ">=2.8"},
+		{marker: `python_version > "2.7"`, python: "<2.8"},
+		{marker: `python_version >= "3.4" and python_version < "4.0"`, python: "^3.6", want: true},
+		{marker: `python_version < "3.0" or python_version >= "3.8"`, python: ">=3.4,<3.7"},
+		{marker: `sys_platform == "win32"`, python: "<3.0", want: true},
+		{marker: `extra == "filecache"`, python: "<3.0", want: true},
+		{marker: "", python: ">=3.4", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.marker+" "+tt.python, func(t *testing.T) {
+			assert.Equal(t, tt.want, markerConstraint(tt.marker).intersects(parseConstraint(tt.python)))
+		})
+	}
+}
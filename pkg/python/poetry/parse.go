@@ -2,10 +2,16 @@ package poetry
 
 import (
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/BurntSushi/toml"
-	"github.com/aquasecurity/go-dep-parser/pkg/types"
 	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	dtoml "github.com/aquasecurity/go-dep-parser/pkg/toml"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 type Lockfile struct {
@@ -22,18 +28,170 @@ type Lockfile struct {
 	} `toml:"package"`
 }
 
-func Parse(r io.Reader) ([]types.Library, error) {
+func Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("read error: %w", err)
+	}
+
 	var lockfile Lockfile
-	if _, err := toml.DecodeReader(r, &lockfile); err != nil {
-		return nil, xerrors.Errorf("decode error: %w", err)
+	if _, err = toml.Decode(string(data), &lockfile); err != nil {
+		return nil, nil, xerrors.Errorf("decode error: %w", err)
 	}
+	locations := dtoml.ArrayTableLocations(data, "package")
 
-	var libs []types.Library
+	// Normalized package name => locked versions
+	pkgs := map[string][]candidate{}
 	for _, pkg := range lockfile.Packages {
-		libs = append(libs, types.Library{
+		name := normalizeName(pkg.Name)
+		pkgs[name] = append(pkgs[name], candidate{
+			id:             utils.PackageID(pkg.Name, pkg.Version),
+			version:        pkg.Version,
+			marker:         markerConstraint(pkg.Marker),
+			pythonVersions: parseConstraint(pkg.PythonVersions),
+		})
+	}
+
+	var libs []types.Library
+	var deps []types.Dependency
+	for i, pkg := range lockfile.Packages {
+		lib := types.Library{
+			ID:      utils.PackageID(pkg.Name, pkg.Version),
 			Name:    pkg.Name,
 			Version: pkg.Version,
-		})
+		}
+		if i < len(locations) {
+			lib.Locations = types.Locations{locations[i]}
+		}
+		libs = append(libs, lib)
+
+		dependsOn := parseDependencies(pkg.Dependencies, pkgs)
+		if len(dependsOn) > 0 {
+			deps = append(deps, types.Dependency{
+				ID:        lib.ID,
+				DependsOn: dependsOn,
+			})
+		}
+	}
+	return libs, deps, nil
+}
+
+// candidate is a locked version of a package.
+type candidate struct {
+	id             string
+	version        string
+	marker         constraint
+	pythonVersions constraint
+}
+
+// requirement is a value under "package.dependencies".
+// e.g. ">=1.0", {version = ">=1.0", python = "<3.8"}
+type requirement struct {
+	version string
+	python  string
+	markers string
+}
+
+// parseDependencies resolves the names under "package.dependencies" to the locked packages.
+// Each value may be a version constraint, a table or an array of tables.
+// When a package is locked with several versions, the version constraint and the Python versions narrow down the candidates,
+// and the dependency is skipped if it is still ambiguous.
+func parseDependencies(dependencies interface{}, pkgs map[string][]candidate) []string {
+	m, ok := dependencies.(map[string]interface{})
+	if !ok {
+		return nil
 	}
-	return libs, nil
+
+	uniq := map[string]struct{}{}
+	for name, value := range m {
+		for _, req := range parseRequirements(value) {
+			id, ok := resolve(pkgs[normalizeName(name)], req)
+			if !ok {
+				// e.g. optional dependencies which are not installed
+				log.Logger.Debugf("Unable to resolve the dependency: %s", name)
+				continue
+			}
+			uniq[id] = struct{}{}
+		}
+	}
+
+	var dependsOn []string
+	for id := range uniq {
+		dependsOn = append(dependsOn, id)
+	}
+	sort.Strings(dependsOn)
+	return dependsOn
+}
+
+func parseRequirements(value interface{}) []requirement {
+	switch v := value.(type) {
+	case string:
+		return []requirement{{version: v}}
+	case map[string]interface{}:
+		return []requirement{parseRequirement(v)}
+	case []map[string]interface{}:
+		var reqs []requirement
+		for _, t := range v {
+			reqs = append(reqs, parseRequirement(t))
+		}
+		return reqs
+	case []interface{}:
+		var reqs []requirement
+		for _, t := range v {
+			if m, ok := t.(map[string]interface{}); ok {
+				reqs = append(reqs, parseRequirement(m))
+			}
+		}
+		return reqs
+	}
+	return []requirement{{}}
+}
+
+func parseRequirement(t map[string]interface{}) requirement {
+	var req requirement
+	req.version, _ = t["version"].(string)
+	req.python, _ = t["python"].(string)
+	req.markers, _ = t["markers"].(string)
+	return req
+}
+
+// resolve returns the only candidate satisfying the requirement.
+func resolve(candidates []candidate, req requirement) (string, bool) {
+	if len(candidates) == 1 {
+		return candidates[0].id, true
+	}
+
+	version := parseConstraint(req.version)
+	envs := []constraint{parseConstraint(req.python), markerConstraint(req.markers)}
+
+	var matched []string
+	for _, c := range candidates {
+		if !version.contains(c.version) {
+			continue
+		}
+		compatible := true
+		for _, env := range envs {
+			if !env.intersects(c.marker) || !env.intersects(c.pythonVersions) {
+				compatible = false
+				break
+			}
+		}
+		if compatible {
+			matched = append(matched, c.id)
+		}
+	}
+	if len(matched) > 1 {
+		log.Logger.Debugf("Ambiguous dependency: %s", strings.Join(matched, ", "))
+	}
+	if len(matched) != 1 {
+		return "", false
+	}
+	return matched[0], true
+}
+
+// normalizeName normalizes the package name according to PEP 503.
+// e.g. Jinja2 => jinja2, typing_extensions => typing-extensions
+func normalizeName(name string) string {
+	name = strings.ToLower(name)
+	return strings.NewReplacer("_", "-", ".", "-").Replace(name)
 }
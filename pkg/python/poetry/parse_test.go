@@ -15,20 +15,24 @@ import (
 
 func TestParse(t *testing.T) {
 	vectors := []struct {
-		file string // Test input file
-		want []types.Library
+		file     string // Test input file
+		want     []types.Library
+		wantDeps []types.Dependency
 	}{
 		{
-			file: "testdata/poetry_normal.lock",
-			want: poetryNormal,
+			file:     "testdata/poetry_normal.lock",
+			want:     poetryNormal,
+			wantDeps: poetryNormalDeps,
 		},
 		{
-			file: "testdata/poetry_many.lock",
-			want: poetryMany,
+			file:     "testdata/poetry_many.lock",
+			want:     poetryMany,
+			wantDeps: poetryManyDeps,
 		},
 		{
-			file: "testdata/poetry_flask.lock",
-			want: poetryFlask,
+			file:     "testdata/poetry_flask.lock",
+			want:     poetryFlask,
+			wantDeps: poetryFlaskDeps,
 		},
 	}
 
@@ -37,7 +41,7 @@ func TestParse(t *testing.T) {
 			f, err := os.Open(v.file)
 			require.NoError(t, err)
 
-			got, err := Parse(f)
+			got, deps, err := Parse(f)
 			require.NoError(t, err)
 
 			sort.Slice(got, func(i, j int) bool {
@@ -57,6 +61,11 @@ func TestParse(t *testing.T) {
 			})
 
 			assert.Equal(t, v.want, got)
+
+			sort.Slice(deps, func(i, j int) bool {
+				return deps[i].ID < deps[j].ID
+			})
+			assert.Equal(t, v.wantDeps, deps)
 		})
 	}
 }
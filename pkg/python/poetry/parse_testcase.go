@@ -11,15 +11,15 @@ var (
 	// poetry add pypi
 	// poetry show -a | awk '{gsub(/\(!\)/, ""); printf("{\""$1"\", \""$2"\", \"\"},\n") }'
 	poetryNormal = []types.Library{
-		{Name: "atomicwrites", Version: "1.3.0"},
-		{Name: "attrs", Version: "19.1.0"},
-		{Name: "colorama", Version: "0.4.1"},
-		{Name: "more-itertools", Version: "7.0.0"},
-		{Name: "pluggy", Version: "0.11.0"},
-		{Name: "py", Version: "1.8.0"},
-		{Name: "pypi", Version: "2.1"},
-		{Name: "pytest", Version: "3.10.1"},
-		{Name: "six", Version: "1.12.0"},
+		{ID: "atomicwrites@1.3.0", Name: "atomicwrites", Version: "1.3.0", Locations: types.Locations{{StartLine: 1, EndLine: 7}}},
+		{ID: "attrs@19.1.0", Name: "attrs", Version: "19.1.0", Locations: types.Locations{{StartLine: 9, EndLine: 15}}},
+		{ID: "colorama@0.4.1", Name: "colorama", Version: "0.4.1", Locations: types.Locations{{StartLine: 17, EndLine: 24}}},
+		{ID: "more-itertools@7.0.0", Name: "more-itertools", Version: "7.0.0", Locations: types.Locations{{StartLine: 26, EndLine: 32}}},
+		{ID: "pluggy@0.11.0", Name: "pluggy", Version: "0.11.0", Locations: types.Locations{{StartLine: 34, EndLine: 40}}},
+		{ID: "py@1.8.0", Name: "py", Version: "1.8.0", Locations: types.Locations{{StartLine: 42, EndLine: 48}}},
+		{ID: "pypi@2.1", Name: "pypi", Version: "2.1", Locations: types.Locations{{StartLine: 50, EndLine: 56}}},
+		{ID: "pytest@3.10.1", Name: "pytest", Version: "3.10.1", Locations: types.Locations{{StartLine: 58, EndLine: 74}}},
+		{ID: "six@1.12.0", Name: "six", Version: "1.12.0", Locations: types.Locations{{StartLine: 76, EndLine: 82}}},
 	}
 
 	// docker run --name pipenv --rm -it python:3.9-alpine sh
@@ -29,84 +29,84 @@ var (
 	// Use https://github.com/sdispater/poetry/blob/master/poetry.lock
 	// poetry show -a | awk '{gsub(/\(!\)/, ""); printf("{\""$1"\", \""$2"\", \"\"},\n") }'
 	poetryMany = []types.Library{
-		{Name: "appdirs", Version: "1.4.3"},
-		{Name: "aspy.yaml", Version: "1.2.0"},
-		{Name: "atomicwrites", Version: "1.3.0"},
-		{Name: "attrs", Version: "19.1.0"},
-		{Name: "black", Version: "19.3b0"},
-		{Name: "cachecontrol", Version: "0.12.5"},
-		{Name: "cachy", Version: "0.2.0"},
-		{Name: "certifi", Version: "2019.3.9"},
-		{Name: "cfgv", Version: "1.6.0"},
-		{Name: "chardet", Version: "3.0.4"},
-		{Name: "cleo", Version: "0.6.8"},
-		{Name: "click", Version: "7.0"},
-		{Name: "colorama", Version: "0.4.1"},
-		{Name: "configparser", Version: "3.7.4"},
-		{Name: "contextlib2", Version: "0.5.5"},
-		{Name: "coverage", Version: "4.5.3"},
-		{Name: "enum34", Version: "1.1.6"},
-		{Name: "filelock", Version: "3.0.10"},
-		{Name: "funcsigs", Version: "1.0.2"},
-		{Name: "functools32", Version: "3.2.3-2"},
-		{Name: "futures", Version: "3.2.0"},
-		{Name: "glob2", Version: "0.6"},
-		{Name: "html5lib", Version: "1.0.1"},
-		{Name: "httpretty", Version: "0.9.6"},
-		{Name: "identify", Version: "1.4.3"},
-		{Name: "idna", Version: "2.8"},
-		{Name: "importlib-metadata", Version: "0.12"},
-		{Name: "importlib-resources", Version: "1.0.2"},
-		{Name: "jinja2", Version: "2.10.1"},
-		{Name: "jsonschema", Version: "3.0.1"},
-		{Name: "livereload", Version: "2.6.1"},
-		{Name: "lockfile", Version: "0.12.2"},
-		{Name: "markdown", Version: "3.0.1"},
-		{Name: "markdown", Version: "3.1"},
-		{Name: "markupsafe", Version: "1.1.1"},
-		{Name: "mkdocs", Version: "1.0.4"},
-		{Name: "mock", Version: "3.0.5"},
-		{Name: "more-itertools", Version: "5.0.0"},
-		{Name: "more-itertools", Version: "7.0.0"},
-		{Name: "msgpack", Version: "0.6.1"},
-		{Name: "nodeenv", Version: "1.3.3"},
-		{Name: "packaging", Version: "19.0"},
-		{Name: "pastel", Version: "0.1.0"},
-		{Name: "pathlib2", Version: "2.3.3"},
-		{Name: "pkginfo", Version: "1.5.0.1"},
-		{Name: "pluggy", Version: "0.11.0"},
-		{Name: "pre-commit", Version: "1.16.1"},
-		{Name: "py", Version: "1.8.0"},
-		{Name: "pygments", Version: "2.3.1"},
-		{Name: "pygments", Version: "2.4.0"},
-		{Name: "pygments-github-lexers", Version: "0.0.5"},
-		{Name: "pylev", Version: "1.3.0"},
-		{Name: "pymdown-extensions", Version: "6.0"},
-		{Name: "pyparsing", Version: "2.4.0"},
-		{Name: "pyrsistent", Version: "0.14.11"},
-		{Name: "pytest", Version: "4.5.0"},
-		{Name: "pytest-cov", Version: "2.7.1"},
-		{Name: "pytest-mock", Version: "1.10.4"},
-		{Name: "pytest-sugar", Version: "0.9.2"},
-		{Name: "pyyaml", Version: "5.1"},
-		{Name: "requests", Version: "2.21.0"},
-		{Name: "requests", Version: "2.22.0"},
-		{Name: "requests-toolbelt", Version: "0.8.0"},
-		{Name: "scandir", Version: "1.10.0"},
-		{Name: "shellingham", Version: "1.3.1"},
-		{Name: "six", Version: "1.12.0"},
-		{Name: "termcolor", Version: "1.1.0"},
-		{Name: "toml", Version: "0.10.0"},
-		{Name: "tomlkit", Version: "0.5.3"},
-		{Name: "tornado", Version: "5.1.1"},
-		{Name: "tox", Version: "3.11.1"},
-		{Name: "typing", Version: "3.6.6"},
-		{Name: "urllib3", Version: "1.24.3"},
-		{Name: "urllib3", Version: "1.25.2"},
-		{Name: "virtualenv", Version: "16.6.0"},
-		{Name: "wcwidth", Version: "0.1.7"},
-		{Name: "webencodings", Version: "0.5.1"},
-		{Name: "zipp", Version: "0.5.1"},
+		{ID: "appdirs@1.4.3", Name: "appdirs", Version: "1.4.3", Locations: types.Locations{{StartLine: 1, EndLine: 8}}},
+		{ID: "aspy.yaml@1.2.0", Name: "aspy.yaml", Version: "1.2.0", Locations: types.Locations{{StartLine: 10, EndLine: 19}}},
+		{ID: "atomicwrites@1.3.0", Name: "atomicwrites", Version: "1.3.0", Locations: types.Locations{{StartLine: 21, EndLine: 27}}},
+		{ID: "attrs@19.1.0", Name: "attrs", Version: "19.1.0", Locations: types.Locations{{StartLine: 29, EndLine: 35}}},
+		{ID: "black@19.3b0", Name: "black", Version: "19.3b0", Locations: types.Locations{{StartLine: 37, EndLine: 50}}},
+		{ID: "cachecontrol@0.12.5", Name: "cachecontrol", Version: "0.12.5", Locations: types.Locations{{StartLine: 52, EndLine: 63}}},
+		{ID: "cachy@0.2.0", Name: "cachy", Version: "0.2.0", Locations: types.Locations{{StartLine: 65, EndLine: 71}}},
+		{ID: "certifi@2019.3.9", Name: "certifi", Version: "2019.3.9", Locations: types.Locations{{StartLine: 73, EndLine: 79}}},
+		{ID: "cfgv@1.6.0", Name: "cfgv", Version: "1.6.0", Locations: types.Locations{{StartLine: 81, EndLine: 90}}},
+		{ID: "chardet@3.0.4", Name: "chardet", Version: "3.0.4", Locations: types.Locations{{StartLine: 92, EndLine: 98}}},
+		{ID: "cleo@0.6.8", Name: "cleo", Version: "0.6.8", Locations: types.Locations{{StartLine: 100, EndLine: 110}}},
+		{ID: "click@7.0", Name: "click", Version: "7.0", Locations: types.Locations{{StartLine: 112, EndLine: 119}}},
+		{ID: "colorama@0.4.1", Name: "colorama", Version: "0.4.1", Locations: types.Locations{{StartLine: 121, EndLine: 128}}},
+		{ID: "configparser@3.7.4", Name: "configparser", Version: "3.7.4", Locations: types.Locations{{StartLine: 130, EndLine: 137}}},
+		{ID: "contextlib2@0.5.5", Name: "contextlib2", Version: "0.5.5", Locations: types.Locations{{StartLine: 139, EndLine: 146}}},
+		{ID: "coverage@4.5.3", Name: "coverage", Version: "4.5.3", Locations: types.Locations{{StartLine: 148, EndLine: 154}}},
+		{ID: "enum34@1.1.6", Name: "enum34", Version: "1.1.6", Locations: types.Locations{{StartLine: 156, EndLine: 163}}},
+		{ID: "filelock@3.0.10", Name: "filelock", Version: "3.0.10", Locations: types.Locations{{StartLine: 165, EndLine: 171}}},
+		{ID: "funcsigs@1.0.2", Name: "funcsigs", Version: "1.0.2", Locations: types.Locations{{StartLine: 173, EndLine: 180}}},
+		{ID: "functools32@3.2.3-2", Name: "functools32", Version: "3.2.3-2", Locations: types.Locations{{StartLine: 182, EndLine: 189}}},
+		{ID: "futures@3.2.0", Name: "futures", Version: "3.2.0", Locations: types.Locations{{StartLine: 191, EndLine: 198}}},
+		{ID: "glob2@0.6", Name: "glob2", Version: "0.6", Locations: types.Locations{{StartLine: 200, EndLine: 207}}},
+		{ID: "html5lib@1.0.1", Name: "html5lib", Version: "1.0.1", Locations: types.Locations{{StartLine: 209, EndLine: 219}}},
+		{ID: "httpretty@0.9.6", Name: "httpretty", Version: "0.9.6", Locations: types.Locations{{StartLine: 221, EndLine: 230}}},
+		{ID: "identify@1.4.3", Name: "identify", Version: "1.4.3", Locations: types.Locations{{StartLine: 232, EndLine: 238}}},
+		{ID: "idna@2.8", Name: "idna", Version: "2.8", Locations: types.Locations{{StartLine: 240, EndLine: 246}}},
+		{ID: "importlib-metadata@0.12", Name: "importlib-metadata", Version: "0.12", Locations: types.Locations{{StartLine: 248, EndLine: 265}}},
+		{ID: "importlib-resources@1.0.2", Name: "importlib-resources", Version: "1.0.2", Locations: types.Locations{{StartLine: 267, EndLine: 283}}},
+		{ID: "jinja2@2.10.1", Name: "jinja2", Version: "2.10.1", Locations: types.Locations{{StartLine: 285, EndLine: 295}}},
+		{ID: "jsonschema@3.0.1", Name: "jsonschema", Version: "3.0.1", Locations: types.Locations{{StartLine: 297, EndLine: 313}}},
+		{ID: "livereload@2.6.1", Name: "livereload", Version: "2.6.1", Locations: types.Locations{{StartLine: 315, EndLine: 326}}},
+		{ID: "lockfile@0.12.2", Name: "lockfile", Version: "0.12.2", Locations: types.Locations{{StartLine: 328, EndLine: 335}}},
+		{ID: "markdown@3.0.1", Name: "markdown", Version: "3.0.1", Locations: types.Locations{{StartLine: 337, EndLine: 343}}},
+		{ID: "markdown@3.1", Name: "markdown", Version: "3.1", Locations: types.Locations{{StartLine: 345, EndLine: 354}}},
+		{ID: "markupsafe@1.1.1", Name: "markupsafe", Version: "1.1.1", Locations: types.Locations{{StartLine: 356, EndLine: 363}}},
+		{ID: "mkdocs@1.0.4", Name: "mkdocs", Version: "1.0.4", Locations: types.Locations{{StartLine: 365, EndLine: 380}}},
+		{ID: "mock@3.0.5", Name: "mock", Version: "3.0.5", Locations: types.Locations{{StartLine: 382, EndLine: 396}}},
+		{ID: "more-itertools@5.0.0", Name: "more-itertools", Version: "5.0.0", Locations: types.Locations{{StartLine: 398, EndLine: 408}}},
+		{ID: "more-itertools@7.0.0", Name: "more-itertools", Version: "7.0.0", Locations: types.Locations{{StartLine: 410, EndLine: 417}}},
+		{ID: "msgpack@0.6.1", Name: "msgpack", Version: "0.6.1", Locations: types.Locations{{StartLine: 419, EndLine: 425}}},
+		{ID: "nodeenv@1.3.3", Name: "nodeenv", Version: "1.3.3", Locations: types.Locations{{StartLine: 427, EndLine: 433}}},
+		{ID: "packaging@19.0", Name: "packaging", Version: "19.0", Locations: types.Locations{{StartLine: 435, EndLine: 445}}},
+		{ID: "pastel@0.1.0", Name: "pastel", Version: "0.1.0", Locations: types.Locations{{StartLine: 447, EndLine: 453}}},
+		{ID: "pathlib2@2.3.3", Name: "pathlib2", Version: "2.3.3", Locations: types.Locations{{StartLine: 455, EndLine: 469}}},
+		{ID: "pkginfo@1.5.0.1", Name: "pkginfo", Version: "1.5.0.1", Locations: types.Locations{{StartLine: 471, EndLine: 477}}},
+		{ID: "pluggy@0.11.0", Name: "pluggy", Version: "0.11.0", Locations: types.Locations{{StartLine: 479, EndLine: 485}}},
+		{ID: "pre-commit@1.16.1", Name: "pre-commit", Version: "1.16.1", Locations: types.Locations{{StartLine: 487, EndLine: 512}}},
+		{ID: "py@1.8.0", Name: "py", Version: "1.8.0", Locations: types.Locations{{StartLine: 514, EndLine: 520}}},
+		{ID: "pygments@2.3.1", Name: "pygments", Version: "2.3.1", Locations: types.Locations{{StartLine: 522, EndLine: 528}}},
+		{ID: "pygments@2.4.0", Name: "pygments", Version: "2.4.0", Locations: types.Locations{{StartLine: 530, EndLine: 536}}},
+		{ID: "pygments-github-lexers@0.0.5", Name: "pygments-github-lexers", Version: "0.0.5", Locations: types.Locations{{StartLine: 538, EndLine: 547}}},
+		{ID: "pylev@1.3.0", Name: "pylev", Version: "1.3.0", Locations: types.Locations{{StartLine: 549, EndLine: 555}}},
+		{ID: "pymdown-extensions@6.0", Name: "pymdown-extensions", Version: "6.0", Locations: types.Locations{{StartLine: 557, EndLine: 566}}},
+		{ID: "pyparsing@2.4.0", Name: "pyparsing", Version: "2.4.0", Locations: types.Locations{{StartLine: 568, EndLine: 574}}},
+		{ID: "pyrsistent@0.14.11", Name: "pyrsistent", Version: "0.14.11", Locations: types.Locations{{StartLine: 576, EndLine: 585}}},
+		{ID: "pytest@4.5.0", Name: "pytest", Version: "4.5.0", Locations: types.Locations{{StartLine: 587, EndLine: 619}}},
+		{ID: "pytest-cov@2.7.1", Name: "pytest-cov", Version: "2.7.1", Locations: types.Locations{{StartLine: 621, EndLine: 631}}},
+		{ID: "pytest-mock@1.10.4", Name: "pytest-mock", Version: "1.10.4", Locations: types.Locations{{StartLine: 633, EndLine: 646}}},
+		{ID: "pytest-sugar@0.9.2", Name: "pytest-sugar", Version: "0.9.2", Locations: types.Locations{{StartLine: 648, EndLine: 659}}},
+		{ID: "pyyaml@5.1", Name: "pyyaml", Version: "5.1", Locations: types.Locations{{StartLine: 661, EndLine: 667}}},
+		{ID: "requests@2.21.0", Name: "requests", Version: "2.21.0", Locations: types.Locations{{StartLine: 669, EndLine: 681}}},
+		{ID: "requests@2.22.0", Name: "requests", Version: "2.22.0", Locations: types.Locations{{StartLine: 683, EndLine: 695}}},
+		{ID: "requests-toolbelt@0.8.0", Name: "requests-toolbelt", Version: "0.8.0", Locations: types.Locations{{StartLine: 697, EndLine: 706}}},
+		{ID: "scandir@1.10.0", Name: "scandir", Version: "1.10.0", Locations: types.Locations{{StartLine: 708, EndLine: 715}}},
+		{ID: "shellingham@1.3.1", Name: "shellingham", Version: "1.3.1", Locations: types.Locations{{StartLine: 717, EndLine: 723}}},
+		{ID: "six@1.12.0", Name: "six", Version: "1.12.0", Locations: types.Locations{{StartLine: 725, EndLine: 731}}},
+		{ID: "termcolor@1.1.0", Name: "termcolor", Version: "1.1.0", Locations: types.Locations{{StartLine: 733, EndLine: 739}}},
+		{ID: "toml@0.10.0", Name: "toml", Version: "0.10.0", Locations: types.Locations{{StartLine: 741, EndLine: 747}}},
+		{ID: "tomlkit@0.5.3", Name: "tomlkit", Version: "0.5.3", Locations: types.Locations{{StartLine: 749, EndLine: 768}}},
+		{ID: "tornado@5.1.1", Name: "tornado", Version: "5.1.1", Locations: types.Locations{{StartLine: 770, EndLine: 777}}},
+		{ID: "tox@3.11.1", Name: "tox", Version: "3.11.1", Locations: types.Locations{{StartLine: 779, EndLine: 794}}},
+		{ID: "typing@3.6.6", Name: "typing", Version: "3.6.6", Locations: types.Locations{{StartLine: 796, EndLine: 803}}},
+		{ID: "urllib3@1.24.3", Name: "urllib3", Version: "1.24.3", Locations: types.Locations{{StartLine: 805, EndLine: 811}}},
+		{ID: "urllib3@1.25.2", Name: "urllib3", Version: "1.25.2", Locations: types.Locations{{StartLine: 813, EndLine: 819}}},
+		{ID: "virtualenv@16.6.0", Name: "virtualenv", Version: "16.6.0", Locations: types.Locations{{StartLine: 821, EndLine: 827}}},
+		{ID: "wcwidth@0.1.7", Name: "wcwidth", Version: "0.1.7", Locations: types.Locations{{StartLine: 829, EndLine: 835}}},
+		{ID: "webencodings@0.5.1", Name: "webencodings", Version: "0.5.1", Locations: types.Locations{{StartLine: 837, EndLine: 843}}},
+		{ID: "zipp@0.5.1", Name: "zipp", Version: "0.5.1", Locations: types.Locations{{StartLine: 845, EndLine: 851}}},
 	}
 
 	// docker run --name pipenv --rm -it python:3.9-alpine sh
@@ -117,19 +117,140 @@ var (
 	// poetry add flask
 	// poetry show -a | awk '{gsub(/\(!\)/, ""); printf("{\""$1"\", \""$2"\", \"\"},\n") }'
 	poetryFlask = []types.Library{
-		{Name: "atomicwrites", Version: "1.3.0"},
-		{Name: "attrs", Version: "19.1.0"},
-		{Name: "click", Version: "7.0"},
-		{Name: "colorama", Version: "0.4.1"},
-		{Name: "flask", Version: "1.0.3"},
-		{Name: "itsdangerous", Version: "1.1.0"},
-		{Name: "jinja2", Version: "2.10.1"},
-		{Name: "markupsafe", Version: "1.1.1"},
-		{Name: "more-itertools", Version: "7.0.0"},
-		{Name: "pluggy", Version: "0.11.0"},
-		{Name: "py", Version: "1.8.0"},
-		{Name: "pytest", Version: "3.10.1"},
-		{Name: "six", Version: "1.12.0"},
-		{Name: "werkzeug", Version: "0.15.4"},
+		{ID: "atomicwrites@1.3.0", Name: "atomicwrites", Version: "1.3.0", Locations: types.Locations{{StartLine: 1, EndLine: 7}}},
+		{ID: "attrs@19.1.0", Name: "attrs", Version: "19.1.0", Locations: types.Locations{{StartLine: 9, EndLine: 15}}},
+		{ID: "click@7.0", Name: "click", Version: "7.0", Locations: types.Locations{{StartLine: 17, EndLine: 23}}},
+		{ID: "colorama@0.4.1", Name: "colorama", Version: "0.4.1", Locations: types.Locations{{StartLine: 25, EndLine: 32}}},
+		{ID: "flask@1.0.3", Name: "flask", Version: "1.0.3", Locations: types.Locations{{StartLine: 34, EndLine: 46}}},
+		{ID: "itsdangerous@1.1.0", Name: "itsdangerous", Version: "1.1.0", Locations: types.Locations{{StartLine: 48, EndLine: 54}}},
+		{ID: "jinja2@2.10.1", Name: "jinja2", Version: "2.10.1", Locations: types.Locations{{StartLine: 56, EndLine: 65}}},
+		{ID: "markupsafe@1.1.1", Name: "markupsafe", Version: "1.1.1", Locations: types.Locations{{StartLine: 67, EndLine: 73}}},
+		{ID: "more-itertools@7.0.0", Name: "more-itertools", Version: "7.0.0", Locations: types.Locations{{StartLine: 75, EndLine: 81}}},
+		{ID: "pluggy@0.11.0", Name: "pluggy", Version: "0.11.0", Locations: types.Locations{{StartLine: 83, EndLine: 89}}},
+		{ID: "py@1.8.0", Name: "py", Version: "1.8.0", Locations: types.Locations{{StartLine: 91, EndLine: 97}}},
+		{ID: "pytest@3.10.1", Name: "pytest", Version: "3.10.1", Locations: types.Locations{{StartLine: 99, EndLine: 115}}},
+		{ID: "six@1.12.0", Name: "six", Version: "1.12.0", Locations: types.Locations{{StartLine: 117, EndLine: 123}}},
+		{ID: "werkzeug@0.15.4", Name: "werkzeug", Version: "0.15.4", Locations: types.Locations{{StartLine: 125, EndLine: 131}}},
+	}
+
+	poetryNormalDeps = []types.Dependency{
+		{
+			ID: "pytest@3.10.1",
+			DependsOn: []string{
+				"atomicwrites@1.3.0",
+				"attrs@19.1.0",
+				"colorama@0.4.1",
+				"more-itertools@7.0.0",
+				"pluggy@0.11.0",
+				"py@1.8.0",
+				"six@1.12.0",
+			},
+		},
+	}
+
+	// The dependencies matching several locked versions are skipped, e.g. "requests = *" of cachecontrol.
+	// pytest depends on both versions of more-itertools under different Python versions.
+	poetryManyDeps = []types.Dependency{
+		{ID: "aspy.yaml@1.2.0", DependsOn: []string{"pyyaml@5.1"}},
+		{ID: "black@19.3b0", DependsOn: []string{"appdirs@1.4.3", "attrs@19.1.0", "click@7.0", "toml@0.10.0"}},
+		{ID: "cachecontrol@0.12.5", DependsOn: []string{"lockfile@0.12.2", "msgpack@0.6.1"}},
+		{ID: "cfgv@1.6.0", DependsOn: []string{"six@1.12.0"}},
+		{ID: "cleo@0.6.8", DependsOn: []string{"pastel@0.1.0", "pylev@1.3.0"}},
+		{ID: "html5lib@1.0.1", DependsOn: []string{"six@1.12.0", "webencodings@0.5.1"}},
+		{ID: "httpretty@0.9.6", DependsOn: []string{"six@1.12.0"}},
+		{ID: "importlib-metadata@0.12", DependsOn: []string{"configparser@3.7.4", "contextlib2@0.5.5", "zipp@0.5.1"}},
+		{ID: "importlib-resources@1.0.2", DependsOn: []string{"pathlib2@2.3.3", "typing@3.6.6"}},
+		{ID: "jinja2@2.10.1", DependsOn: []string{"markupsafe@1.1.1"}},
+		{
+			ID: "jsonschema@3.0.1",
+			DependsOn: []string{
+				"attrs@19.1.0",
+				"functools32@3.2.3-2",
+				"pyrsistent@0.14.11",
+				"six@1.12.0",
+			},
+		},
+		{ID: "livereload@2.6.1", DependsOn: []string{"six@1.12.0", "tornado@5.1.1"}},
+		{
+			ID: "mkdocs@1.0.4",
+			DependsOn: []string{
+				"click@7.0",
+				"jinja2@2.10.1",
+				"livereload@2.6.1",
+				"pyyaml@5.1",
+				"tornado@5.1.1",
+			},
+		},
+		{ID: "mock@3.0.5", DependsOn: []string{"funcsigs@1.0.2", "six@1.12.0"}},
+		{ID: "more-itertools@5.0.0", DependsOn: []string{"six@1.12.0"}},
+		{ID: "packaging@19.0", DependsOn: []string{"pyparsing@2.4.0", "six@1.12.0"}},
+		{ID: "pathlib2@2.3.3", DependsOn: []string{"scandir@1.10.0", "six@1.12.0"}},
+		{
+			ID: "pre-commit@1.16.1",
+			DependsOn: []string{
+				"aspy.yaml@1.2.0",
+				"cfgv@1.6.0",
+				"futures@3.2.0",
+				"identify@1.4.3",
+				"importlib-metadata@0.12",
+				"importlib-resources@1.0.2",
+				"nodeenv@1.3.3",
+				"pyyaml@5.1",
+				"six@1.12.0",
+				"toml@0.10.0",
+				"virtualenv@16.6.0",
+			},
+		},
+		{ID: "pyrsistent@0.14.11", DependsOn: []string{"six@1.12.0"}},
+		{ID: "pytest-cov@2.7.1", DependsOn: []string{"coverage@4.5.3", "pytest@4.5.0"}},
+		{ID: "pytest-mock@1.10.4", DependsOn: []string{"mock@3.0.5", "pytest@4.5.0"}},
+		{ID: "pytest-sugar@0.9.2", DependsOn: []string{"packaging@19.0", "pytest@4.5.0", "termcolor@1.1.0"}},
+		{
+			ID: "pytest@4.5.0",
+			DependsOn: []string{
+				"atomicwrites@1.3.0",
+				"attrs@19.1.0",
+				"colorama@0.4.1",
+				"funcsigs@1.0.2",
+				"more-itertools@5.0.0",
+				"more-itertools@7.0.0",
+				"pathlib2@2.3.3",
+				"pluggy@0.11.0",
+				"py@1.8.0",
+				"six@1.12.0",
+				"wcwidth@0.1.7",
+			},
+		},
+		{ID: "requests@2.21.0", DependsOn: []string{"certifi@2019.3.9", "chardet@3.0.4", "idna@2.8", "urllib3@1.24.3"}},
+		{ID: "requests@2.22.0", DependsOn: []string{"certifi@2019.3.9", "chardet@3.0.4", "idna@2.8"}},
+		{ID: "tomlkit@0.5.3", DependsOn: []string{"enum34@1.1.6", "functools32@3.2.3-2", "typing@3.6.6"}},
+		{
+			ID: "tox@3.11.1",
+			DependsOn: []string{
+				"filelock@3.0.10",
+				"pluggy@0.11.0",
+				"py@1.8.0",
+				"six@1.12.0",
+				"toml@0.10.0",
+				"virtualenv@16.6.0",
+			},
+		},
+	}
+
+	poetryFlaskDeps = []types.Dependency{
+		{ID: "flask@1.0.3", DependsOn: []string{"click@7.0", "itsdangerous@1.1.0", "jinja2@2.10.1", "werkzeug@0.15.4"}},
+		{ID: "jinja2@2.10.1", DependsOn: []string{"markupsafe@1.1.1"}},
+		{
+			ID: "pytest@3.10.1",
+			DependsOn: []string{
+				"atomicwrites@1.3.0",
+				"attrs@19.1.0",
+				"colorama@0.4.1",
+				"more-itertools@7.0.0",
+				"pluggy@0.11.0",
+				"py@1.8.0",
+				"six@1.12.0",
+			},
+		},
 	}
 )
@@ -0,0 +1,472 @@
+package registry
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/frameworks/wordpress"
+	"github.com/aquasecurity/go-dep-parser/pkg/golang/binary"
+	"github.com/aquasecurity/go-dep-parser/pkg/golang/mod"
+	"github.com/aquasecurity/go-dep-parser/pkg/golang/sum"
+	"github.com/aquasecurity/go-dep-parser/pkg/golang/vendor"
+	"github.com/aquasecurity/go-dep-parser/pkg/golang/work"
+	dio "github.com/aquasecurity/go-dep-parser/pkg/io"
+	"github.com/aquasecurity/go-dep-parser/pkg/java/jar"
+	"github.com/aquasecurity/go-dep-parser/pkg/java/pom"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/npm"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/packagejson"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/pnpm"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/yarn"
+	"github.com/aquasecurity/go-dep-parser/pkg/nuget/config"
+	"github.com/aquasecurity/go-dep-parser/pkg/nuget/lock"
+	"github.com/aquasecurity/go-dep-parser/pkg/php/composer"
+	"github.com/aquasecurity/go-dep-parser/pkg/python/packaging"
+	"github.com/aquasecurity/go-dep-parser/pkg/python/pip"
+	"github.com/aquasecurity/go-dep-parser/pkg/python/pipenv"
+	"github.com/aquasecurity/go-dep-parser/pkg/python/poetry"
+	"github.com/aquasecurity/go-dep-parser/pkg/ruby/bundler"
+	"github.com/aquasecurity/go-dep-parser/pkg/ruby/gemspec"
+	"github.com/aquasecurity/go-dep-parser/pkg/rust/cargo"
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/cyclonedx"
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/spdx"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+var ErrUnsupportedFile = xerrors.New("unsupported file")
+
+// FileType identifies the format of a dependency file.
+type FileType string
+
+const (
+	Npm         FileType = "npm"
+	Yarn        FileType = "yarn"
+	Pnpm        FileType = "pnpm"
+	PackageJSON FileType = "packagejson"
+	Pip         FileType = "pip"
+	Pipenv      FileType = "pipenv"
+	Poetry      FileType = "poetry"
+	Packaging   FileType = "packaging"
+	Bundler     FileType = "bundler"
+	Gemspec     FileType = "gemspec"
+	Cargo       FileType = "cargo"
+	Composer    FileType = "composer"
+	GoMod       FileType = "gomod"
+	GoSum       FileType = "gosum"
+	GoWork      FileType = "gowork"
+	GoVendor    FileType = "govendor"
+	GoBinary    FileType = "gobinary"
+	Jar         FileType = "jar"
+	Pom         FileType = "pom"
+	NuGetLock   FileType = "nuget-lock"
+	NuGetConfig FileType = "nuget-config"
+	WordPress   FileType = "wordpress"
+
+	CycloneDXJSON FileType = "cyclonedx-json"
+	CycloneDXXML  FileType = "cyclonedx-xml"
+	SPDXJSON      FileType = "spdx-json"
+	SPDXTagValue  FileType = "spdx-tv"
+)
+
+// Entry associates file name patterns with a parser.
+type Entry struct {
+	Type FileType
+
+	// Ecosystem is empty for SBOMs since they may contain libraries of several ecosystems.
+	// Their libraries have types.Library.Ecosystem instead.
+	Ecosystem types.Ecosystem
+
+	// Patterns are matched against the trailing elements of the slash-separated path
+	// with path.Match, so both base names and globs are supported.
+	// e.g. "yarn.lock", "*.jar" and "wp-includes/version.php"
+	// Entries without patterns are never detected by name. e.g. Go binaries
+	Patterns []string
+
+	// NewParser returns a parser for the file. The path is required by some parsers
+	// in order to resolve related files. e.g. parent POMs
+	NewParser func(filePath string, opts Options) types.Parser
+}
+
+// Options are passed to parsers which support them.
+type Options struct {
+	// Offline disables network access. e.g. Maven Central
+	Offline bool
+
+	// FS is the file system containing the file. Related files such as go.sum and parent POMs
+	// are read from it, and the file path is relative to its root. The local disk is used if nil.
+	FS fs.FS
+}
+
+type Option func(*Options)
+
+func WithOffline(offline bool) Option {
+	return func(opts *Options) {
+		opts.Offline = offline
+	}
+}
+
+// WithFS reads related files from the file system. The file path passed to Parse must be relative to its root.
+func WithFS(fsys fs.FS) Option {
+	return func(opts *Options) {
+		opts.FS = fsys
+	}
+}
+
+// open opens a file related to the parsed file. The name is slash-separated and relative to
+// the directory of the file. e.g. "go.sum" and "../go.mod"
+func (o Options) open(filePath, name string) (fs.File, error) {
+	if o.FS != nil {
+		return o.FS.Open(path.Join(path.Dir(filepath.ToSlash(filePath)), name))
+	}
+	return os.Open(filepath.Join(filepath.Dir(filePath), filepath.FromSlash(name)))
+}
+
+// rootFS returns the file system containing the parsed file and the slash-separated directory of the file in it.
+// The root of the local disk is used so that related files in the parent directories are accessible.
+func (o Options) rootFS(filePath string) (fs.FS, string, error) {
+	if o.FS != nil {
+		return o.FS, path.Dir(filepath.ToSlash(filePath)), nil
+	}
+	dir, err := filepath.Abs(filepath.Dir(filePath))
+	if err != nil {
+		return nil, "", xerrors.Errorf("absolute path error: %w", err)
+	}
+	// e.g. `C:\work` => `C:\` and "work"
+	volume := filepath.VolumeName(dir)
+	dir = strings.TrimPrefix(filepath.ToSlash(dir[len(volume):]), "/")
+	if dir == "" {
+		dir = "."
+	}
+	return os.DirFS(volume + string(filepath.Separator)), dir, nil
+}
+
+// ParserFunc is an adapter to allow the use of ordinary functions as parsers.
+type ParserFunc func(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error)
+
+// Parse calls f(r).
+func (f ParserFunc) Parse(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+	return f(r)
+}
+
+var (
+	mu      sync.RWMutex
+	entries = defaultEntries()
+)
+
+// Register adds an entry. Registered entries take precedence over the existing ones.
+func Register(e Entry) {
+	mu.Lock()
+	defer mu.Unlock()
+	entries = append([]Entry{e}, entries...)
+}
+
+// Entries returns all the registered entries.
+func Entries() []Entry {
+	mu.RLock()
+	defer mu.RUnlock()
+	return append([]Entry{}, entries...)
+}
+
+// Lookup returns the entry matching the file path.
+func Lookup(filePath string) (Entry, bool) {
+	filePath = filepath.ToSlash(filepath.Clean(filePath))
+	for _, e := range Entries() {
+		for _, pattern := range e.Patterns {
+			if match(pattern, filePath) {
+				return e, true
+			}
+		}
+	}
+	return Entry{}, false
+}
+
+// Get returns the entry of the file type.
+func Get(t FileType) (Entry, bool) {
+	for _, e := range Entries() {
+		if e.Type == t {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Parse detects the file type from the path and parses the file.
+func Parse(filePath string, r dio.ReadSeekerAt, opts ...Option) ([]types.Library, []types.Dependency, error) {
+	e, ok := Lookup(filePath)
+	if !ok {
+		return nil, nil, xerrors.Errorf("%s: %w", filePath, ErrUnsupportedFile)
+	}
+
+	var o Options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	libs, deps, err := e.NewParser(filePath, o).Parse(r)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("%s parse error: %w", e.Type, err)
+	}
+	return libs, deps, nil
+}
+
+// match reports whether the last elements of the path match the pattern.
+// e.g. "wp-includes/version.php" matches "wordpress/wp-includes/version.php"
+func match(pattern, filePath string) bool {
+	n := strings.Count(pattern, "/") + 1
+	elems := strings.Split(filePath, "/")
+	if len(elems) < n {
+		return false
+	}
+	matched, err := path.Match(pattern, strings.Join(elems[len(elems)-n:], "/"))
+	return err == nil && matched
+}
+
+func defaultEntries() []Entry {
+	return []Entry{
+		{
+			Type:      Npm,
+			Ecosystem: types.Npm,
+			Patterns:  []string{"package-lock.json", "npm-shrinkwrap.json"},
+			NewParser: graphParser(npm.Parse),
+		},
+		{
+			Type:      Yarn,
+			Ecosystem: types.Npm,
+			Patterns:  []string{"yarn.lock"},
+			NewParser: libraryParser(yarn.Parse),
+		},
+		{
+			Type:      Pnpm,
+			Ecosystem: types.Npm,
+			Patterns:  []string{"pnpm-lock.yaml"},
+			NewParser: graphParser(pnpm.Parse),
+		},
+		{
+			Type:      PackageJSON,
+			Ecosystem: types.Npm,
+			Patterns:  []string{"package.json"},
+			NewParser: singleLibraryParser(packagejson.Parse),
+		},
+		{
+			Type:      Pip,
+			Ecosystem: types.PyPI,
+			Patterns:  []string{"requirements.txt"},
+			NewParser: libraryParser(pip.Parse),
+		},
+		{
+			Type:      Pipenv,
+			Ecosystem: types.PyPI,
+			Patterns:  []string{"Pipfile.lock"},
+			NewParser: libraryParser(pipenv.Parse),
+		},
+		{
+			Type:      Poetry,
+			Ecosystem: types.PyPI,
+			Patterns:  []string{"poetry.lock"},
+			NewParser: graphParser(poetry.Parse),
+		},
+		{
+			Type:      Packaging,
+			Ecosystem: types.PyPI,
+			Patterns:  []string{"*.egg-info", "*.egg-info/PKG-INFO", "EGG-INFO/PKG-INFO", "*.dist-info/METADATA"},
+			NewParser: singleLibraryParser(packaging.Parse),
+		},
+		{
+			Type:      Bundler,
+			Ecosystem: types.RubyGems,
+			Patterns:  []string{"Gemfile.lock"},
+			NewParser: graphParser(bundler.Parse),
+		},
+		{
+			Type:      Gemspec,
+			Ecosystem: types.RubyGems,
+			Patterns:  []string{"specifications/*.gemspec"},
+			NewParser: singleLibraryParser(gemspec.Parse),
+		},
+		{
+			Type:      Cargo,
+			Ecosystem: types.Cargo,
+			Patterns:  []string{"Cargo.lock"},
+			NewParser: graphParser(cargo.Parse),
+		},
+		{
+			Type:      Composer,
+			Ecosystem: types.Composer,
+			Patterns:  []string{"composer.lock"},
+			NewParser: libraryParser(composer.Parse),
+		},
+		{
+			Type:      GoMod,
+			Ecosystem: types.Go,
+			Patterns:  []string{"go.mod"},
+			NewParser: func(filePath string, o Options) types.Parser {
+				return ParserFunc(func(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+					var opts []mod.Option
+					// go.sum is optional.
+					if f, err := o.open(filePath, "go.sum"); err == nil {
+						defer f.Close()
+						opts = append(opts, mod.WithGoSum(f))
+					}
+					libs, err := mod.Parse(r, opts...)
+					return libs, nil, err
+				})
+			},
+		},
+		{
+			Type:      GoSum,
+			Ecosystem: types.Go,
+			Patterns:  []string{"go.sum"},
+			NewParser: libraryParser(sum.Parse),
+		},
+		{
+			Type:      GoWork,
+			Ecosystem: types.Go,
+			Patterns:  []string{"go.work"},
+			NewParser: func(filePath string, o Options) types.Parser {
+				return ParserFunc(func(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+					fsys, dir, err := o.rootFS(filePath)
+					if err != nil {
+						return nil, nil, xerrors.Errorf("file system error: %w", err)
+					}
+					modules, err := work.Parse(r, fsys, dir)
+					if err != nil {
+						return nil, nil, err
+					}
+					return work.Libraries(modules), nil, nil
+				})
+			},
+		},
+		{
+			Type:      GoVendor,
+			Ecosystem: types.Go,
+			Patterns:  []string{"vendor/modules.txt"},
+			NewParser: func(filePath string, o Options) types.Parser {
+				return ParserFunc(func(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+					var opts []vendor.Option
+					// go.mod is optional.
+					if f, err := o.open(filePath, "../go.mod"); err == nil {
+						defer f.Close()
+						opts = append(opts, vendor.WithGoMod(f))
+					}
+					libs, err := vendor.Parse(r, opts...)
+					return libs, nil, err
+				})
+			},
+		},
+		{
+			Type:      GoBinary,
+			Ecosystem: types.Go,
+			NewParser: func(string, Options) types.Parser {
+				return ParserFunc(func(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+					libs, err := binary.Parse(r)
+					return libs, nil, err
+				})
+			},
+		},
+		{
+			Type:      Jar,
+			Ecosystem: types.Maven,
+			Patterns:  []string{"*.jar", "*.war", "*.ear", "*.par"},
+			NewParser: func(filePath string, opts Options) types.Parser {
+				return ParserFunc(func(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+					size, err := r.Seek(0, io.SeekEnd)
+					if err != nil {
+						return nil, nil, xerrors.Errorf("seek error: %w", err)
+					}
+					if _, err = r.Seek(0, io.SeekStart); err != nil {
+						return nil, nil, xerrors.Errorf("seek error: %w", err)
+					}
+					libs, err := jar.Parse(r, size, jar.WithFilePath(filePath), jar.WithOffline(opts.Offline))
+					return libs, nil, err
+				})
+			},
+		},
+		{
+			Type:      Pom,
+			Ecosystem: types.Maven,
+			Patterns:  []string{"pom.xml"},
+			NewParser: func(filePath string, opts Options) types.Parser {
+				p := pom.NewParser(filePath, pom.WithOffline(opts.Offline), pom.WithFS(opts.FS))
+				return ParserFunc(func(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+					return p.Parse(r)
+				})
+			},
+		},
+		{
+			Type:      NuGetLock,
+			Ecosystem: types.NuGet,
+			Patterns:  []string{"packages.lock.json"},
+			NewParser: libraryParser(lock.Parse),
+		},
+		{
+			Type:      NuGetConfig,
+			Ecosystem: types.NuGet,
+			Patterns:  []string{"packages.config"},
+			NewParser: libraryParser(config.Parse),
+		},
+		{
+			Type:      WordPress,
+			Ecosystem: types.WordPress,
+			Patterns:  []string{"wp-includes/version.php"},
+			NewParser: singleLibraryParser(wordpress.Parse),
+		},
+		{
+			Type:      CycloneDXJSON,
+			Patterns:  []string{"*.cdx.json", "bom.json"},
+			NewParser: graphParser(cyclonedx.Parse),
+		},
+		{
+			Type:      CycloneDXXML,
+			Patterns:  []string{"*.cdx.xml", "bom.xml"},
+			NewParser: graphParser(cyclonedx.ParseXML),
+		},
+		{
+			Type:      SPDXJSON,
+			Patterns:  []string{"*.spdx.json"},
+			NewParser: graphParser(spdx.Parse),
+		},
+		{
+			Type:      SPDXTagValue,
+			Patterns:  []string{"*.spdx"},
+			NewParser: graphParser(spdx.ParseTagValue),
+		},
+	}
+}
+
+// graphParser adapts parsers which take io.Reader.
+func graphParser(parse func(io.Reader) ([]types.Library, []types.Dependency, error)) func(string, Options) types.Parser {
+	return func(string, Options) types.Parser {
+		return ParserFunc(func(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+			return parse(r)
+		})
+	}
+}
+
+// libraryParser adapts parsers which don't return dependencies.
+func libraryParser(parse func(io.Reader) ([]types.Library, error)) func(string, Options) types.Parser {
+	return func(string, Options) types.Parser {
+		return ParserFunc(func(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+			libs, err := parse(r)
+			return libs, nil, err
+		})
+	}
+}
+
+// singleLibraryParser adapts parsers of package metadata which describe only one library.
+func singleLibraryParser(parse func(io.Reader) (types.Library, error)) func(string, Options) types.Parser {
+	return func(string, Options) types.Parser {
+		return ParserFunc(func(r dio.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+			lib, err := parse(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []types.Library{lib}, nil, nil
+		})
+	}
+}
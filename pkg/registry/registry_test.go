@@ -0,0 +1,131 @@
+package registry_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/registry"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		filePath string
+		want     registry.FileType
+		wantOK   bool
+	}{
+		{filePath: "package-lock.json", want: registry.Npm, wantOK: true},
+		{filePath: "app/node_modules/foo/package.json", want: registry.PackageJSON, wantOK: true},
+		{filePath: "app/yarn.lock", want: registry.Yarn, wantOK: true},
+		{filePath: "pnpm-lock.yaml", want: registry.Pnpm, wantOK: true},
+		{filePath: "Cargo.lock", want: registry.Cargo, wantOK: true},
+		{filePath: "lib/spring-core-5.3.4.jar", want: registry.Jar, wantOK: true},
+		{filePath: "go.mod", want: registry.GoMod, wantOK: true},
+		{filePath: "go.work", want: registry.GoWork, wantOK: true},
+		{filePath: "app/vendor/modules.txt", want: registry.GoVendor, wantOK: true},
+		{filePath: "modules.txt"},
+		{filePath: "Pipfile.lock", want: registry.Pipenv, wantOK: true},
+		{filePath: "site-packages/Flask-2.0.0.dist-info/METADATA", want: registry.Packaging, wantOK: true},
+		{filePath: "packages.config", want: registry.NuGetConfig, wantOK: true},
+		{filePath: "var/www/wp-includes/version.php", want: registry.WordPress, wantOK: true},
+		{filePath: "sbom/app.cdx.json", want: registry.CycloneDXJSON, wantOK: true},
+		{filePath: "bom.xml", want: registry.CycloneDXXML, wantOK: true},
+		{filePath: "app.spdx.json", want: registry.SPDXJSON, wantOK: true},
+		{filePath: "app.spdx", want: registry.SPDXTagValue, wantOK: true},
+		{filePath: "version.php"},
+		{filePath: "README.md"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filePath, func(t *testing.T) {
+			got, ok := registry.Lookup(tt.filePath)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got.Type)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputFile string
+		filePath  string
+		want      []types.Library
+		wantDeps  []types.Dependency
+		wantErr   string
+	}{
+		{
+			name:      "yarn.lock",
+			inputFile: filepath.Join("testdata", "yarn.lock"),
+			filePath:  "app/yarn.lock",
+			want: []types.Library{
+				{
+					Name:      "asap",
+					Version:   "2.0.6",
+					Locations: types.Locations{{StartLine: 5, EndLine: 8}},
+					Resolved:  "https://registry.yarnpkg.com/asap/-/asap-2.0.6.tgz#e50347611d7e690943208bbdafebcbc2fb866d46",
+					Digests:   []types.Digest{"sha1:e50347611d7e690943208bbdafebcbc2fb866d46"},
+				},
+				{
+					Name:      "jquery",
+					Version:   "3.4.1",
+					Locations: types.Locations{{StartLine: 10, EndLine: 13}},
+					Resolved:  "https://registry.yarnpkg.com/jquery/-/jquery-3.4.1.tgz#714f1f8d9dde4bdfa55764ba37ef214630d80ef2",
+					Digests:   []types.Digest{"sha512:dfaf80741cc22fecbaaa3c394f1ec78337860b30bcd4c0c381a50ff25776ce1c79f0776a5c6a0177eb4776b04c8b28c642cd07c6cfcc2d94eefde1cd249b963f"},
+				},
+				{
+					Name:      "promise",
+					Version:   "8.0.3",
+					Locations: types.Locations{{StartLine: 15, EndLine: 20}},
+					Resolved:  "https://registry.yarnpkg.com/promise/-/promise-8.0.3.tgz#f592e099c6cddc000d538ee7283bb190452b0bf6",
+					Digests:   []types.Digest{"sha512:1de44350bd512622e1c80d3f82b9fe3d34a194101c2ee87fd41246b6bbe3c1bbd10c24cb2cc133f6b386095f91def1d8e0c8b122ea0c11df58abf5efb133dc8f"},
+				},
+			},
+		},
+		{
+			name:      "Cargo.lock",
+			inputFile: filepath.Join("testdata", "Cargo.lock"),
+			filePath:  "Cargo.lock",
+			want: []types.Library{
+				{
+					ID:        "libc@0.2.54",
+					Name:      "libc",
+					Version:   "0.2.54",
+					Locations: types.Locations{{StartLine: 3, EndLine: 6}},
+					Resolved:  "registry+https://github.com/rust-lang/crates.io-index",
+					Digests:   []types.Digest{"sha256:c6785aa7dd976f5fbf3b71cfd9cd49d7f783c1ff565a858d71031c6c313aa5c6"},
+				},
+				{ID: "normal@0.1.0", Name: "normal", Version: "0.1.0", Locations: types.Locations{{StartLine: 8, EndLine: 13}}},
+			},
+			wantDeps: []types.Dependency{
+				{ID: "normal@0.1.0", DependsOn: []string{"libc@0.2.54"}},
+			},
+		},
+		{
+			name:      "unsupported file",
+			inputFile: filepath.Join("testdata", "yarn.lock"),
+			filePath:  "unknown.txt",
+			wantErr:   "unsupported file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.inputFile)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, gotDeps, err := registry.Parse(tt.filePath, f, registry.WithOffline(true))
+			if tt.wantErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDeps, gotDeps)
+		})
+	}
+}
@@ -3,33 +3,94 @@ package bundler
 import (
 	"bufio"
 	"io"
+	"sort"
 	"strings"
 
-	"github.com/aquasecurity/go-dep-parser/pkg/types"
 	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
-func Parse(r io.Reader) ([]types.Library, error) {
+func Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
 	var libs []types.Library
+	// Index of the gem whose dependencies are being read
+	current := -1
+	// Gem ID => names of the gems it depends on
+	dependsOn := map[string][]string{}
 	scanner := bufio.NewScanner(r)
+	var lineNumber int
 	for scanner.Scan() {
+		lineNumber++
 		line := scanner.Text()
-		if countLeadingSpace(line) == 4 {
+		switch countLeadingSpace(line) {
+		case 4:
 			line = strings.TrimSpace(line)
 			s := strings.Fields(line)
 			if len(s) != 2 {
+				current = -1
 				continue
 			}
+			name, version := s[0], strings.Trim(s[1], "()")
 			libs = append(libs, types.Library{
-				Name:    s[0],
-				Version: strings.Trim(s[1], "()"),
+				ID:      utils.PackageID(name, version),
+				Name:    name,
+				Version: version,
+				Locations: types.Locations{
+					{
+						StartLine: lineNumber,
+						EndLine:   lineNumber,
+					},
+				},
 			})
+			current = len(libs) - 1
+		case 6:
+			// e.g. "      actionpack (= 5.2.3)" under "    actioncable (5.2.3)"
+			s := strings.Fields(line)
+			if len(s) == 0 || current < 0 {
+				continue
+			}
+			lib := &libs[current]
+			dependsOn[lib.ID] = append(dependsOn[lib.ID], s[0])
+			lib.Locations[0].EndLine = lineNumber
+		default:
+			current = -1
 		}
 	}
 	if err := scanner.Err(); err != nil {
-		return nil, xerrors.Errorf("scan error: %w", err)
+		return nil, nil, xerrors.Errorf("scan error: %w", err)
+	}
+	return libs, parseDependencies(libs, dependsOn), nil
+}
+
+// parseDependencies resolves gem names to the locked versions.
+func parseDependencies(libs []types.Library, dependsOn map[string][]string) []types.Dependency {
+	versions := map[string]string{}
+	for _, lib := range libs {
+		versions[lib.Name] = lib.Version
+	}
+
+	var deps []types.Dependency
+	for _, lib := range libs {
+		var ids []string
+		for _, name := range dependsOn[lib.ID] {
+			// Gems not locked in "specs" such as "bundler" are ignored.
+			ver, ok := versions[name]
+			if !ok {
+				continue
+			}
+			ids = append(ids, utils.PackageID(name, ver))
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		sort.Strings(ids)
+		deps = append(deps, types.Dependency{
+			ID:        lib.ID,
+			DependsOn: utils.UniqueStrings(ids),
+		})
 	}
-	return libs, nil
+	return deps
 }
 
 func countLeadingSpace(line string) int {
@@ -3,6 +3,7 @@ package bundler
 import (
 	"os"
 	"path"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,20 +14,24 @@ import (
 
 func TestParse(t *testing.T) {
 	vectors := []struct {
-		file string // Test input file
-		want []types.Library
+		file     string // Test input file
+		want     []types.Library
+		wantDeps []types.Dependency
 	}{
 		{
-			file: "testdata/Gemfile_normal.lock",
-			want: BundlerNormal,
+			file:     "testdata/Gemfile_normal.lock",
+			want:     BundlerNormal,
+			wantDeps: BundlerNormalDeps,
 		},
 		{
-			file: "testdata/Gemfile_rails.lock",
-			want: BundlerRails,
+			file:     "testdata/Gemfile_rails.lock",
+			want:     BundlerRails,
+			wantDeps: BundlerRailsDeps,
 		},
 		{
-			file: "testdata/Gemfile_many.lock",
-			want: BundlerMany,
+			file:     "testdata/Gemfile_many.lock",
+			want:     BundlerMany,
+			wantDeps: BundlerManyDeps,
 		},
 	}
 
@@ -35,10 +40,15 @@ func TestParse(t *testing.T) {
 			f, err := os.Open(v.file)
 			require.NoError(t, err)
 
-			got, err := Parse(f)
+			got, deps, err := Parse(f)
 			require.NoError(t, err)
 
 			assert.Equal(t, v.want, got)
+
+			sort.Slice(deps, func(i, j int) bool {
+				return deps[i].ID < deps[j].ID
+			})
+			assert.Equal(t, v.wantDeps, deps)
 		})
 	}
 }
@@ -8,23 +8,23 @@ var (
 	// bundle add dotenv json faker rubocop pry
 	// bundler show | grep "*" | grep -v bundler | awk '{if(match($0, /\((.*)\)/)) printf("{\""$2"\", \""substr($0, RSTART+1, RLENGTH-2)"\", \"\"},\n");}'
 	BundlerNormal = []types.Library{
-		{Name: "ast", Version: "2.4.0"},
-		{Name: "coderay", Version: "1.1.2"},
-		{Name: "concurrent-ruby", Version: "1.1.5"},
-		{Name: "dotenv", Version: "2.7.2"},
-		{Name: "faker", Version: "1.9.3"},
-		{Name: "i18n", Version: "1.6.0"},
-		{Name: "jaro_winkler", Version: "1.5.2"},
-		{Name: "json", Version: "2.2.0"},
-		{Name: "method_source", Version: "0.9.2"},
-		{Name: "parallel", Version: "1.17.0"},
-		{Name: "parser", Version: "2.6.3.0"},
-		{Name: "pry", Version: "0.12.2"},
-		{Name: "psych", Version: "3.1.0"},
-		{Name: "rainbow", Version: "3.0.0"},
-		{Name: "rubocop", Version: "0.67.2"},
-		{Name: "ruby-progressbar", Version: "1.10.0"},
-		{Name: "unicode-display_width", Version: "1.5.0"},
+		{ID: "ast@2.4.0", Name: "ast", Version: "2.4.0", Locations: types.Locations{{StartLine: 4, EndLine: 4}}},
+		{ID: "coderay@1.1.2", Name: "coderay", Version: "1.1.2", Locations: types.Locations{{StartLine: 5, EndLine: 5}}},
+		{ID: "concurrent-ruby@1.1.5", Name: "concurrent-ruby", Version: "1.1.5", Locations: types.Locations{{StartLine: 6, EndLine: 6}}},
+		{ID: "dotenv@2.7.2", Name: "dotenv", Version: "2.7.2", Locations: types.Locations{{StartLine: 7, EndLine: 7}}},
+		{ID: "faker@1.9.3", Name: "faker", Version: "1.9.3", Locations: types.Locations{{StartLine: 8, EndLine: 9}}},
+		{ID: "i18n@1.6.0", Name: "i18n", Version: "1.6.0", Locations: types.Locations{{StartLine: 10, EndLine: 11}}},
+		{ID: "jaro_winkler@1.5.2", Name: "jaro_winkler", Version: "1.5.2", Locations: types.Locations{{StartLine: 12, EndLine: 12}}},
+		{ID: "json@2.2.0", Name: "json", Version: "2.2.0", Locations: types.Locations{{StartLine: 13, EndLine: 13}}},
+		{ID: "method_source@0.9.2", Name: "method_source", Version: "0.9.2", Locations: types.Locations{{StartLine: 14, EndLine: 14}}},
+		{ID: "parallel@1.17.0", Name: "parallel", Version: "1.17.0", Locations: types.Locations{{StartLine: 15, EndLine: 15}}},
+		{ID: "parser@2.6.3.0", Name: "parser", Version: "2.6.3.0", Locations: types.Locations{{StartLine: 16, EndLine: 17}}},
+		{ID: "pry@0.12.2", Name: "pry", Version: "0.12.2", Locations: types.Locations{{StartLine: 18, EndLine: 20}}},
+		{ID: "psych@3.1.0", Name: "psych", Version: "3.1.0", Locations: types.Locations{{StartLine: 21, EndLine: 21}}},
+		{ID: "rainbow@3.0.0", Name: "rainbow", Version: "3.0.0", Locations: types.Locations{{StartLine: 22, EndLine: 22}}},
+		{ID: "rubocop@0.67.2", Name: "rubocop", Version: "0.67.2", Locations: types.Locations{{StartLine: 23, EndLine: 30}}},
+		{ID: "ruby-progressbar@1.10.0", Name: "ruby-progressbar", Version: "1.10.0", Locations: types.Locations{{StartLine: 31, EndLine: 31}}},
+		{ID: "unicode-display_width@1.5.0", Name: "unicode-display_width", Version: "1.5.0", Locations: types.Locations{{StartLine: 32, EndLine: 32}}},
 	}
 
 	// docker run --name bundler --rm -it ruby:2.6 bash
@@ -33,60 +33,60 @@ var (
 	// bundle add rails
 	// bundler show | grep "*" | grep -v bundler | awk '{if(match($0, /\((.*)\)/)) printf("{\""$2"\", \""substr($0, RSTART+1, RLENGTH-2)"\", \"\"},\n");}'
 	BundlerRails = []types.Library{
-		{Name: "actioncable", Version: "5.2.3"},
-		{Name: "actionmailer", Version: "5.2.3"},
-		{Name: "actionpack", Version: "5.2.3"},
-		{Name: "actionview", Version: "5.2.3"},
-		{Name: "activejob", Version: "5.2.3"},
-		{Name: "activemodel", Version: "5.2.3"},
-		{Name: "activerecord", Version: "5.2.3"},
-		{Name: "activestorage", Version: "5.2.3"},
-		{Name: "activesupport", Version: "5.2.3"},
-		{Name: "arel", Version: "9.0.0"},
-		{Name: "ast", Version: "2.4.0"},
-		{Name: "builder", Version: "3.2.3"},
-		{Name: "coderay", Version: "1.1.2"},
-		{Name: "concurrent-ruby", Version: "1.1.5"},
-		{Name: "crass", Version: "1.0.4"},
-		{Name: "dotenv", Version: "2.7.2"},
-		{Name: "erubi", Version: "1.8.0"},
-		{Name: "faker", Version: "1.9.3"},
-		{Name: "globalid", Version: "0.4.2"},
-		{Name: "i18n", Version: "1.6.0"},
-		{Name: "jaro_winkler", Version: "1.5.2"},
-		{Name: "json", Version: "2.2.0"},
-		{Name: "loofah", Version: "2.2.3"},
-		{Name: "mail", Version: "2.7.1"},
-		{Name: "marcel", Version: "0.3.3"},
-		{Name: "method_source", Version: "0.9.2"},
-		{Name: "mimemagic", Version: "0.3.3"},
-		{Name: "mini_mime", Version: "1.0.1"},
-		{Name: "mini_portile2", Version: "2.4.0"},
-		{Name: "minitest", Version: "5.11.3"},
-		{Name: "nio4r", Version: "2.3.1"},
-		{Name: "nokogiri", Version: "1.10.3"},
-		{Name: "parallel", Version: "1.17.0"},
-		{Name: "parser", Version: "2.6.3.0"},
-		{Name: "pry", Version: "0.12.2"},
-		{Name: "psych", Version: "3.1.0"},
-		{Name: "rack", Version: "2.0.7"},
-		{Name: "rack-test", Version: "1.1.0"},
-		{Name: "rails", Version: "5.2.3"},
-		{Name: "rails-dom-testing", Version: "2.0.3"},
-		{Name: "rails-html-sanitizer", Version: "1.0.4"},
-		{Name: "railties", Version: "5.2.3"},
-		{Name: "rainbow", Version: "3.0.0"},
-		{Name: "rake", Version: "12.3.2"},
-		{Name: "rubocop", Version: "0.67.2"},
-		{Name: "ruby-progressbar", Version: "1.10.0"},
-		{Name: "sprockets", Version: "3.7.2"},
-		{Name: "sprockets-rails", Version: "3.2.1"},
-		{Name: "thor", Version: "0.20.3"},
-		{Name: "thread_safe", Version: "0.3.6"},
-		{Name: "tzinfo", Version: "1.2.5"},
-		{Name: "unicode-display_width", Version: "1.5.0"},
-		{Name: "websocket-driver", Version: "0.7.0"},
-		{Name: "websocket-extensions", Version: "0.1.3"},
+		{ID: "actioncable@5.2.3", Name: "actioncable", Version: "5.2.3", Locations: types.Locations{{StartLine: 4, EndLine: 7}}},
+		{ID: "actionmailer@5.2.3", Name: "actionmailer", Version: "5.2.3", Locations: types.Locations{{StartLine: 8, EndLine: 13}}},
+		{ID: "actionpack@5.2.3", Name: "actionpack", Version: "5.2.3", Locations: types.Locations{{StartLine: 14, EndLine: 20}}},
+		{ID: "actionview@5.2.3", Name: "actionview", Version: "5.2.3", Locations: types.Locations{{StartLine: 21, EndLine: 26}}},
+		{ID: "activejob@5.2.3", Name: "activejob", Version: "5.2.3", Locations: types.Locations{{StartLine: 27, EndLine: 29}}},
+		{ID: "activemodel@5.2.3", Name: "activemodel", Version: "5.2.3", Locations: types.Locations{{StartLine: 30, EndLine: 31}}},
+		{ID: "activerecord@5.2.3", Name: "activerecord", Version: "5.2.3", Locations: types.Locations{{StartLine: 32, EndLine: 35}}},
+		{ID: "activestorage@5.2.3", Name: "activestorage", Version: "5.2.3", Locations: types.Locations{{StartLine: 36, EndLine: 39}}},
+		{ID: "activesupport@5.2.3", Name: "activesupport", Version: "5.2.3", Locations: types.Locations{{StartLine: 40, EndLine: 44}}},
+		{ID: "arel@9.0.0", Name: "arel", Version: "9.0.0", Locations: types.Locations{{StartLine: 45, EndLine: 45}}},
+		{ID: "ast@2.4.0", Name: "ast", Version: "2.4.0", Locations: types.Locations{{StartLine: 46, EndLine: 46}}},
+		{ID: "builder@3.2.3", Name: "builder", Version: "3.2.3", Locations: types.Locations{{StartLine: 47, EndLine: 47}}},
+		{ID: "coderay@1.1.2", Name: "coderay", Version: "1.1.2", Locations: types.Locations{{StartLine: 48, EndLine: 48}}},
+		{ID: "concurrent-ruby@1.1.5", Name: "concurrent-ruby", Version: "1.1.5", Locations: types.Locations{{StartLine: 49, EndLine: 49}}},
+		{ID: "crass@1.0.4", Name: "crass", Version: "1.0.4", Locations: types.Locations{{StartLine: 50, EndLine: 50}}},
+		{ID: "dotenv@2.7.2", Name: "dotenv", Version: "2.7.2", Locations: types.Locations{{StartLine: 51, EndLine: 51}}},
+		{ID: "erubi@1.8.0", Name: "erubi", Version: "1.8.0", Locations: types.Locations{{StartLine: 52, EndLine: 52}}},
+		{ID: "faker@1.9.3", Name: "faker", Version: "1.9.3", Locations: types.Locations{{StartLine: 53, EndLine: 54}}},
+		{ID: "globalid@0.4.2", Name: "globalid", Version: "0.4.2", Locations: types.Locations{{StartLine: 55, EndLine: 56}}},
+		{ID: "i18n@1.6.0", Name: "i18n", Version: "1.6.0", Locations: types.Locations{{StartLine: 57, EndLine: 58}}},
+		{ID: "jaro_winkler@1.5.2", Name: "jaro_winkler", Version: "1.5.2", Locations: types.Locations{{StartLine: 59, EndLine: 59}}},
+		{ID: "json@2.2.0", Name: "json", Version: "2.2.0", Locations: types.Locations{{StartLine: 60, EndLine: 60}}},
+		{ID: "loofah@2.2.3", Name: "loofah", Version: "2.2.3", Locations: types.Locations{{StartLine: 61, EndLine: 63}}},
+		{ID: "mail@2.7.1", Name: "mail", Version: "2.7.1", Locations: types.Locations{{StartLine: 64, EndLine: 65}}},
+		{ID: "marcel@0.3.3", Name: "marcel", Version: "0.3.3", Locations: types.Locations{{StartLine: 66, EndLine: 67}}},
+		{ID: "method_source@0.9.2", Name: "method_source", Version: "0.9.2", Locations: types.Locations{{StartLine: 68, EndLine: 68}}},
+		{ID: "mimemagic@0.3.3", Name: "mimemagic", Version: "0.3.3", Locations: types.Locations{{StartLine: 69, EndLine: 69}}},
+		{ID: "mini_mime@1.0.1", Name: "mini_mime", Version: "1.0.1", Locations: types.Locations{{StartLine: 70, EndLine: 70}}},
+		{ID: "mini_portile2@2.4.0", Name: "mini_portile2", Version: "2.4.0", Locations: types.Locations{{StartLine: 71, EndLine: 71}}},
+		{ID: "minitest@5.11.3", Name: "minitest", Version: "5.11.3", Locations: types.Locations{{StartLine: 72, EndLine: 72}}},
+		{ID: "nio4r@2.3.1", Name: "nio4r", Version: "2.3.1", Locations: types.Locations{{StartLine: 73, EndLine: 73}}},
+		{ID: "nokogiri@1.10.3", Name: "nokogiri", Version: "1.10.3", Locations: types.Locations{{StartLine: 74, EndLine: 75}}},
+		{ID: "parallel@1.17.0", Name: "parallel", Version: "1.17.0", Locations: types.Locations{{StartLine: 76, EndLine: 76}}},
+		{ID: "parser@2.6.3.0", Name: "parser", Version: "2.6.3.0", Locations: types.Locations{{StartLine: 77, EndLine: 78}}},
+		{ID: "pry@0.12.2", Name: "pry", Version: "0.12.2", Locations: types.Locations{{StartLine: 79, EndLine: 81}}},
+		{ID: "psych@3.1.0", Name: "psych", Version: "3.1.0", Locations: types.Locations{{StartLine: 82, EndLine: 82}}},
+		{ID: "rack@2.0.7", Name: "rack", Version: "2.0.7", Locations: types.Locations{{StartLine: 83, EndLine: 83}}},
+		{ID: "rack-test@1.1.0", Name: "rack-test", Version: "1.1.0", Locations: types.Locations{{StartLine: 84, EndLine: 85}}},
+		{ID: "rails@5.2.3", Name: "rails", Version: "5.2.3", Locations: types.Locations{{StartLine: 86, EndLine: 98}}},
+		{ID: "rails-dom-testing@2.0.3", Name: "rails-dom-testing", Version: "2.0.3", Locations: types.Locations{{StartLine: 99, EndLine: 101}}},
+		{ID: "rails-html-sanitizer@1.0.4", Name: "rails-html-sanitizer", Version: "1.0.4", Locations: types.Locations{{StartLine: 102, EndLine: 103}}},
+		{ID: "railties@5.2.3", Name: "railties", Version: "5.2.3", Locations: types.Locations{{StartLine: 104, EndLine: 109}}},
+		{ID: "rainbow@3.0.0", Name: "rainbow", Version: "3.0.0", Locations: types.Locations{{StartLine: 110, EndLine: 110}}},
+		{ID: "rake@12.3.2", Name: "rake", Version: "12.3.2", Locations: types.Locations{{StartLine: 111, EndLine: 111}}},
+		{ID: "rubocop@0.67.2", Name: "rubocop", Version: "0.67.2", Locations: types.Locations{{StartLine: 112, EndLine: 119}}},
+		{ID: "ruby-progressbar@1.10.0", Name: "ruby-progressbar", Version: "1.10.0", Locations: types.Locations{{StartLine: 120, EndLine: 120}}},
+		{ID: "sprockets@3.7.2", Name: "sprockets", Version: "3.7.2", Locations: types.Locations{{StartLine: 121, EndLine: 123}}},
+		{ID: "sprockets-rails@3.2.1", Name: "sprockets-rails", Version: "3.2.1", Locations: types.Locations{{StartLine: 124, EndLine: 127}}},
+		{ID: "thor@0.20.3", Name: "thor", Version: "0.20.3", Locations: types.Locations{{StartLine: 128, EndLine: 128}}},
+		{ID: "thread_safe@0.3.6", Name: "thread_safe", Version: "0.3.6", Locations: types.Locations{{StartLine: 129, EndLine: 129}}},
+		{ID: "tzinfo@1.2.5", Name: "tzinfo", Version: "1.2.5", Locations: types.Locations{{StartLine: 130, EndLine: 131}}},
+		{ID: "unicode-display_width@1.5.0", Name: "unicode-display_width", Version: "1.5.0", Locations: types.Locations{{StartLine: 132, EndLine: 132}}},
+		{ID: "websocket-driver@0.7.0", Name: "websocket-driver", Version: "0.7.0", Locations: types.Locations{{StartLine: 133, EndLine: 134}}},
+		{ID: "websocket-extensions@0.1.3", Name: "websocket-extensions", Version: "0.1.3", Locations: types.Locations{{StartLine: 135, EndLine: 135}}},
 	}
 	// docker run --name bundler --rm -it ruby:2.6 bash
 	// bundle init
@@ -95,252 +95,843 @@ var (
 	// bundle add sinatra multi-json thor sass aws-sdk faraday
 	// bundler show | grep "*" | grep -v bundler | awk '{if(match($0, /\((.*)\)/)) printf("{\""$2"\", \""substr($0, RSTART+1, RLENGTH-2)"\"}, \"\"},\n");}'
 	BundlerMany = []types.Library{
-		{Name: "actioncable", Version: "5.2.3"},
-		{Name: "actionmailer", Version: "5.2.3"},
-		{Name: "actionpack", Version: "5.2.3"},
-		{Name: "actionview", Version: "5.2.3"},
-		{Name: "activejob", Version: "5.2.3"},
-		{Name: "activemodel", Version: "5.2.3"},
-		{Name: "activerecord", Version: "5.2.3"},
-		{Name: "activestorage", Version: "5.2.3"},
-		{Name: "activesupport", Version: "5.2.3"},
-		{Name: "arel", Version: "9.0.0"},
-		{Name: "ast", Version: "2.4.0"},
-		{Name: "aws-eventstream", Version: "1.0.3"},
-		{Name: "aws-partitions", Version: "1.154.0"},
-		{Name: "aws-sdk", Version: "3.0.1"},
-		{Name: "aws-sdk-acm", Version: "1.19.0"},
-		{Name: "aws-sdk-acmpca", Version: "1.13.0"},
-		{Name: "aws-sdk-alexaforbusiness", Version: "1.20.0"},
-		{Name: "aws-sdk-amplify", Version: "1.3.0"},
-		{Name: "aws-sdk-apigateway", Version: "1.26.0"},
-		{Name: "aws-sdk-apigatewaymanagementapi", Version: "1.3.0"},
-		{Name: "aws-sdk-apigatewayv2", Version: "1.4.0"},
-		{Name: "aws-sdk-applicationautoscaling", Version: "1.22.0"},
-		{Name: "aws-sdk-applicationdiscoveryservice", Version: "1.15.0"},
-		{Name: "aws-sdk-appmesh", Version: "1.6.0"},
-		{Name: "aws-sdk-appstream", Version: "1.25.0"},
-		{Name: "aws-sdk-appsync", Version: "1.12.0"},
-		{Name: "aws-sdk-athena", Version: "1.12.0"},
-		{Name: "aws-sdk-autoscaling", Version: "1.20.0"},
-		{Name: "aws-sdk-autoscalingplans", Version: "1.12.0"},
-		{Name: "aws-sdk-backup", Version: "1.3.0"},
-		{Name: "aws-sdk-batch", Version: "1.17.0"},
-		{Name: "aws-sdk-budgets", Version: "1.18.0"},
-		{Name: "aws-sdk-chime", Version: "1.6.0"},
-		{Name: "aws-sdk-cloud9", Version: "1.11.0"},
-		{Name: "aws-sdk-clouddirectory", Version: "1.14.0"},
-		{Name: "aws-sdk-cloudformation", Version: "1.19.0"},
-		{Name: "aws-sdk-cloudfront", Version: "1.15.0"},
-		{Name: "aws-sdk-cloudhsm", Version: "1.12.0"},
-		{Name: "aws-sdk-cloudhsmv2", Version: "1.12.0"},
-		{Name: "aws-sdk-cloudsearch", Version: "1.9.0"},
-		{Name: "aws-sdk-cloudsearchdomain", Version: "1.9.0"},
-		{Name: "aws-sdk-cloudtrail", Version: "1.11.0"},
-		{Name: "aws-sdk-cloudwatch", Version: "1.20.0"},
-		{Name: "aws-sdk-cloudwatchevents", Version: "1.17.0"},
-		{Name: "aws-sdk-cloudwatchlogs", Version: "1.17.0"},
-		{Name: "aws-sdk-codebuild", Version: "1.32.0"},
-		{Name: "aws-sdk-codecommit", Version: "1.17.0"},
-		{Name: "aws-sdk-codedeploy", Version: "1.18.0"},
-		{Name: "aws-sdk-codepipeline", Version: "1.15.0"},
-		{Name: "aws-sdk-codestar", Version: "1.11.0"},
-		{Name: "aws-sdk-cognitoidentity", Version: "1.10.0"},
-		{Name: "aws-sdk-cognitoidentityprovider", Version: "1.18.0"},
-		{Name: "aws-sdk-cognitosync", Version: "1.9.0"},
-		{Name: "aws-sdk-comprehend", Version: "1.18.0"},
-		{Name: "aws-sdk-comprehendmedical", Version: "1.3.0"},
-		{Name: "aws-sdk-configservice", Version: "1.26.0"},
-		{Name: "aws-sdk-connect", Version: "1.13.0"},
-		{Name: "aws-sdk-core", Version: "3.48.6"},
-		{Name: "aws-sdk-costandusagereportservice", Version: "1.10.0"},
-		{Name: "aws-sdk-costexplorer", Version: "1.21.0"},
-		{Name: "aws-sdk-databasemigrationservice", Version: "1.20.0"},
-		{Name: "aws-sdk-datapipeline", Version: "1.9.0"},
-		{Name: "aws-sdk-datasync", Version: "1.3.0"},
-		{Name: "aws-sdk-dax", Version: "1.11.0"},
-		{Name: "aws-sdk-devicefarm", Version: "1.19.0"},
-		{Name: "aws-sdk-directconnect", Version: "1.16.0"},
-		{Name: "aws-sdk-directoryservice", Version: "1.15.0"},
-		{Name: "aws-sdk-dlm", Version: "1.11.0"},
-		{Name: "aws-sdk-docdb", Version: "1.4.0"},
-		{Name: "aws-sdk-dynamodb", Version: "1.26.0"},
-		{Name: "aws-sdk-dynamodbstreams", Version: "1.9.0"},
-		{Name: "aws-sdk-ec2", Version: "1.80.0"},
-		{Name: "aws-sdk-ecr", Version: "1.14.0"},
-		{Name: "aws-sdk-ecs", Version: "1.36.0"},
-		{Name: "aws-sdk-efs", Version: "1.13.0"},
-		{Name: "aws-sdk-eks", Version: "1.15.0"},
-		{Name: "aws-sdk-elasticache", Version: "1.14.0"},
-		{Name: "aws-sdk-elasticbeanstalk", Version: "1.19.0"},
-		{Name: "aws-sdk-elasticloadbalancing", Version: "1.12.0"},
-		{Name: "aws-sdk-elasticloadbalancingv2", Version: "1.26.0"},
-		{Name: "aws-sdk-elasticsearchservice", Version: "1.19.0"},
-		{Name: "aws-sdk-elastictranscoder", Version: "1.11.0"},
-		{Name: "aws-sdk-emr", Version: "1.14.0"},
-		{Name: "aws-sdk-firehose", Version: "1.14.0"},
-		{Name: "aws-sdk-fms", Version: "1.12.0"},
-		{Name: "aws-sdk-fsx", Version: "1.4.0"},
-		{Name: "aws-sdk-gamelift", Version: "1.16.0"},
-		{Name: "aws-sdk-glacier", Version: "1.18.0"},
-		{Name: "aws-sdk-globalaccelerator", Version: "1.4.0"},
-		{Name: "aws-sdk-glue", Version: "1.30.0"},
-		{Name: "aws-sdk-greengrass", Version: "1.17.0"},
-		{Name: "aws-sdk-guardduty", Version: "1.14.0"},
-		{Name: "aws-sdk-health", Version: "1.12.0"},
-		{Name: "aws-sdk-iam", Version: "1.19.0"},
-		{Name: "aws-sdk-importexport", Version: "1.9.0"},
-		{Name: "aws-sdk-inspector", Version: "1.16.0"},
-		{Name: "aws-sdk-iot", Version: "1.29.0"},
-		{Name: "aws-sdk-iot1clickdevicesservice", Version: "1.11.0"},
-		{Name: "aws-sdk-iot1clickprojects", Version: "1.10.0"},
-		{Name: "aws-sdk-iotanalytics", Version: "1.16.0"},
-		{Name: "aws-sdk-iotdataplane", Version: "1.9.0"},
-		{Name: "aws-sdk-iotjobsdataplane", Version: "1.10.0"},
-		{Name: "aws-sdk-kafka", Version: "1.4.0"},
-		{Name: "aws-sdk-kinesis", Version: "1.13.1"},
-		{Name: "aws-sdk-kinesisanalytics", Version: "1.12.0"},
-		{Name: "aws-sdk-kinesisanalyticsv2", Version: "1.3.0"},
-		{Name: "aws-sdk-kinesisvideo", Version: "1.12.0"},
-		{Name: "aws-sdk-kinesisvideoarchivedmedia", Version: "1.11.0"},
-		{Name: "aws-sdk-kinesisvideomedia", Version: "1.10.0"},
-		{Name: "aws-sdk-kms", Version: "1.17.0"},
-		{Name: "aws-sdk-lambda", Version: "1.22.0"},
-		{Name: "aws-sdk-lambdapreview", Version: "1.9.0"},
-		{Name: "aws-sdk-lex", Version: "1.12.0"},
-		{Name: "aws-sdk-lexmodelbuildingservice", Version: "1.15.0"},
-		{Name: "aws-sdk-licensemanager", Version: "1.3.0"},
-		{Name: "aws-sdk-lightsail", Version: "1.18.0"},
-		{Name: "aws-sdk-machinelearning", Version: "1.10.0"},
-		{Name: "aws-sdk-macie", Version: "1.9.0"},
-		{Name: "aws-sdk-marketplacecommerceanalytics", Version: "1.9.0"},
-		{Name: "aws-sdk-marketplaceentitlementservice", Version: "1.9.0"},
-		{Name: "aws-sdk-marketplacemetering", Version: "1.11.0"},
-		{Name: "aws-sdk-mediaconnect", Version: "1.5.0"},
-		{Name: "aws-sdk-mediaconvert", Version: "1.25.0"},
-		{Name: "aws-sdk-medialive", Version: "1.28.0"},
-		{Name: "aws-sdk-mediapackage", Version: "1.15.0"},
-		{Name: "aws-sdk-mediastore", Version: "1.12.0"},
-		{Name: "aws-sdk-mediastoredata", Version: "1.11.0"},
-		{Name: "aws-sdk-mediatailor", Version: "1.14.0"},
-		{Name: "aws-sdk-migrationhub", Version: "1.11.0"},
-		{Name: "aws-sdk-mobile", Version: "1.9.0"},
-		{Name: "aws-sdk-mq", Version: "1.13.0"},
-		{Name: "aws-sdk-mturk", Version: "1.12.0"},
-		{Name: "aws-sdk-neptune", Version: "1.11.0"},
-		{Name: "aws-sdk-opsworks", Version: "1.13.0"},
-		{Name: "aws-sdk-opsworkscm", Version: "1.16.0"},
-		{Name: "aws-sdk-organizations", Version: "1.24.0"},
-		{Name: "aws-sdk-pi", Version: "1.9.0"},
-		{Name: "aws-sdk-pinpoint", Version: "1.19.0"},
-		{Name: "aws-sdk-pinpointemail", Version: "1.6.0"},
-		{Name: "aws-sdk-pinpointsmsvoice", Version: "1.6.0"},
-		{Name: "aws-sdk-polly", Version: "1.19.0"},
-		{Name: "aws-sdk-pricing", Version: "1.9.0"},
-		{Name: "aws-sdk-quicksight", Version: "1.5.0"},
-		{Name: "aws-sdk-ram", Version: "1.4.0"},
-		{Name: "aws-sdk-rds", Version: "1.50.0"},
-		{Name: "aws-sdk-rdsdataservice", Version: "1.4.0"},
-		{Name: "aws-sdk-redshift", Version: "1.23.0"},
-		{Name: "aws-sdk-rekognition", Version: "1.22.0"},
-		{Name: "aws-sdk-resourcegroups", Version: "1.14.0"},
-		{Name: "aws-sdk-resourcegroupstaggingapi", Version: "1.9.0"},
-		{Name: "aws-sdk-resources", Version: "3.41.0"},
-		{Name: "aws-sdk-robomaker", Version: "1.5.0"},
-		{Name: "aws-sdk-route53", Version: "1.22.0"},
-		{Name: "aws-sdk-route53domains", Version: "1.11.0"},
-		{Name: "aws-sdk-route53resolver", Version: "1.4.0"},
-		{Name: "aws-sdk-s3", Version: "1.36.1"},
-		{Name: "aws-sdk-s3control", Version: "1.4.0"},
-		{Name: "aws-sdk-sagemaker", Version: "1.33.0"},
-		{Name: "aws-sdk-sagemakerruntime", Version: "1.10.0"},
-		{Name: "aws-sdk-secretsmanager", Version: "1.24.0"},
-		{Name: "aws-sdk-securityhub", Version: "1.4.0"},
-		{Name: "aws-sdk-serverlessapplicationrepository", Version: "1.15.0"},
-		{Name: "aws-sdk-servicecatalog", Version: "1.20.0"},
-		{Name: "aws-sdk-servicediscovery", Version: "1.12.0"},
-		{Name: "aws-sdk-ses", Version: "1.18.0"},
-		{Name: "aws-sdk-shield", Version: "1.13.0"},
-		{Name: "aws-sdk-signer", Version: "1.9.0"},
-		{Name: "aws-sdk-simpledb", Version: "1.9.0"},
-		{Name: "aws-sdk-sms", Version: "1.10.0"},
-		{Name: "aws-sdk-snowball", Version: "1.14.0"},
-		{Name: "aws-sdk-sns", Version: "1.13.0"},
-		{Name: "aws-sdk-sqs", Version: "1.13.0"},
-		{Name: "aws-sdk-ssm", Version: "1.43.0"},
-		{Name: "aws-sdk-states", Version: "1.14.0"},
-		{Name: "aws-sdk-storagegateway", Version: "1.21.0"},
-		{Name: "aws-sdk-support", Version: "1.9.0"},
-		{Name: "aws-sdk-swf", Version: "1.9.0"},
-		{Name: "aws-sdk-textract", Version: "1.4.0"},
-		{Name: "aws-sdk-transcribeservice", Version: "1.19.0"},
-		{Name: "aws-sdk-transcribestreamingservice", Version: "1.2.0"},
-		{Name: "aws-sdk-transfer", Version: "1.5.0"},
-		{Name: "aws-sdk-translate", Version: "1.11.0"},
-		{Name: "aws-sdk-waf", Version: "1.16.0"},
-		{Name: "aws-sdk-wafregional", Version: "1.17.0"},
-		{Name: "aws-sdk-workdocs", Version: "1.12.0"},
-		{Name: "aws-sdk-worklink", Version: "1.4.0"},
-		{Name: "aws-sdk-workmail", Version: "1.11.0"},
-		{Name: "aws-sdk-workspaces", Version: "1.19.0"},
-		{Name: "aws-sdk-xray", Version: "1.13.0"},
-		{Name: "aws-sigv2", Version: "1.0.1"},
-		{Name: "aws-sigv4", Version: "1.1.0"},
-		{Name: "builder", Version: "3.2.3"},
-		{Name: "coderay", Version: "1.1.2"},
-		{Name: "concurrent-ruby", Version: "1.1.5"},
-		{Name: "crass", Version: "1.0.4"},
-		{Name: "dotenv", Version: "2.7.2"},
-		{Name: "erubi", Version: "1.8.0"},
-		{Name: "faker", Version: "1.9.3"},
-		{Name: "faraday", Version: "0.15.4"},
-		{Name: "ffi", Version: "1.10.0"},
-		{Name: "globalid", Version: "0.4.2"},
-		{Name: "i18n", Version: "1.6.0"},
-		{Name: "jaro_winkler", Version: "1.5.2"},
-		{Name: "jmespath", Version: "1.4.0"},
-		{Name: "json", Version: "2.2.0"},
-		{Name: "loofah", Version: "2.2.3"},
-		{Name: "mail", Version: "2.7.1"},
-		{Name: "marcel", Version: "0.3.3"},
-		{Name: "method_source", Version: "0.9.2"},
-		{Name: "mimemagic", Version: "0.3.3"},
-		{Name: "mini_mime", Version: "1.0.1"},
-		{Name: "mini_portile2", Version: "2.4.0"},
-		{Name: "minitest", Version: "5.11.3"},
-		{Name: "multi_json", Version: "1.13.1"},
-		{Name: "multipart-post", Version: "2.0.0"},
-		{Name: "mustermann", Version: "1.0.3"},
-		{Name: "nio4r", Version: "2.3.1"},
-		{Name: "nokogiri", Version: "1.10.3"},
-		{Name: "parallel", Version: "1.17.0"},
-		{Name: "parser", Version: "2.6.3.0"},
-		{Name: "pry", Version: "0.12.2"},
-		{Name: "psych", Version: "3.1.0"},
-		{Name: "rack", Version: "2.0.7"},
-		{Name: "rack-protection", Version: "2.0.5"},
-		{Name: "rack-test", Version: "1.1.0"},
-		{Name: "rails", Version: "5.2.3"},
-		{Name: "rails-dom-testing", Version: "2.0.3"},
-		{Name: "rails-html-sanitizer", Version: "1.0.4"},
-		{Name: "railties", Version: "5.2.3"},
-		{Name: "rainbow", Version: "3.0.0"},
-		{Name: "rake", Version: "12.3.2"},
-		{Name: "rb-fsevent", Version: "0.10.3"},
-		{Name: "rb-inotify", Version: "0.10.0"},
-		{Name: "rubocop", Version: "0.67.2"},
-		{Name: "ruby-progressbar", Version: "1.10.0"},
-		{Name: "sass", Version: "3.7.4"},
-		{Name: "sass-listen", Version: "4.0.0"},
-		{Name: "sinatra", Version: "2.0.5"},
-		{Name: "sprockets", Version: "3.7.2"},
-		{Name: "sprockets-rails", Version: "3.2.1"},
-		{Name: "thor", Version: "0.20.3"},
-		{Name: "thread_safe", Version: "0.3.6"},
-		{Name: "tilt", Version: "2.0.9"},
-		{Name: "tzinfo", Version: "1.2.5"},
-		{Name: "unicode-display_width", Version: "1.5.0"},
-		{Name: "websocket-driver", Version: "0.7.0"},
-		{Name: "websocket-extensions", Version: "0.1.3"},
+		{ID: "actioncable@5.2.3", Name: "actioncable", Version: "5.2.3", Locations: types.Locations{{StartLine: 4, EndLine: 7}}},
+		{ID: "actionmailer@5.2.3", Name: "actionmailer", Version: "5.2.3", Locations: types.Locations{{StartLine: 8, EndLine: 13}}},
+		{ID: "actionpack@5.2.3", Name: "actionpack", Version: "5.2.3", Locations: types.Locations{{StartLine: 14, EndLine: 20}}},
+		{ID: "actionview@5.2.3", Name: "actionview", Version: "5.2.3", Locations: types.Locations{{StartLine: 21, EndLine: 26}}},
+		{ID: "activejob@5.2.3", Name: "activejob", Version: "5.2.3", Locations: types.Locations{{StartLine: 27, EndLine: 29}}},
+		{ID: "activemodel@5.2.3", Name: "activemodel", Version: "5.2.3", Locations: types.Locations{{StartLine: 30, EndLine: 31}}},
+		{ID: "activerecord@5.2.3", Name: "activerecord", Version: "5.2.3", Locations: types.Locations{{StartLine: 32, EndLine: 35}}},
+		{ID: "activestorage@5.2.3", Name: "activestorage", Version: "5.2.3", Locations: types.Locations{{StartLine: 36, EndLine: 39}}},
+		{ID: "activesupport@5.2.3", Name: "activesupport", Version: "5.2.3", Locations: types.Locations{{StartLine: 40, EndLine: 44}}},
+		{ID: "arel@9.0.0", Name: "arel", Version: "9.0.0", Locations: types.Locations{{StartLine: 45, EndLine: 45}}},
+		{ID: "ast@2.4.0", Name: "ast", Version: "2.4.0", Locations: types.Locations{{StartLine: 46, EndLine: 46}}},
+		{ID: "aws-eventstream@1.0.3", Name: "aws-eventstream", Version: "1.0.3", Locations: types.Locations{{StartLine: 47, EndLine: 47}}},
+		{ID: "aws-partitions@1.154.0", Name: "aws-partitions", Version: "1.154.0", Locations: types.Locations{{StartLine: 48, EndLine: 48}}},
+		{ID: "aws-sdk@3.0.1", Name: "aws-sdk", Version: "3.0.1", Locations: types.Locations{{StartLine: 49, EndLine: 50}}},
+		{ID: "aws-sdk-acm@1.19.0", Name: "aws-sdk-acm", Version: "1.19.0", Locations: types.Locations{{StartLine: 51, EndLine: 53}}},
+		{ID: "aws-sdk-acmpca@1.13.0", Name: "aws-sdk-acmpca", Version: "1.13.0", Locations: types.Locations{{StartLine: 54, EndLine: 56}}},
+		{ID: "aws-sdk-alexaforbusiness@1.20.0", Name: "aws-sdk-alexaforbusiness", Version: "1.20.0", Locations: types.Locations{{StartLine: 57, EndLine: 59}}},
+		{ID: "aws-sdk-amplify@1.3.0", Name: "aws-sdk-amplify", Version: "1.3.0", Locations: types.Locations{{StartLine: 60, EndLine: 62}}},
+		{ID: "aws-sdk-apigateway@1.26.0", Name: "aws-sdk-apigateway", Version: "1.26.0", Locations: types.Locations{{StartLine: 63, EndLine: 65}}},
+		{ID: "aws-sdk-apigatewaymanagementapi@1.3.0", Name: "aws-sdk-apigatewaymanagementapi", Version: "1.3.0", Locations: types.Locations{{StartLine: 66, EndLine: 68}}},
+		{ID: "aws-sdk-apigatewayv2@1.4.0", Name: "aws-sdk-apigatewayv2", Version: "1.4.0", Locations: types.Locations{{StartLine: 69, EndLine: 71}}},
+		{ID: "aws-sdk-applicationautoscaling@1.22.0", Name: "aws-sdk-applicationautoscaling", Version: "1.22.0", Locations: types.Locations{{StartLine: 72, EndLine: 74}}},
+		{ID: "aws-sdk-applicationdiscoveryservice@1.15.0", Name: "aws-sdk-applicationdiscoveryservice", Version: "1.15.0", Locations: types.Locations{{StartLine: 75, EndLine: 77}}},
+		{ID: "aws-sdk-appmesh@1.6.0", Name: "aws-sdk-appmesh", Version: "1.6.0", Locations: types.Locations{{StartLine: 78, EndLine: 80}}},
+		{ID: "aws-sdk-appstream@1.25.0", Name: "aws-sdk-appstream", Version: "1.25.0", Locations: types.Locations{{StartLine: 81, EndLine: 83}}},
+		{ID: "aws-sdk-appsync@1.12.0", Name: "aws-sdk-appsync", Version: "1.12.0", Locations: types.Locations{{StartLine: 84, EndLine: 86}}},
+		{ID: "aws-sdk-athena@1.12.0", Name: "aws-sdk-athena", Version: "1.12.0", Locations: types.Locations{{StartLine: 87, EndLine: 89}}},
+		{ID: "aws-sdk-autoscaling@1.20.0", Name: "aws-sdk-autoscaling", Version: "1.20.0", Locations: types.Locations{{StartLine: 90, EndLine: 92}}},
+		{ID: "aws-sdk-autoscalingplans@1.12.0", Name: "aws-sdk-autoscalingplans", Version: "1.12.0", Locations: types.Locations{{StartLine: 93, EndLine: 95}}},
+		{ID: "aws-sdk-backup@1.3.0", Name: "aws-sdk-backup", Version: "1.3.0", Locations: types.Locations{{StartLine: 96, EndLine: 98}}},
+		{ID: "aws-sdk-batch@1.17.0", Name: "aws-sdk-batch", Version: "1.17.0", Locations: types.Locations{{StartLine: 99, EndLine: 101}}},
+		{ID: "aws-sdk-budgets@1.18.0", Name: "aws-sdk-budgets", Version: "1.18.0", Locations: types.Locations{{StartLine: 102, EndLine: 104}}},
+		{ID: "aws-sdk-chime@1.6.0", Name: "aws-sdk-chime", Version: "1.6.0", Locations: types.Locations{{StartLine: 105, EndLine: 107}}},
+		{ID: "aws-sdk-cloud9@1.11.0", Name: "aws-sdk-cloud9", Version: "1.11.0", Locations: types.Locations{{StartLine: 108, EndLine: 110}}},
+		{ID: "aws-sdk-clouddirectory@1.14.0", Name: "aws-sdk-clouddirectory", Version: "1.14.0", Locations: types.Locations{{StartLine: 111, EndLine: 113}}},
+		{ID: "aws-sdk-cloudformation@1.19.0", Name: "aws-sdk-cloudformation", Version: "1.19.0", Locations: types.Locations{{StartLine: 114, EndLine: 116}}},
+		{ID: "aws-sdk-cloudfront@1.15.0", Name: "aws-sdk-cloudfront", Version: "1.15.0", Locations: types.Locations{{StartLine: 117, EndLine: 119}}},
+		{ID: "aws-sdk-cloudhsm@1.12.0", Name: "aws-sdk-cloudhsm", Version: "1.12.0", Locations: types.Locations{{StartLine: 120, EndLine: 122}}},
+		{ID: "aws-sdk-cloudhsmv2@1.12.0", Name: "aws-sdk-cloudhsmv2", Version: "1.12.0", Locations: types.Locations{{StartLine: 123, EndLine: 125}}},
+		{ID: "aws-sdk-cloudsearch@1.9.0", Name: "aws-sdk-cloudsearch", Version: "1.9.0", Locations: types.Locations{{StartLine: 126, EndLine: 128}}},
+		{ID: "aws-sdk-cloudsearchdomain@1.9.0", Name: "aws-sdk-cloudsearchdomain", Version: "1.9.0", Locations: types.Locations{{StartLine: 129, EndLine: 131}}},
+		{ID: "aws-sdk-cloudtrail@1.11.0", Name: "aws-sdk-cloudtrail", Version: "1.11.0", Locations: types.Locations{{StartLine: 132, EndLine: 134}}},
+		{ID: "aws-sdk-cloudwatch@1.20.0", Name: "aws-sdk-cloudwatch", Version: "1.20.0", Locations: types.Locations{{StartLine: 135, EndLine: 137}}},
+		{ID: "aws-sdk-cloudwatchevents@1.17.0", Name: "aws-sdk-cloudwatchevents", Version: "1.17.0", Locations: types.Locations{{StartLine: 138, EndLine: 140}}},
+		{ID: "aws-sdk-cloudwatchlogs@1.17.0", Name: "aws-sdk-cloudwatchlogs", Version: "1.17.0", Locations: types.Locations{{StartLine: 141, EndLine: 143}}},
+		{ID: "aws-sdk-codebuild@1.32.0", Name: "aws-sdk-codebuild", Version: "1.32.0", Locations: types.Locations{{StartLine: 144, EndLine: 146}}},
+		{ID: "aws-sdk-codecommit@1.17.0", Name: "aws-sdk-codecommit", Version: "1.17.0", Locations: types.Locations{{StartLine: 147, EndLine: 149}}},
+		{ID: "aws-sdk-codedeploy@1.18.0", Name: "aws-sdk-codedeploy", Version: "1.18.0", Locations: types.Locations{{StartLine: 150, EndLine: 152}}},
+		{ID: "aws-sdk-codepipeline@1.15.0", Name: "aws-sdk-codepipeline", Version: "1.15.0", Locations: types.Locations{{StartLine: 153, EndLine: 155}}},
+		{ID: "aws-sdk-codestar@1.11.0", Name: "aws-sdk-codestar", Version: "1.11.0", Locations: types.Locations{{StartLine: 156, EndLine: 158}}},
+		{ID: "aws-sdk-cognitoidentity@1.10.0", Name: "aws-sdk-cognitoidentity", Version: "1.10.0", Locations: types.Locations{{StartLine: 159, EndLine: 161}}},
+		{ID: "aws-sdk-cognitoidentityprovider@1.18.0", Name: "aws-sdk-cognitoidentityprovider", Version: "1.18.0", Locations: types.Locations{{StartLine: 162, EndLine: 164}}},
+		{ID: "aws-sdk-cognitosync@1.9.0", Name: "aws-sdk-cognitosync", Version: "1.9.0", Locations: types.Locations{{StartLine: 165, EndLine: 167}}},
+		{ID: "aws-sdk-comprehend@1.18.0", Name: "aws-sdk-comprehend", Version: "1.18.0", Locations: types.Locations{{StartLine: 168, EndLine: 170}}},
+		{ID: "aws-sdk-comprehendmedical@1.3.0", Name: "aws-sdk-comprehendmedical", Version: "1.3.0", Locations: types.Locations{{StartLine: 171, EndLine: 173}}},
+		{ID: "aws-sdk-configservice@1.26.0", Name: "aws-sdk-configservice", Version: "1.26.0", Locations: types.Locations{{StartLine: 174, EndLine: 176}}},
+		{ID: "aws-sdk-connect@1.13.0", Name: "aws-sdk-connect", Version: "1.13.0", Locations: types.Locations{{StartLine: 177, EndLine: 179}}},
+		{ID: "aws-sdk-core@3.48.6", Name: "aws-sdk-core", Version: "3.48.6", Locations: types.Locations{{StartLine: 180, EndLine: 184}}},
+		{ID: "aws-sdk-costandusagereportservice@1.10.0", Name: "aws-sdk-costandusagereportservice", Version: "1.10.0", Locations: types.Locations{{StartLine: 185, EndLine: 187}}},
+		{ID: "aws-sdk-costexplorer@1.21.0", Name: "aws-sdk-costexplorer", Version: "1.21.0", Locations: types.Locations{{StartLine: 188, EndLine: 190}}},
+		{ID: "aws-sdk-databasemigrationservice@1.20.0", Name: "aws-sdk-databasemigrationservice", Version: "1.20.0", Locations: types.Locations{{StartLine: 191, EndLine: 193}}},
+		{ID: "aws-sdk-datapipeline@1.9.0", Name: "aws-sdk-datapipeline", Version: "1.9.0", Locations: types.Locations{{StartLine: 194, EndLine: 196}}},
+		{ID: "aws-sdk-datasync@1.3.0", Name: "aws-sdk-datasync", Version: "1.3.0", Locations: types.Locations{{StartLine: 197, EndLine: 199}}},
+		{ID: "aws-sdk-dax@1.11.0", Name: "aws-sdk-dax", Version: "1.11.0", Locations: types.Locations{{StartLine: 200, EndLine: 202}}},
+		{ID: "aws-sdk-devicefarm@1.19.0", Name: "aws-sdk-devicefarm", Version: "1.19.0", Locations: types.Locations{{StartLine: 203, EndLine: 205}}},
+		{ID: "aws-sdk-directconnect@1.16.0", Name: "aws-sdk-directconnect", Version: "1.16.0", Locations: types.Locations{{StartLine: 206, EndLine: 208}}},
+		{ID: "aws-sdk-directoryservice@1.15.0", Name: "aws-sdk-directoryservice", Version: "1.15.0", Locations: types.Locations{{StartLine: 209, EndLine: 211}}},
+		{ID: "aws-sdk-dlm@1.11.0", Name: "aws-sdk-dlm", Version: "1.11.0", Locations: types.Locations{{StartLine: 212, EndLine: 214}}},
+		{ID: "aws-sdk-docdb@1.4.0", Name: "aws-sdk-docdb", Version: "1.4.0", Locations: types.Locations{{StartLine: 215, EndLine: 217}}},
+		{ID: "aws-sdk-dynamodb@1.26.0", Name: "aws-sdk-dynamodb", Version: "1.26.0", Locations: types.Locations{{StartLine: 218, EndLine: 220}}},
+		{ID: "aws-sdk-dynamodbstreams@1.9.0", Name: "aws-sdk-dynamodbstreams", Version: "1.9.0", Locations: types.Locations{{StartLine: 221, EndLine: 223}}},
+		{ID: "aws-sdk-ec2@1.80.0", Name: "aws-sdk-ec2", Version: "1.80.0", Locations: types.Locations{{StartLine: 224, EndLine: 226}}},
+		{ID: "aws-sdk-ecr@1.14.0", Name: "aws-sdk-ecr", Version: "1.14.0", Locations: types.Locations{{StartLine: 227, EndLine: 229}}},
+		{ID: "aws-sdk-ecs@1.36.0", Name: "aws-sdk-ecs", Version: "1.36.0", Locations: types.Locations{{StartLine: 230, EndLine: 232}}},
+		{ID: "aws-sdk-efs@1.13.0", Name: "aws-sdk-efs", Version: "1.13.0", Locations: types.Locations{{StartLine: 233, EndLine: 235}}},
+		{ID: "aws-sdk-eks@1.15.0", Name: "aws-sdk-eks", Version: "1.15.0", Locations: types.Locations{{StartLine: 236, EndLine: 238}}},
+		{ID: "aws-sdk-elasticache@1.14.0", Name: "aws-sdk-elasticache", Version: "1.14.0", Locations: types.Locations{{StartLine: 239, EndLine: 241}}},
+		{ID: "aws-sdk-elasticbeanstalk@1.19.0", Name: "aws-sdk-elasticbeanstalk", Version: "1.19.0", Locations: types.Locations{{StartLine: 242, EndLine: 244}}},
+		{ID: "aws-sdk-elasticloadbalancing@1.12.0", Name: "aws-sdk-elasticloadbalancing", Version: "1.12.0", Locations: types.Locations{{StartLine: 245, EndLine: 247}}},
+		{ID: "aws-sdk-elasticloadbalancingv2@1.26.0", Name: "aws-sdk-elasticloadbalancingv2", Version: "1.26.0", Locations: types.Locations{{StartLine: 248, EndLine: 250}}},
+		{ID: "aws-sdk-elasticsearchservice@1.19.0", Name: "aws-sdk-elasticsearchservice", Version: "1.19.0", Locations: types.Locations{{StartLine: 251, EndLine: 253}}},
+		{ID: "aws-sdk-elastictranscoder@1.11.0", Name: "aws-sdk-elastictranscoder", Version: "1.11.0", Locations: types.Locations{{StartLine: 254, EndLine: 256}}},
+		{ID: "aws-sdk-emr@1.14.0", Name: "aws-sdk-emr", Version: "1.14.0", Locations: types.Locations{{StartLine: 257, EndLine: 259}}},
+		{ID: "aws-sdk-firehose@1.14.0", Name: "aws-sdk-firehose", Version: "1.14.0", Locations: types.Locations{{StartLine: 260, EndLine: 262}}},
+		{ID: "aws-sdk-fms@1.12.0", Name: "aws-sdk-fms", Version: "1.12.0", Locations: types.Locations{{StartLine: 263, EndLine: 265}}},
+		{ID: "aws-sdk-fsx@1.4.0", Name: "aws-sdk-fsx", Version: "1.4.0", Locations: types.Locations{{StartLine: 266, EndLine: 268}}},
+		{ID: "aws-sdk-gamelift@1.16.0", Name: "aws-sdk-gamelift", Version: "1.16.0", Locations: types.Locations{{StartLine: 269, EndLine: 271}}},
+		{ID: "aws-sdk-glacier@1.18.0", Name: "aws-sdk-glacier", Version: "1.18.0", Locations: types.Locations{{StartLine: 272, EndLine: 274}}},
+		{ID: "aws-sdk-globalaccelerator@1.4.0", Name: "aws-sdk-globalaccelerator", Version: "1.4.0", Locations: types.Locations{{StartLine: 275, EndLine: 277}}},
+		{ID: "aws-sdk-glue@1.30.0", Name: "aws-sdk-glue", Version: "1.30.0", Locations: types.Locations{{StartLine: 278, EndLine: 280}}},
+		{ID: "aws-sdk-greengrass@1.17.0", Name: "aws-sdk-greengrass", Version: "1.17.0", Locations: types.Locations{{StartLine: 281, EndLine: 283}}},
+		{ID: "aws-sdk-guardduty@1.14.0", Name: "aws-sdk-guardduty", Version: "1.14.0", Locations: types.Locations{{StartLine: 284, EndLine: 286}}},
+		{ID: "aws-sdk-health@1.12.0", Name: "aws-sdk-health", Version: "1.12.0", Locations: types.Locations{{StartLine: 287, EndLine: 289}}},
+		{ID: "aws-sdk-iam@1.19.0", Name: "aws-sdk-iam", Version: "1.19.0", Locations: types.Locations{{StartLine: 290, EndLine: 292}}},
+		{ID: "aws-sdk-importexport@1.9.0", Name: "aws-sdk-importexport", Version: "1.9.0", Locations: types.Locations{{StartLine: 293, EndLine: 295}}},
+		{ID: "aws-sdk-inspector@1.16.0", Name: "aws-sdk-inspector", Version: "1.16.0", Locations: types.Locations{{StartLine: 296, EndLine: 298}}},
+		{ID: "aws-sdk-iot@1.29.0", Name: "aws-sdk-iot", Version: "1.29.0", Locations: types.Locations{{StartLine: 299, EndLine: 301}}},
+		{ID: "aws-sdk-iot1clickdevicesservice@1.11.0", Name: "aws-sdk-iot1clickdevicesservice", Version: "1.11.0", Locations: types.Locations{{StartLine: 302, EndLine: 304}}},
+		{ID: "aws-sdk-iot1clickprojects@1.10.0", Name: "aws-sdk-iot1clickprojects", Version: "1.10.0", Locations: types.Locations{{StartLine: 305, EndLine: 307}}},
+		{ID: "aws-sdk-iotanalytics@1.16.0", Name: "aws-sdk-iotanalytics", Version: "1.16.0", Locations: types.Locations{{StartLine: 308, EndLine: 310}}},
+		{ID: "aws-sdk-iotdataplane@1.9.0", Name: "aws-sdk-iotdataplane", Version: "1.9.0", Locations: types.Locations{{StartLine: 311, EndLine: 313}}},
+		{ID: "aws-sdk-iotjobsdataplane@1.10.0", Name: "aws-sdk-iotjobsdataplane", Version: "1.10.0", Locations: types.Locations{{StartLine: 314, EndLine: 316}}},
+		{ID: "aws-sdk-kafka@1.4.0", Name: "aws-sdk-kafka", Version: "1.4.0", Locations: types.Locations{{StartLine: 317, EndLine: 319}}},
+		{ID: "aws-sdk-kinesis@1.13.1", Name: "aws-sdk-kinesis", Version: "1.13.1", Locations: types.Locations{{StartLine: 320, EndLine: 322}}},
+		{ID: "aws-sdk-kinesisanalytics@1.12.0", Name: "aws-sdk-kinesisanalytics", Version: "1.12.0", Locations: types.Locations{{StartLine: 323, EndLine: 325}}},
+		{ID: "aws-sdk-kinesisanalyticsv2@1.3.0", Name: "aws-sdk-kinesisanalyticsv2", Version: "1.3.0", Locations: types.Locations{{StartLine: 326, EndLine: 328}}},
+		{ID: "aws-sdk-kinesisvideo@1.12.0", Name: "aws-sdk-kinesisvideo", Version: "1.12.0", Locations: types.Locations{{StartLine: 329, EndLine: 331}}},
+		{ID: "aws-sdk-kinesisvideoarchivedmedia@1.11.0", Name: "aws-sdk-kinesisvideoarchivedmedia", Version: "1.11.0", Locations: types.Locations{{StartLine: 332, EndLine: 334}}},
+		{ID: "aws-sdk-kinesisvideomedia@1.10.0", Name: "aws-sdk-kinesisvideomedia", Version: "1.10.0", Locations: types.Locations{{StartLine: 335, EndLine: 337}}},
+		{ID: "aws-sdk-kms@1.17.0", Name: "aws-sdk-kms", Version: "1.17.0", Locations: types.Locations{{StartLine: 338, EndLine: 340}}},
+		{ID: "aws-sdk-lambda@1.22.0", Name: "aws-sdk-lambda", Version: "1.22.0", Locations: types.Locations{{StartLine: 341, EndLine: 343}}},
+		{ID: "aws-sdk-lambdapreview@1.9.0", Name: "aws-sdk-lambdapreview", Version: "1.9.0", Locations: types.Locations{{StartLine: 344, EndLine: 346}}},
+		{ID: "aws-sdk-lex@1.12.0", Name: "aws-sdk-lex", Version: "1.12.0", Locations: types.Locations{{StartLine: 347, EndLine: 349}}},
+		{ID: "aws-sdk-lexmodelbuildingservice@1.15.0", Name: "aws-sdk-lexmodelbuildingservice", Version: "1.15.0", Locations: types.Locations{{StartLine: 350, EndLine: 352}}},
+		{ID: "aws-sdk-licensemanager@1.3.0", Name: "aws-sdk-licensemanager", Version: "1.3.0", Locations: types.Locations{{StartLine: 353, EndLine: 355}}},
+		{ID: "aws-sdk-lightsail@1.18.0", Name: "aws-sdk-lightsail", Version: "1.18.0", Locations: types.Locations{{StartLine: 356, EndLine: 358}}},
+		{ID: "aws-sdk-machinelearning@1.10.0", Name: "aws-sdk-machinelearning", Version: "1.10.0", Locations: types.Locations{{StartLine: 359, EndLine: 361}}},
+		{ID: "aws-sdk-macie@1.9.0", Name: "aws-sdk-macie", Version: "1.9.0", Locations: types.Locations{{StartLine: 362, EndLine: 364}}},
+		{ID: "aws-sdk-marketplacecommerceanalytics@1.9.0", Name: "aws-sdk-marketplacecommerceanalytics", Version: "1.9.0", Locations: types.Locations{{StartLine: 365, EndLine: 367}}},
+		{ID: "aws-sdk-marketplaceentitlementservice@1.9.0", Name: "aws-sdk-marketplaceentitlementservice", Version: "1.9.0", Locations: types.Locations{{StartLine: 368, EndLine: 370}}},
+		{ID: "aws-sdk-marketplacemetering@1.11.0", Name: "aws-sdk-marketplacemetering", Version: "1.11.0", Locations: types.Locations{{StartLine: 371, EndLine: 373}}},
+		{ID: "aws-sdk-mediaconnect@1.5.0", Name: "aws-sdk-mediaconnect", Version: "1.5.0", Locations: types.Locations{{StartLine: 374, EndLine: 376}}},
+		{ID: "aws-sdk-mediaconvert@1.25.0", Name: "aws-sdk-mediaconvert", Version: "1.25.0", Locations: types.Locations{{StartLine: 377, EndLine: 379}}},
+		{ID: "aws-sdk-medialive@1.28.0", Name: "aws-sdk-medialive", Version: "1.28.0", Locations: types.Locations{{StartLine: 380, EndLine: 382}}},
+		{ID: "aws-sdk-mediapackage@1.15.0", Name: "aws-sdk-mediapackage", Version: "1.15.0", Locations: types.Locations{{StartLine: 383, EndLine: 385}}},
+		{ID: "aws-sdk-mediastore@1.12.0", Name: "aws-sdk-mediastore", Version: "1.12.0", Locations: types.Locations{{StartLine: 386, EndLine: 388}}},
+		{ID: "aws-sdk-mediastoredata@1.11.0", Name: "aws-sdk-mediastoredata", Version: "1.11.0", Locations: types.Locations{{StartLine: 389, EndLine: 391}}},
+		{ID: "aws-sdk-mediatailor@1.14.0", Name: "aws-sdk-mediatailor", Version: "1.14.0", Locations: types.Locations{{StartLine: 392, EndLine: 394}}},
+		{ID: "aws-sdk-migrationhub@1.11.0", Name: "aws-sdk-migrationhub", Version: "1.11.0", Locations: types.Locations{{StartLine: 395, EndLine: 397}}},
+		{ID: "aws-sdk-mobile@1.9.0", Name: "aws-sdk-mobile", Version: "1.9.0", Locations: types.Locations{{StartLine: 398, EndLine: 400}}},
+		{ID: "aws-sdk-mq@1.13.0", Name: "aws-sdk-mq", Version: "1.13.0", Locations: types.Locations{{StartLine: 401, EndLine: 403}}},
+		{ID: "aws-sdk-mturk@1.12.0", Name: "aws-sdk-mturk", Version: "1.12.0", Locations: types.Locations{{StartLine: 404, EndLine: 406}}},
+		{ID: "aws-sdk-neptune@1.11.0", Name: "aws-sdk-neptune", Version: "1.11.0", Locations: types.Locations{{StartLine: 407, EndLine: 409}}},
+		{ID: "aws-sdk-opsworks@1.13.0", Name: "aws-sdk-opsworks", Version: "1.13.0", Locations: types.Locations{{StartLine: 410, EndLine: 412}}},
+		{ID: "aws-sdk-opsworkscm@1.16.0", Name: "aws-sdk-opsworkscm", Version: "1.16.0", Locations: types.Locations{{StartLine: 413, EndLine: 415}}},
+		{ID: "aws-sdk-organizations@1.24.0", Name: "aws-sdk-organizations", Version: "1.24.0", Locations: types.Locations{{StartLine: 416, EndLine: 418}}},
+		{ID: "aws-sdk-pi@1.9.0", Name: "aws-sdk-pi", Version: "1.9.0", Locations: types.Locations{{StartLine: 419, EndLine: 421}}},
+		{ID: "aws-sdk-pinpoint@1.19.0", Name: "aws-sdk-pinpoint", Version: "1.19.0", Locations: types.Locations{{StartLine: 422, EndLine: 424}}},
+		{ID: "aws-sdk-pinpointemail@1.6.0", Name: "aws-sdk-pinpointemail", Version: "1.6.0", Locations: types.Locations{{StartLine: 425, EndLine: 427}}},
+		{ID: "aws-sdk-pinpointsmsvoice@1.6.0", Name: "aws-sdk-pinpointsmsvoice", Version: "1.6.0", Locations: types.Locations{{StartLine: 428, EndLine: 430}}},
+		{ID: "aws-sdk-polly@1.19.0", Name: "aws-sdk-polly", Version: "1.19.0", Locations: types.Locations{{StartLine: 431, EndLine: 433}}},
+		{ID: "aws-sdk-pricing@1.9.0", Name: "aws-sdk-pricing", Version: "1.9.0", Locations: types.Locations{{StartLine: 434, EndLine: 436}}},
+		{ID: "aws-sdk-quicksight@1.5.0", Name: "aws-sdk-quicksight", Version: "1.5.0", Locations: types.Locations{{StartLine: 437, EndLine: 439}}},
+		{ID: "aws-sdk-ram@1.4.0", Name: "aws-sdk-ram", Version: "1.4.0", Locations: types.Locations{{StartLine: 440, EndLine: 442}}},
+		{ID: "aws-sdk-rds@1.50.0", Name: "aws-sdk-rds", Version: "1.50.0", Locations: types.Locations{{StartLine: 443, EndLine: 445}}},
+		{ID: "aws-sdk-rdsdataservice@1.4.0", Name: "aws-sdk-rdsdataservice", Version: "1.4.0", Locations: types.Locations{{StartLine: 446, EndLine: 448}}},
+		{ID: "aws-sdk-redshift@1.23.0", Name: "aws-sdk-redshift", Version: "1.23.0", Locations: types.Locations{{StartLine: 449, EndLine: 451}}},
+		{ID: "aws-sdk-rekognition@1.22.0", Name: "aws-sdk-rekognition", Version: "1.22.0", Locations: types.Locations{{StartLine: 452, EndLine: 454}}},
+		{ID: "aws-sdk-resourcegroups@1.14.0", Name: "aws-sdk-resourcegroups", Version: "1.14.0", Locations: types.Locations{{StartLine: 455, EndLine: 457}}},
+		{ID: "aws-sdk-resourcegroupstaggingapi@1.9.0", Name: "aws-sdk-resourcegroupstaggingapi", Version: "1.9.0", Locations: types.Locations{{StartLine: 458, EndLine: 460}}},
+		{ID: "aws-sdk-resources@3.41.0", Name: "aws-sdk-resources", Version: "3.41.0", Locations: types.Locations{{StartLine: 461, EndLine: 634}}},
+		{ID: "aws-sdk-robomaker@1.5.0", Name: "aws-sdk-robomaker", Version: "1.5.0", Locations: types.Locations{{StartLine: 635, EndLine: 637}}},
+		{ID: "aws-sdk-route53@1.22.0", Name: "aws-sdk-route53", Version: "1.22.0", Locations: types.Locations{{StartLine: 638, EndLine: 640}}},
+		{ID: "aws-sdk-route53domains@1.11.0", Name: "aws-sdk-route53domains", Version: "1.11.0", Locations: types.Locations{{StartLine: 641, EndLine: 643}}},
+		{ID: "aws-sdk-route53resolver@1.4.0", Name: "aws-sdk-route53resolver", Version: "1.4.0", Locations: types.Locations{{StartLine: 644, EndLine: 646}}},
+		{ID: "aws-sdk-s3@1.36.1", Name: "aws-sdk-s3", Version: "1.36.1", Locations: types.Locations{{StartLine: 647, EndLine: 650}}},
+		{ID: "aws-sdk-s3control@1.4.0", Name: "aws-sdk-s3control", Version: "1.4.0", Locations: types.Locations{{StartLine: 651, EndLine: 653}}},
+		{ID: "aws-sdk-sagemaker@1.33.0", Name: "aws-sdk-sagemaker", Version: "1.33.0", Locations: types.Locations{{StartLine: 654, EndLine: 656}}},
+		{ID: "aws-sdk-sagemakerruntime@1.10.0", Name: "aws-sdk-sagemakerruntime", Version: "1.10.0", Locations: types.Locations{{StartLine: 657, EndLine: 659}}},
+		{ID: "aws-sdk-secretsmanager@1.24.0", Name: "aws-sdk-secretsmanager", Version: "1.24.0", Locations: types.Locations{{StartLine: 660, EndLine: 662}}},
+		{ID: "aws-sdk-securityhub@1.4.0", Name: "aws-sdk-securityhub", Version: "1.4.0", Locations: types.Locations{{StartLine: 663, EndLine: 665}}},
+		{ID: "aws-sdk-serverlessapplicationrepository@1.15.0", Name: "aws-sdk-serverlessapplicationrepository", Version: "1.15.0", Locations: types.Locations{{StartLine: 666, EndLine: 668}}},
+		{ID: "aws-sdk-servicecatalog@1.20.0", Name: "aws-sdk-servicecatalog", Version: "1.20.0", Locations: types.Locations{{StartLine: 669, EndLine: 671}}},
+		{ID: "aws-sdk-servicediscovery@1.12.0", Name: "aws-sdk-servicediscovery", Version: "1.12.0", Locations: types.Locations{{StartLine: 672, EndLine: 674}}},
+		{ID: "aws-sdk-ses@1.18.0", Name: "aws-sdk-ses", Version: "1.18.0", Locations: types.Locations{{StartLine: 675, EndLine: 677}}},
+		{ID: "aws-sdk-shield@1.13.0", Name: "aws-sdk-shield", Version: "1.13.0", Locations: types.Locations{{StartLine: 678, EndLine: 680}}},
+		{ID: "aws-sdk-signer@1.9.0", Name: "aws-sdk-signer", Version: "1.9.0", Locations: types.Locations{{StartLine: 681, EndLine: 683}}},
+		{ID: "aws-sdk-simpledb@1.9.0", Name: "aws-sdk-simpledb", Version: "1.9.0", Locations: types.Locations{{StartLine: 684, EndLine: 686}}},
+		{ID: "aws-sdk-sms@1.10.0", Name: "aws-sdk-sms", Version: "1.10.0", Locations: types.Locations{{StartLine: 687, EndLine: 689}}},
+		{ID: "aws-sdk-snowball@1.14.0", Name: "aws-sdk-snowball", Version: "1.14.0", Locations: types.Locations{{StartLine: 690, EndLine: 692}}},
+		{ID: "aws-sdk-sns@1.13.0", Name: "aws-sdk-sns", Version: "1.13.0", Locations: types.Locations{{StartLine: 693, EndLine: 695}}},
+		{ID: "aws-sdk-sqs@1.13.0", Name: "aws-sdk-sqs", Version: "1.13.0", Locations: types.Locations{{StartLine: 696, EndLine: 698}}},
+		{ID: "aws-sdk-ssm@1.43.0", Name: "aws-sdk-ssm", Version: "1.43.0", Locations: types.Locations{{StartLine: 699, EndLine: 701}}},
+		{ID: "aws-sdk-states@1.14.0", Name: "aws-sdk-states", Version: "1.14.0", Locations: types.Locations{{StartLine: 702, EndLine: 704}}},
+		{ID: "aws-sdk-storagegateway@1.21.0", Name: "aws-sdk-storagegateway", Version: "1.21.0", Locations: types.Locations{{StartLine: 705, EndLine: 707}}},
+		{ID: "aws-sdk-support@1.9.0", Name: "aws-sdk-support", Version: "1.9.0", Locations: types.Locations{{StartLine: 708, EndLine: 710}}},
+		{ID: "aws-sdk-swf@1.9.0", Name: "aws-sdk-swf", Version: "1.9.0", Locations: types.Locations{{StartLine: 711, EndLine: 713}}},
+		{ID: "aws-sdk-textract@1.4.0", Name: "aws-sdk-textract", Version: "1.4.0", Locations: types.Locations{{StartLine: 714, EndLine: 716}}},
+		{ID: "aws-sdk-transcribeservice@1.19.0", Name: "aws-sdk-transcribeservice", Version: "1.19.0", Locations: types.Locations{{StartLine: 717, EndLine: 719}}},
+		{ID: "aws-sdk-transcribestreamingservice@1.2.0", Name: "aws-sdk-transcribestreamingservice", Version: "1.2.0", Locations: types.Locations{{StartLine: 720, EndLine: 722}}},
+		{ID: "aws-sdk-transfer@1.5.0", Name: "aws-sdk-transfer", Version: "1.5.0", Locations: types.Locations{{StartLine: 723, EndLine: 725}}},
+		{ID: "aws-sdk-translate@1.11.0", Name: "aws-sdk-translate", Version: "1.11.0", Locations: types.Locations{{StartLine: 726, EndLine: 728}}},
+		{ID: "aws-sdk-waf@1.16.0", Name: "aws-sdk-waf", Version: "1.16.0", Locations: types.Locations{{StartLine: 729, EndLine: 731}}},
+		{ID: "aws-sdk-wafregional@1.17.0", Name: "aws-sdk-wafregional", Version: "1.17.0", Locations: types.Locations{{StartLine: 732, EndLine: 734}}},
+		{ID: "aws-sdk-workdocs@1.12.0", Name: "aws-sdk-workdocs", Version: "1.12.0", Locations: types.Locations{{StartLine: 735, EndLine: 737}}},
+		{ID: "aws-sdk-worklink@1.4.0", Name: "aws-sdk-worklink", Version: "1.4.0", Locations: types.Locations{{StartLine: 738, EndLine: 740}}},
+		{ID: "aws-sdk-workmail@1.11.0", Name: "aws-sdk-workmail", Version: "1.11.0", Locations: types.Locations{{StartLine: 741, EndLine: 743}}},
+		{ID: "aws-sdk-workspaces@1.19.0", Name: "aws-sdk-workspaces", Version: "1.19.0", Locations: types.Locations{{StartLine: 744, EndLine: 746}}},
+		{ID: "aws-sdk-xray@1.13.0", Name: "aws-sdk-xray", Version: "1.13.0", Locations: types.Locations{{StartLine: 747, EndLine: 749}}},
+		{ID: "aws-sigv2@1.0.1", Name: "aws-sigv2", Version: "1.0.1", Locations: types.Locations{{StartLine: 750, EndLine: 750}}},
+		{ID: "aws-sigv4@1.1.0", Name: "aws-sigv4", Version: "1.1.0", Locations: types.Locations{{StartLine: 751, EndLine: 752}}},
+		{ID: "builder@3.2.3", Name: "builder", Version: "3.2.3", Locations: types.Locations{{StartLine: 753, EndLine: 753}}},
+		{ID: "coderay@1.1.2", Name: "coderay", Version: "1.1.2", Locations: types.Locations{{StartLine: 754, EndLine: 754}}},
+		{ID: "concurrent-ruby@1.1.5", Name: "concurrent-ruby", Version: "1.1.5", Locations: types.Locations{{StartLine: 755, EndLine: 755}}},
+		{ID: "crass@1.0.4", Name: "crass", Version: "1.0.4", Locations: types.Locations{{StartLine: 756, EndLine: 756}}},
+		{ID: "dotenv@2.7.2", Name: "dotenv", Version: "2.7.2", Locations: types.Locations{{StartLine: 757, EndLine: 757}}},
+		{ID: "erubi@1.8.0", Name: "erubi", Version: "1.8.0", Locations: types.Locations{{StartLine: 758, EndLine: 758}}},
+		{ID: "faker@1.9.3", Name: "faker", Version: "1.9.3", Locations: types.Locations{{StartLine: 759, EndLine: 760}}},
+		{ID: "faraday@0.15.4", Name: "faraday", Version: "0.15.4", Locations: types.Locations{{StartLine: 761, EndLine: 762}}},
+		{ID: "ffi@1.10.0", Name: "ffi", Version: "1.10.0", Locations: types.Locations{{StartLine: 763, EndLine: 763}}},
+		{ID: "globalid@0.4.2", Name: "globalid", Version: "0.4.2", Locations: types.Locations{{StartLine: 764, EndLine: 765}}},
+		{ID: "i18n@1.6.0", Name: "i18n", Version: "1.6.0", Locations: types.Locations{{StartLine: 766, EndLine: 767}}},
+		{ID: "jaro_winkler@1.5.2", Name: "jaro_winkler", Version: "1.5.2", Locations: types.Locations{{StartLine: 768, EndLine: 768}}},
+		{ID: "jmespath@1.4.0", Name: "jmespath", Version: "1.4.0", Locations: types.Locations{{StartLine: 769, EndLine: 769}}},
+		{ID: "json@2.2.0", Name: "json", Version: "2.2.0", Locations: types.Locations{{StartLine: 770, EndLine: 770}}},
+		{ID: "loofah@2.2.3", Name: "loofah", Version: "2.2.3", Locations: types.Locations{{StartLine: 771, EndLine: 773}}},
+		{ID: "mail@2.7.1", Name: "mail", Version: "2.7.1", Locations: types.Locations{{StartLine: 774, EndLine: 775}}},
+		{ID: "marcel@0.3.3", Name: "marcel", Version: "0.3.3", Locations: types.Locations{{StartLine: 776, EndLine: 777}}},
+		{ID: "method_source@0.9.2", Name: "method_source", Version: "0.9.2", Locations: types.Locations{{StartLine: 778, EndLine: 778}}},
+		{ID: "mimemagic@0.3.3", Name: "mimemagic", Version: "0.3.3", Locations: types.Locations{{StartLine: 779, EndLine: 779}}},
+		{ID: "mini_mime@1.0.1", Name: "mini_mime", Version: "1.0.1", Locations: types.Locations{{StartLine: 780, EndLine: 780}}},
+		{ID: "mini_portile2@2.4.0", Name: "mini_portile2", Version: "2.4.0", Locations: types.Locations{{StartLine: 781, EndLine: 781}}},
+		{ID: "minitest@5.11.3", Name: "minitest", Version: "5.11.3", Locations: types.Locations{{StartLine: 782, EndLine: 782}}},
+		{ID: "multi_json@1.13.1", Name: "multi_json", Version: "1.13.1", Locations: types.Locations{{StartLine: 783, EndLine: 783}}},
+		{ID: "multipart-post@2.0.0", Name: "multipart-post", Version: "2.0.0", Locations: types.Locations{{StartLine: 784, EndLine: 784}}},
+		{ID: "mustermann@1.0.3", Name: "mustermann", Version: "1.0.3", Locations: types.Locations{{StartLine: 785, EndLine: 785}}},
+		{ID: "nio4r@2.3.1", Name: "nio4r", Version: "2.3.1", Locations: types.Locations{{StartLine: 786, EndLine: 786}}},
+		{ID: "nokogiri@1.10.3", Name: "nokogiri", Version: "1.10.3", Locations: types.Locations{{StartLine: 787, EndLine: 788}}},
+		{ID: "parallel@1.17.0", Name: "parallel", Version: "1.17.0", Locations: types.Locations{{StartLine: 789, EndLine: 789}}},
+		{ID: "parser@2.6.3.0", Name: "parser", Version: "2.6.3.0", Locations: types.Locations{{StartLine: 790, EndLine: 791}}},
+		{ID: "pry@0.12.2", Name: "pry", Version: "0.12.2", Locations: types.Locations{{StartLine: 792, EndLine: 794}}},
+		{ID: "psych@3.1.0", Name: "psych", Version: "3.1.0", Locations: types.Locations{{StartLine: 795, EndLine: 795}}},
+		{ID: "rack@2.0.7", Name: "rack", Version: "2.0.7", Locations: types.Locations{{StartLine: 796, EndLine: 796}}},
+		{ID: "rack-protection@2.0.5", Name: "rack-protection", Version: "2.0.5", Locations: types.Locations{{StartLine: 797, EndLine: 798}}},
+		{ID: "rack-test@1.1.0", Name: "rack-test", Version: "1.1.0", Locations: types.Locations{{StartLine: 799, EndLine: 800}}},
+		{ID: "rails@5.2.3", Name: "rails", Version: "5.2.3", Locations: types.Locations{{StartLine: 801, EndLine: 813}}},
+		{ID: "rails-dom-testing@2.0.3", Name: "rails-dom-testing", Version: "2.0.3", Locations: types.Locations{{StartLine: 814, EndLine: 816}}},
+		{ID: "rails-html-sanitizer@1.0.4", Name: "rails-html-sanitizer", Version: "1.0.4", Locations: types.Locations{{StartLine: 817, EndLine: 818}}},
+		{ID: "railties@5.2.3", Name: "railties", Version: "5.2.3", Locations: types.Locations{{StartLine: 819, EndLine: 824}}},
+		{ID: "rainbow@3.0.0", Name: "rainbow", Version: "3.0.0", Locations: types.Locations{{StartLine: 825, EndLine: 825}}},
+		{ID: "rake@12.3.2", Name: "rake", Version: "12.3.2", Locations: types.Locations{{StartLine: 826, EndLine: 826}}},
+		{ID: "rb-fsevent@0.10.3", Name: "rb-fsevent", Version: "0.10.3", Locations: types.Locations{{StartLine: 827, EndLine: 827}}},
+		{ID: "rb-inotify@0.10.0", Name: "rb-inotify", Version: "0.10.0", Locations: types.Locations{{StartLine: 828, EndLine: 829}}},
+		{ID: "rubocop@0.67.2", Name: "rubocop", Version: "0.67.2", Locations: types.Locations{{StartLine: 830, EndLine: 837}}},
+		{ID: "ruby-progressbar@1.10.0", Name: "ruby-progressbar", Version: "1.10.0", Locations: types.Locations{{StartLine: 838, EndLine: 838}}},
+		{ID: "sass@3.7.4", Name: "sass", Version: "3.7.4", Locations: types.Locations{{StartLine: 839, EndLine: 840}}},
+		{ID: "sass-listen@4.0.0", Name: "sass-listen", Version: "4.0.0", Locations: types.Locations{{StartLine: 841, EndLine: 843}}},
+		{ID: "sinatra@2.0.5", Name: "sinatra", Version: "2.0.5", Locations: types.Locations{{StartLine: 844, EndLine: 848}}},
+		{ID: "sprockets@3.7.2", Name: "sprockets", Version: "3.7.2", Locations: types.Locations{{StartLine: 849, EndLine: 851}}},
+		{ID: "sprockets-rails@3.2.1", Name: "sprockets-rails", Version: "3.2.1", Locations: types.Locations{{StartLine: 852, EndLine: 855}}},
+		{ID: "thor@0.20.3", Name: "thor", Version: "0.20.3", Locations: types.Locations{{StartLine: 856, EndLine: 856}}},
+		{ID: "thread_safe@0.3.6", Name: "thread_safe", Version: "0.3.6", Locations: types.Locations{{StartLine: 857, EndLine: 857}}},
+		{ID: "tilt@2.0.9", Name: "tilt", Version: "2.0.9", Locations: types.Locations{{StartLine: 858, EndLine: 858}}},
+		{ID: "tzinfo@1.2.5", Name: "tzinfo", Version: "1.2.5", Locations: types.Locations{{StartLine: 859, EndLine: 860}}},
+		{ID: "unicode-display_width@1.5.0", Name: "unicode-display_width", Version: "1.5.0", Locations: types.Locations{{StartLine: 861, EndLine: 861}}},
+		{ID: "websocket-driver@0.7.0", Name: "websocket-driver", Version: "0.7.0", Locations: types.Locations{{StartLine: 862, EndLine: 863}}},
+		{ID: "websocket-extensions@0.1.3", Name: "websocket-extensions", Version: "0.1.3", Locations: types.Locations{{StartLine: 864, EndLine: 864}}},
+	}
+
+	BundlerNormalDeps = []types.Dependency{
+		{ID: "faker@1.9.3", DependsOn: []string{"i18n@1.6.0"}},
+		{ID: "i18n@1.6.0", DependsOn: []string{"concurrent-ruby@1.1.5"}},
+		{ID: "parser@2.6.3.0", DependsOn: []string{"ast@2.4.0"}},
+		{ID: "pry@0.12.2", DependsOn: []string{"coderay@1.1.2", "method_source@0.9.2"}},
+		{
+			ID: "rubocop@0.67.2",
+			DependsOn: []string{
+				"jaro_winkler@1.5.2",
+				"parallel@1.17.0",
+				"parser@2.6.3.0",
+				"psych@3.1.0",
+				"rainbow@3.0.0",
+				"ruby-progressbar@1.10.0",
+				"unicode-display_width@1.5.0",
+			},
+		},
+	}
+
+	BundlerRailsDeps = []types.Dependency{
+		{ID: "actioncable@5.2.3", DependsOn: []string{"actionpack@5.2.3", "nio4r@2.3.1", "websocket-driver@0.7.0"}},
+		{
+			ID: "actionmailer@5.2.3",
+			DependsOn: []string{
+				"actionpack@5.2.3",
+				"actionview@5.2.3",
+				"activejob@5.2.3",
+				"mail@2.7.1",
+				"rails-dom-testing@2.0.3",
+			},
+		},
+		{
+			ID: "actionpack@5.2.3",
+			DependsOn: []string{
+				"actionview@5.2.3",
+				"activesupport@5.2.3",
+				"rack-test@1.1.0",
+				"rack@2.0.7",
+				"rails-dom-testing@2.0.3",
+				"rails-html-sanitizer@1.0.4",
+			},
+		},
+		{
+			ID: "actionview@5.2.3",
+			DependsOn: []string{
+				"activesupport@5.2.3",
+				"builder@3.2.3",
+				"erubi@1.8.0",
+				"rails-dom-testing@2.0.3",
+				"rails-html-sanitizer@1.0.4",
+			},
+		},
+		{ID: "activejob@5.2.3", DependsOn: []string{"activesupport@5.2.3", "globalid@0.4.2"}},
+		{ID: "activemodel@5.2.3", DependsOn: []string{"activesupport@5.2.3"}},
+		{ID: "activerecord@5.2.3", DependsOn: []string{"activemodel@5.2.3", "activesupport@5.2.3", "arel@9.0.0"}},
+		{ID: "activestorage@5.2.3", DependsOn: []string{"actionpack@5.2.3", "activerecord@5.2.3", "marcel@0.3.3"}},
+		{
+			ID: "activesupport@5.2.3",
+			DependsOn: []string{
+				"concurrent-ruby@1.1.5",
+				"i18n@1.6.0",
+				"minitest@5.11.3",
+				"tzinfo@1.2.5",
+			},
+		},
+		{ID: "faker@1.9.3", DependsOn: []string{"i18n@1.6.0"}},
+		{ID: "globalid@0.4.2", DependsOn: []string{"activesupport@5.2.3"}},
+		{ID: "i18n@1.6.0", DependsOn: []string{"concurrent-ruby@1.1.5"}},
+		{ID: "loofah@2.2.3", DependsOn: []string{"crass@1.0.4", "nokogiri@1.10.3"}},
+		{ID: "mail@2.7.1", DependsOn: []string{"mini_mime@1.0.1"}},
+		{ID: "marcel@0.3.3", DependsOn: []string{"mimemagic@0.3.3"}},
+		{ID: "nokogiri@1.10.3", DependsOn: []string{"mini_portile2@2.4.0"}},
+		{ID: "parser@2.6.3.0", DependsOn: []string{"ast@2.4.0"}},
+		{ID: "pry@0.12.2", DependsOn: []string{"coderay@1.1.2", "method_source@0.9.2"}},
+		{ID: "rack-test@1.1.0", DependsOn: []string{"rack@2.0.7"}},
+		{ID: "rails-dom-testing@2.0.3", DependsOn: []string{"activesupport@5.2.3", "nokogiri@1.10.3"}},
+		{ID: "rails-html-sanitizer@1.0.4", DependsOn: []string{"loofah@2.2.3"}},
+		{
+			ID: "rails@5.2.3",
+			DependsOn: []string{
+				"actioncable@5.2.3",
+				"actionmailer@5.2.3",
+				"actionpack@5.2.3",
+				"actionview@5.2.3",
+				"activejob@5.2.3",
+				"activemodel@5.2.3",
+				"activerecord@5.2.3",
+				"activestorage@5.2.3",
+				"activesupport@5.2.3",
+				"railties@5.2.3",
+				"sprockets-rails@3.2.1",
+			},
+		},
+		{
+			ID: "railties@5.2.3",
+			DependsOn: []string{
+				"actionpack@5.2.3",
+				"activesupport@5.2.3",
+				"method_source@0.9.2",
+				"rake@12.3.2",
+				"thor@0.20.3",
+			},
+		},
+		{
+			ID: "rubocop@0.67.2",
+			DependsOn: []string{
+				"jaro_winkler@1.5.2",
+				"parallel@1.17.0",
+				"parser@2.6.3.0",
+				"psych@3.1.0",
+				"rainbow@3.0.0",
+				"ruby-progressbar@1.10.0",
+				"unicode-display_width@1.5.0",
+			},
+		},
+		{ID: "sprockets-rails@3.2.1", DependsOn: []string{"actionpack@5.2.3", "activesupport@5.2.3", "sprockets@3.7.2"}},
+		{ID: "sprockets@3.7.2", DependsOn: []string{"concurrent-ruby@1.1.5", "rack@2.0.7"}},
+		{ID: "tzinfo@1.2.5", DependsOn: []string{"thread_safe@0.3.6"}},
+		{ID: "websocket-driver@0.7.0", DependsOn: []string{"websocket-extensions@0.1.3"}},
+	}
+
+	BundlerManyDeps = []types.Dependency{
+		{ID: "actioncable@5.2.3", DependsOn: []string{"actionpack@5.2.3", "nio4r@2.3.1", "websocket-driver@0.7.0"}},
+		{
+			ID: "actionmailer@5.2.3",
+			DependsOn: []string{
+				"actionpack@5.2.3",
+				"actionview@5.2.3",
+				"activejob@5.2.3",
+				"mail@2.7.1",
+				"rails-dom-testing@2.0.3",
+			},
+		},
+		{
+			ID: "actionpack@5.2.3",
+			DependsOn: []string{
+				"actionview@5.2.3",
+				"activesupport@5.2.3",
+				"rack-test@1.1.0",
+				"rack@2.0.7",
+				"rails-dom-testing@2.0.3",
+				"rails-html-sanitizer@1.0.4",
+			},
+		},
+		{
+			ID: "actionview@5.2.3",
+			DependsOn: []string{
+				"activesupport@5.2.3",
+				"builder@3.2.3",
+				"erubi@1.8.0",
+				"rails-dom-testing@2.0.3",
+				"rails-html-sanitizer@1.0.4",
+			},
+		},
+		{ID: "activejob@5.2.3", DependsOn: []string{"activesupport@5.2.3", "globalid@0.4.2"}},
+		{ID: "activemodel@5.2.3", DependsOn: []string{"activesupport@5.2.3"}},
+		{ID: "activerecord@5.2.3", DependsOn: []string{"activemodel@5.2.3", "activesupport@5.2.3", "arel@9.0.0"}},
+		{ID: "activestorage@5.2.3", DependsOn: []string{"actionpack@5.2.3", "activerecord@5.2.3", "marcel@0.3.3"}},
+		{
+			ID: "activesupport@5.2.3",
+			DependsOn: []string{
+				"concurrent-ruby@1.1.5",
+				"i18n@1.6.0",
+				"minitest@5.11.3",
+				"tzinfo@1.2.5",
+			},
+		},
+		{ID: "aws-sdk-acm@1.19.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-acmpca@1.13.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-alexaforbusiness@1.20.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-amplify@1.3.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-apigateway@1.26.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-apigatewaymanagementapi@1.3.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-apigatewayv2@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-applicationautoscaling@1.22.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-applicationdiscoveryservice@1.15.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-appmesh@1.6.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-appstream@1.25.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-appsync@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-athena@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-autoscaling@1.20.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-autoscalingplans@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-backup@1.3.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-batch@1.17.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-budgets@1.18.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-chime@1.6.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloud9@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-clouddirectory@1.14.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloudformation@1.19.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloudfront@1.15.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloudhsm@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloudhsmv2@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloudsearch@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloudsearchdomain@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloudtrail@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloudwatch@1.20.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloudwatchevents@1.17.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cloudwatchlogs@1.17.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-codebuild@1.32.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-codecommit@1.17.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-codedeploy@1.18.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-codepipeline@1.15.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-codestar@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cognitoidentity@1.10.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cognitoidentityprovider@1.18.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-cognitosync@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-comprehend@1.18.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-comprehendmedical@1.3.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-configservice@1.26.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-connect@1.13.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{
+			ID: "aws-sdk-core@3.48.6",
+			DependsOn: []string{
+				"aws-eventstream@1.0.3",
+				"aws-partitions@1.154.0",
+				"aws-sigv4@1.1.0",
+				"jmespath@1.4.0",
+			},
+		},
+		{ID: "aws-sdk-costandusagereportservice@1.10.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-costexplorer@1.21.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-databasemigrationservice@1.20.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-datapipeline@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-datasync@1.3.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-dax@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-devicefarm@1.19.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-directconnect@1.16.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-directoryservice@1.15.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-dlm@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-docdb@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-dynamodb@1.26.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-dynamodbstreams@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-ec2@1.80.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-ecr@1.14.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-ecs@1.36.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-efs@1.13.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-eks@1.15.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-elasticache@1.14.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-elasticbeanstalk@1.19.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-elasticloadbalancing@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-elasticloadbalancingv2@1.26.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-elasticsearchservice@1.19.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-elastictranscoder@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-emr@1.14.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-firehose@1.14.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-fms@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-fsx@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-gamelift@1.16.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-glacier@1.18.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-globalaccelerator@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-glue@1.30.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-greengrass@1.17.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-guardduty@1.14.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-health@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-iam@1.19.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-importexport@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv2@1.0.1"}},
+		{ID: "aws-sdk-inspector@1.16.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-iot1clickdevicesservice@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-iot1clickprojects@1.10.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-iot@1.29.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-iotanalytics@1.16.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-iotdataplane@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-iotjobsdataplane@1.10.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-kafka@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-kinesis@1.13.1", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-kinesisanalytics@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-kinesisanalyticsv2@1.3.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-kinesisvideo@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-kinesisvideoarchivedmedia@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-kinesisvideomedia@1.10.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-kms@1.17.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-lambda@1.22.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-lambdapreview@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-lex@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-lexmodelbuildingservice@1.15.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-licensemanager@1.3.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-lightsail@1.18.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-machinelearning@1.10.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-macie@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-marketplacecommerceanalytics@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-marketplaceentitlementservice@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-marketplacemetering@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-mediaconnect@1.5.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-mediaconvert@1.25.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-medialive@1.28.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-mediapackage@1.15.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-mediastore@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-mediastoredata@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-mediatailor@1.14.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-migrationhub@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-mobile@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-mq@1.13.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-mturk@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-neptune@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-opsworks@1.13.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-opsworkscm@1.16.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-organizations@1.24.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-pi@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-pinpoint@1.19.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-pinpointemail@1.6.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-pinpointsmsvoice@1.6.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-polly@1.19.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-pricing@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-quicksight@1.5.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-ram@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-rds@1.50.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-rdsdataservice@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-redshift@1.23.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-rekognition@1.22.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-resourcegroups@1.14.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-resourcegroupstaggingapi@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{
+			ID: "aws-sdk-resources@3.41.0",
+			DependsOn: []string{
+				"aws-sdk-acm@1.19.0",
+				"aws-sdk-acmpca@1.13.0",
+				"aws-sdk-alexaforbusiness@1.20.0",
+				"aws-sdk-amplify@1.3.0",
+				"aws-sdk-apigateway@1.26.0",
+				"aws-sdk-apigatewaymanagementapi@1.3.0",
+				"aws-sdk-apigatewayv2@1.4.0",
+				"aws-sdk-applicationautoscaling@1.22.0",
+				"aws-sdk-applicationdiscoveryservice@1.15.0",
+				"aws-sdk-appmesh@1.6.0",
+				"aws-sdk-appstream@1.25.0",
+				"aws-sdk-appsync@1.12.0",
+				"aws-sdk-athena@1.12.0",
+				"aws-sdk-autoscaling@1.20.0",
+				"aws-sdk-autoscalingplans@1.12.0",
+				"aws-sdk-backup@1.3.0",
+				"aws-sdk-batch@1.17.0",
+				"aws-sdk-budgets@1.18.0",
+				"aws-sdk-chime@1.6.0",
+				"aws-sdk-cloud9@1.11.0",
+				"aws-sdk-clouddirectory@1.14.0",
+				"aws-sdk-cloudformation@1.19.0",
+				"aws-sdk-cloudfront@1.15.0",
+				"aws-sdk-cloudhsm@1.12.0",
+				"aws-sdk-cloudhsmv2@1.12.0",
+				"aws-sdk-cloudsearch@1.9.0",
+				"aws-sdk-cloudsearchdomain@1.9.0",
+				"aws-sdk-cloudtrail@1.11.0",
+				"aws-sdk-cloudwatch@1.20.0",
+				"aws-sdk-cloudwatchevents@1.17.0",
+				"aws-sdk-cloudwatchlogs@1.17.0",
+				"aws-sdk-codebuild@1.32.0",
+				"aws-sdk-codecommit@1.17.0",
+				"aws-sdk-codedeploy@1.18.0",
+				"aws-sdk-codepipeline@1.15.0",
+				"aws-sdk-codestar@1.11.0",
+				"aws-sdk-cognitoidentity@1.10.0",
+				"aws-sdk-cognitoidentityprovider@1.18.0",
+				"aws-sdk-cognitosync@1.9.0",
+				"aws-sdk-comprehend@1.18.0",
+				"aws-sdk-comprehendmedical@1.3.0",
+				"aws-sdk-configservice@1.26.0",
+				"aws-sdk-connect@1.13.0",
+				"aws-sdk-costandusagereportservice@1.10.0",
+				"aws-sdk-costexplorer@1.21.0",
+				"aws-sdk-databasemigrationservice@1.20.0",
+				"aws-sdk-datapipeline@1.9.0",
+				"aws-sdk-datasync@1.3.0",
+				"aws-sdk-dax@1.11.0",
+				"aws-sdk-devicefarm@1.19.0",
+				"aws-sdk-directconnect@1.16.0",
+				"aws-sdk-directoryservice@1.15.0",
+				"aws-sdk-dlm@1.11.0",
+				"aws-sdk-docdb@1.4.0",
+				"aws-sdk-dynamodb@1.26.0",
+				"aws-sdk-dynamodbstreams@1.9.0",
+				"aws-sdk-ec2@1.80.0",
+				"aws-sdk-ecr@1.14.0",
+				"aws-sdk-ecs@1.36.0",
+				"aws-sdk-efs@1.13.0",
+				"aws-sdk-eks@1.15.0",
+				"aws-sdk-elasticache@1.14.0",
+				"aws-sdk-elasticbeanstalk@1.19.0",
+				"aws-sdk-elasticloadbalancing@1.12.0",
+				"aws-sdk-elasticloadbalancingv2@1.26.0",
+				"aws-sdk-elasticsearchservice@1.19.0",
+				"aws-sdk-elastictranscoder@1.11.0",
+				"aws-sdk-emr@1.14.0",
+				"aws-sdk-firehose@1.14.0",
+				"aws-sdk-fms@1.12.0",
+				"aws-sdk-fsx@1.4.0",
+				"aws-sdk-gamelift@1.16.0",
+				"aws-sdk-glacier@1.18.0",
+				"aws-sdk-globalaccelerator@1.4.0",
+				"aws-sdk-glue@1.30.0",
+				"aws-sdk-greengrass@1.17.0",
+				"aws-sdk-guardduty@1.14.0",
+				"aws-sdk-health@1.12.0",
+				"aws-sdk-iam@1.19.0",
+				"aws-sdk-importexport@1.9.0",
+				"aws-sdk-inspector@1.16.0",
+				"aws-sdk-iot1clickdevicesservice@1.11.0",
+				"aws-sdk-iot1clickprojects@1.10.0",
+				"aws-sdk-iot@1.29.0",
+				"aws-sdk-iotanalytics@1.16.0",
+				"aws-sdk-iotdataplane@1.9.0",
+				"aws-sdk-iotjobsdataplane@1.10.0",
+				"aws-sdk-kafka@1.4.0",
+				"aws-sdk-kinesis@1.13.1",
+				"aws-sdk-kinesisanalytics@1.12.0",
+				"aws-sdk-kinesisanalyticsv2@1.3.0",
+				"aws-sdk-kinesisvideo@1.12.0",
+				"aws-sdk-kinesisvideoarchivedmedia@1.11.0",
+				"aws-sdk-kinesisvideomedia@1.10.0",
+				"aws-sdk-kms@1.17.0",
+				"aws-sdk-lambda@1.22.0",
+				"aws-sdk-lambdapreview@1.9.0",
+				"aws-sdk-lex@1.12.0",
+				"aws-sdk-lexmodelbuildingservice@1.15.0",
+				"aws-sdk-licensemanager@1.3.0",
+				"aws-sdk-lightsail@1.18.0",
+				"aws-sdk-machinelearning@1.10.0",
+				"aws-sdk-macie@1.9.0",
+				"aws-sdk-marketplacecommerceanalytics@1.9.0",
+				"aws-sdk-marketplaceentitlementservice@1.9.0",
+				"aws-sdk-marketplacemetering@1.11.0",
+				"aws-sdk-mediaconnect@1.5.0",
+				"aws-sdk-mediaconvert@1.25.0",
+				"aws-sdk-medialive@1.28.0",
+				"aws-sdk-mediapackage@1.15.0",
+				"aws-sdk-mediastore@1.12.0",
+				"aws-sdk-mediastoredata@1.11.0",
+				"aws-sdk-mediatailor@1.14.0",
+				"aws-sdk-migrationhub@1.11.0",
+				"aws-sdk-mobile@1.9.0",
+				"aws-sdk-mq@1.13.0",
+				"aws-sdk-mturk@1.12.0",
+				"aws-sdk-neptune@1.11.0",
+				"aws-sdk-opsworks@1.13.0",
+				"aws-sdk-opsworkscm@1.16.0",
+				"aws-sdk-organizations@1.24.0",
+				"aws-sdk-pi@1.9.0",
+				"aws-sdk-pinpoint@1.19.0",
+				"aws-sdk-pinpointemail@1.6.0",
+				"aws-sdk-pinpointsmsvoice@1.6.0",
+				"aws-sdk-polly@1.19.0",
+				"aws-sdk-pricing@1.9.0",
+				"aws-sdk-quicksight@1.5.0",
+				"aws-sdk-ram@1.4.0",
+				"aws-sdk-rds@1.50.0",
+				"aws-sdk-rdsdataservice@1.4.0",
+				"aws-sdk-redshift@1.23.0",
+				"aws-sdk-rekognition@1.22.0",
+				"aws-sdk-resourcegroups@1.14.0",
+				"aws-sdk-resourcegroupstaggingapi@1.9.0",
+				"aws-sdk-robomaker@1.5.0",
+				"aws-sdk-route53@1.22.0",
+				"aws-sdk-route53domains@1.11.0",
+				"aws-sdk-route53resolver@1.4.0",
+				"aws-sdk-s3@1.36.1",
+				"aws-sdk-s3control@1.4.0",
+				"aws-sdk-sagemaker@1.33.0",
+				"aws-sdk-sagemakerruntime@1.10.0",
+				"aws-sdk-secretsmanager@1.24.0",
+				"aws-sdk-securityhub@1.4.0",
+				"aws-sdk-serverlessapplicationrepository@1.15.0",
+				"aws-sdk-servicecatalog@1.20.0",
+				"aws-sdk-servicediscovery@1.12.0",
+				"aws-sdk-ses@1.18.0",
+				"aws-sdk-shield@1.13.0",
+				"aws-sdk-signer@1.9.0",
+				"aws-sdk-simpledb@1.9.0",
+				"aws-sdk-sms@1.10.0",
+				"aws-sdk-snowball@1.14.0",
+				"aws-sdk-sns@1.13.0",
+				"aws-sdk-sqs@1.13.0",
+				"aws-sdk-ssm@1.43.0",
+				"aws-sdk-states@1.14.0",
+				"aws-sdk-storagegateway@1.21.0",
+				"aws-sdk-support@1.9.0",
+				"aws-sdk-swf@1.9.0",
+				"aws-sdk-textract@1.4.0",
+				"aws-sdk-transcribeservice@1.19.0",
+				"aws-sdk-transcribestreamingservice@1.2.0",
+				"aws-sdk-transfer@1.5.0",
+				"aws-sdk-translate@1.11.0",
+				"aws-sdk-waf@1.16.0",
+				"aws-sdk-wafregional@1.17.0",
+				"aws-sdk-workdocs@1.12.0",
+				"aws-sdk-worklink@1.4.0",
+				"aws-sdk-workmail@1.11.0",
+				"aws-sdk-workspaces@1.19.0",
+				"aws-sdk-xray@1.13.0",
+			},
+		},
+		{ID: "aws-sdk-robomaker@1.5.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-route53@1.22.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-route53domains@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-route53resolver@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-s3@1.36.1", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sdk-kms@1.17.0", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-s3control@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-sagemaker@1.33.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-sagemakerruntime@1.10.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-secretsmanager@1.24.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-securityhub@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-serverlessapplicationrepository@1.15.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-servicecatalog@1.20.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-servicediscovery@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-ses@1.18.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-shield@1.13.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-signer@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-simpledb@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv2@1.0.1"}},
+		{ID: "aws-sdk-sms@1.10.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-snowball@1.14.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-sns@1.13.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-sqs@1.13.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-ssm@1.43.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-states@1.14.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-storagegateway@1.21.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-support@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-swf@1.9.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-textract@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-transcribeservice@1.19.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-transcribestreamingservice@1.2.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-transfer@1.5.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-translate@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-waf@1.16.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-wafregional@1.17.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-workdocs@1.12.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-worklink@1.4.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-workmail@1.11.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-workspaces@1.19.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk-xray@1.13.0", DependsOn: []string{"aws-sdk-core@3.48.6", "aws-sigv4@1.1.0"}},
+		{ID: "aws-sdk@3.0.1", DependsOn: []string{"aws-sdk-resources@3.41.0"}},
+		{ID: "aws-sigv4@1.1.0", DependsOn: []string{"aws-eventstream@1.0.3"}},
+		{ID: "faker@1.9.3", DependsOn: []string{"i18n@1.6.0"}},
+		{ID: "faraday@0.15.4", DependsOn: []string{"multipart-post@2.0.0"}},
+		{ID: "globalid@0.4.2", DependsOn: []string{"activesupport@5.2.3"}},
+		{ID: "i18n@1.6.0", DependsOn: []string{"concurrent-ruby@1.1.5"}},
+		{ID: "loofah@2.2.3", DependsOn: []string{"crass@1.0.4", "nokogiri@1.10.3"}},
+		{ID: "mail@2.7.1", DependsOn: []string{"mini_mime@1.0.1"}},
+		{ID: "marcel@0.3.3", DependsOn: []string{"mimemagic@0.3.3"}},
+		{ID: "nokogiri@1.10.3", DependsOn: []string{"mini_portile2@2.4.0"}},
+		{ID: "parser@2.6.3.0", DependsOn: []string{"ast@2.4.0"}},
+		{ID: "pry@0.12.2", DependsOn: []string{"coderay@1.1.2", "method_source@0.9.2"}},
+		{ID: "rack-protection@2.0.5", DependsOn: []string{"rack@2.0.7"}},
+		{ID: "rack-test@1.1.0", DependsOn: []string{"rack@2.0.7"}},
+		{ID: "rails-dom-testing@2.0.3", DependsOn: []string{"activesupport@5.2.3", "nokogiri@1.10.3"}},
+		{ID: "rails-html-sanitizer@1.0.4", DependsOn: []string{"loofah@2.2.3"}},
+		{
+			ID: "rails@5.2.3",
+			DependsOn: []string{
+				"actioncable@5.2.3",
+				"actionmailer@5.2.3",
+				"actionpack@5.2.3",
+				"actionview@5.2.3",
+				"activejob@5.2.3",
+				"activemodel@5.2.3",
+				"activerecord@5.2.3",
+				"activestorage@5.2.3",
+				"activesupport@5.2.3",
+				"railties@5.2.3",
+				"sprockets-rails@3.2.1",
+			},
+		},
+		{
+			ID: "railties@5.2.3",
+			DependsOn: []string{
+				"actionpack@5.2.3",
+				"activesupport@5.2.3",
+				"method_source@0.9.2",
+				"rake@12.3.2",
+				"thor@0.20.3",
+			},
+		},
+		{ID: "rb-inotify@0.10.0", DependsOn: []string{"ffi@1.10.0"}},
+		{
+			ID: "rubocop@0.67.2",
+			DependsOn: []string{
+				"jaro_winkler@1.5.2",
+				"parallel@1.17.0",
+				"parser@2.6.3.0",
+				"psych@3.1.0",
+				"rainbow@3.0.0",
+				"ruby-progressbar@1.10.0",
+				"unicode-display_width@1.5.0",
+			},
+		},
+		{ID: "sass-listen@4.0.0", DependsOn: []string{"rb-fsevent@0.10.3", "rb-inotify@0.10.0"}},
+		{ID: "sass@3.7.4", DependsOn: []string{"sass-listen@4.0.0"}},
+		{ID: "sinatra@2.0.5", DependsOn: []string{"mustermann@1.0.3", "rack-protection@2.0.5", "rack@2.0.7", "tilt@2.0.9"}},
+		{ID: "sprockets-rails@3.2.1", DependsOn: []string{"actionpack@5.2.3", "activesupport@5.2.3", "sprockets@3.7.2"}},
+		{ID: "sprockets@3.7.2", DependsOn: []string{"concurrent-ruby@1.1.5", "rack@2.0.7"}},
+		{ID: "tzinfo@1.2.5", DependsOn: []string{"thread_safe@0.3.6"}},
+		{ID: "websocket-driver@0.7.0", DependsOn: []string{"websocket-extensions@0.1.3"}},
 	}
 )
@@ -1,11 +1,18 @@
 package cargo
 
 import (
+	"fmt"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/BurntSushi/toml"
-	"github.com/aquasecurity/go-dep-parser/pkg/types"
 	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	dtoml "github.com/aquasecurity/go-dep-parser/pkg/toml"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 type Lockfile struct {
@@ -13,23 +20,112 @@ type Lockfile struct {
 		Name         string   `toml:"name"`
 		Version      string   `toml:"version"`
 		Source       string   `toml:"source,omitempty"`
+		Checksum     string   `toml:"checksum,omitempty"`
 		Dependencies []string `toml:"dependencies,omitempty"`
 	} `toml:"package"`
+	// Metadata has checksums in the lockfile v1.
+	// e.g. "checksum libc 0.2.54 (registry+https://github.com/rust-lang/crates.io-index)" = "c2f5e3..."
 	Metadata interface{}
 }
 
-func Parse(r io.Reader) ([]types.Library, error) {
+func Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("read error: %w", err)
+	}
+
 	var lockfile Lockfile
-	if _, err := toml.DecodeReader(r, &lockfile); err != nil {
-		return nil, xerrors.Errorf("decode error: %w", err)
+	if _, err = toml.Decode(string(data), &lockfile); err != nil {
+		return nil, nil, xerrors.Errorf("decode error: %w", err)
 	}
+	locations := dtoml.ArrayTableLocations(data, "package")
 
-	var libs []types.Library
+	// Package name => versions
+	versions := map[string][]string{}
 	for _, pkg := range lockfile.Packages {
-		libs = append(libs, types.Library{
-			Name:    pkg.Name,
-			Version: pkg.Version,
-		})
+		versions[pkg.Name] = append(versions[pkg.Name], pkg.Version)
+	}
+
+	checksums := metadataChecksums(lockfile.Metadata)
+
+	var libs []types.Library
+	var deps []types.Dependency
+	for i, pkg := range lockfile.Packages {
+		lib := types.Library{
+			ID:       utils.PackageID(pkg.Name, pkg.Version),
+			Name:     pkg.Name,
+			Version:  pkg.Version,
+			Resolved: pkg.Source,
+		}
+		if i < len(locations) {
+			lib.Locations = types.Locations{locations[i]}
+		}
+
+		checksum := pkg.Checksum
+		if checksum == "" {
+			checksum = checksums[fmt.Sprintf("checksum %s %s (%s)", pkg.Name, pkg.Version, pkg.Source)]
+		}
+		if checksum != "" {
+			if d, err := types.NewDigestFromHex(types.SHA256, checksum); err != nil {
+				log.Logger.Debugf("Invalid checksum of %s: %s", lib.ID, err)
+			} else {
+				lib.Digests = []types.Digest{d}
+			}
+		}
+		libs = append(libs, lib)
+
+		var dependsOn []string
+		for _, d := range pkg.Dependencies {
+			id, err := resolveDependency(d, versions)
+			if err != nil {
+				log.Logger.Debugf("Unable to resolve the dependency of %s: %s", lib.ID, err)
+				continue
+			}
+			dependsOn = append(dependsOn, id)
+		}
+		if len(dependsOn) > 0 {
+			sort.Strings(dependsOn)
+			deps = append(deps, types.Dependency{
+				ID:        lib.ID,
+				DependsOn: dependsOn,
+			})
+		}
+	}
+	return libs, deps, nil
+}
+
+// metadataChecksums returns the checksums in the metadata table of the lockfile v1.
+func metadataChecksums(metadata interface{}) map[string]string {
+	checksums := map[string]string{}
+	m, ok := metadata.(map[string]interface{})
+	if !ok {
+		return checksums
+	}
+	for key, value := range m {
+		if s, ok := value.(string); ok && strings.HasPrefix(key, "checksum ") {
+			checksums[key] = s
+		}
+	}
+	return checksums
+}
+
+// resolveDependency converts an entry of "dependencies" into the package ID.
+// The version and source are present only when multiple versions of the package are locked.
+// e.g. "libc", "libc 0.2.54" or "libc 0.2.54 (registry+https://github.com/rust-lang/crates.io-index)"
+func resolveDependency(dep string, versions map[string][]string) (string, error) {
+	fields := strings.Fields(dep)
+	if len(fields) == 0 {
+		return "", xerrors.New("empty dependency")
+	}
+
+	name := fields[0]
+	if len(fields) > 1 {
+		return utils.PackageID(name, fields[1]), nil
+	}
+
+	vers := versions[name]
+	if len(vers) != 1 {
+		return "", xerrors.Errorf("ambiguous dependency: %s", dep)
 	}
-	return libs, nil
+	return utils.PackageID(name, vers[0]), nil
 }
@@ -15,20 +15,24 @@ import (
 
 func TestParse(t *testing.T) {
 	vectors := []struct {
-		file string // Test input file
-		want []types.Library
+		file     string // Test input file
+		want     []types.Library
+		wantDeps []types.Dependency
 	}{
 		{
-			file: "testdata/cargo_normal.lock",
-			want: cargoNormal,
+			file:     "testdata/cargo_normal.lock",
+			want:     cargoNormal,
+			wantDeps: cargoNormalDeps,
 		},
 		{
-			file: "testdata/cargo_many.lock",
-			want: cargoMany,
+			file:     "testdata/cargo_many.lock",
+			want:     cargoMany,
+			wantDeps: cargoManyDeps,
 		},
 		{
-			file: "testdata/cargo_nickel.lock",
-			want: cargoNickel,
+			file:     "testdata/cargo_nickel.lock",
+			want:     cargoNickel,
+			wantDeps: cargoNickelDeps,
 		},
 	}
 
@@ -37,7 +41,7 @@ func TestParse(t *testing.T) {
 			f, err := os.Open(v.file)
 			require.NoError(t, err)
 
-			got, err := Parse(f)
+			got, deps, err := Parse(f)
 			require.NoError(t, err)
 
 			sort.Slice(got, func(i, j int) bool {
@@ -57,6 +61,11 @@ func TestParse(t *testing.T) {
 			})
 
 			assert.Equal(t, v.want, got)
+
+			sort.Slice(deps, func(i, j int) bool {
+				return deps[i].ID < deps[j].ID
+			})
+			assert.Equal(t, v.wantDeps, deps)
 		})
 	}
 }
@@ -12,8 +12,8 @@ var (
 	// cargo update
 	// cargo metadata  | jq -rc '.packages[] | "{\"\(.name)\", \"\(.version)\", \"\"},"'
 	cargoNormal = []types.Library{
-		{Name: "normal", Version: "0.1.0"},
-		{Name: "libc", Version: "0.2.54"},
+		{ID: "normal@0.1.0", Name: "normal", Version: "0.1.0", Locations: types.Locations{{StartLine: 8, EndLine: 13}}},
+		{ID: "libc@0.2.54", Name: "libc", Version: "0.2.54", Locations: types.Locations{{StartLine: 3, EndLine: 6}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c6785aa7dd976f5fbf3b71cfd9cd49d7f783c1ff565a858d71031c6c313aa5c6"}},
 	}
 
 	// docker run --name cargo --rm -it rust:1.45 bash
@@ -25,105 +25,105 @@ var (
 	// cargo update
 	// cargo metadata  | jq -rc '.packages[] | "{\"\(.name)\", \"\(.version)\", \"\"},"'
 	cargoMany = []types.Library{
-		{Name: "many", Version: "0.1.0"},
-		{Name: "aho-corasick", Version: "0.7.3"},
-		{Name: "autocfg", Version: "0.1.2"},
-		{Name: "base64", Version: "0.10.1"},
-		{Name: "base64", Version: "0.9.3"},
-		{Name: "bitflags", Version: "1.0.4"},
-		{Name: "block-buffer", Version: "0.7.3"},
-		{Name: "block-padding", Version: "0.1.4"},
-		{Name: "byte-tools", Version: "0.3.1"},
-		{Name: "byteorder", Version: "1.3.1"},
-		{Name: "cc", Version: "1.0.36"},
-		{Name: "cfg-if", Version: "0.1.7"},
-		{Name: "cloudabi", Version: "0.0.3"},
-		{Name: "cookie", Version: "0.11.1"},
-		{Name: "devise", Version: "0.2.0"},
-		{Name: "devise_codegen", Version: "0.2.0"},
-		{Name: "devise_core", Version: "0.2.0"},
-		{Name: "digest", Version: "0.8.0"},
-		{Name: "fake-simd", Version: "0.1.2"},
-		{Name: "fuchsia-cprng", Version: "0.1.1"},
-		{Name: "generic-array", Version: "0.12.0"},
-		{Name: "handlebars", Version: "1.1.0"},
-		{Name: "httparse", Version: "1.3.3"},
-		{Name: "hyper", Version: "0.10.16"},
-		{Name: "idna", Version: "0.1.5"},
-		{Name: "indexmap", Version: "1.0.2"},
-		{Name: "isatty", Version: "0.1.9"},
-		{Name: "itoa", Version: "0.4.4"},
-		{Name: "language-tags", Version: "0.2.2"},
-		{Name: "lazy_static", Version: "1.3.0"},
-		{Name: "libc", Version: "0.2.54"},
-		{Name: "log", Version: "0.3.9"},
-		{Name: "log", Version: "0.4.6"},
-		{Name: "maplit", Version: "1.0.1"},
-		{Name: "matches", Version: "0.1.8"},
-		{Name: "memchr", Version: "2.2.0"},
-		{Name: "mime", Version: "0.2.6"},
-		{Name: "num_cpus", Version: "1.10.0"},
-		{Name: "opaque-debug", Version: "0.2.2"},
-		{Name: "pear", Version: "0.1.2"},
-		{Name: "pear_codegen", Version: "0.1.2"},
-		{Name: "percent-encoding", Version: "1.0.1"},
-		{Name: "pest", Version: "2.1.1"},
-		{Name: "pest_derive", Version: "2.1.0"},
-		{Name: "pest_generator", Version: "2.1.0"},
-		{Name: "pest_meta", Version: "2.1.1"},
-		{Name: "proc-macro2", Version: "0.4.30"},
-		{Name: "quick-error", Version: "1.2.2"},
-		{Name: "quote", Version: "0.6.12"},
-		{Name: "rand", Version: "0.6.5"},
-		{Name: "rand_chacha", Version: "0.1.1"},
-		{Name: "rand_core", Version: "0.3.1"},
-		{Name: "rand_core", Version: "0.4.0"},
-		{Name: "rand_hc", Version: "0.1.0"},
-		{Name: "rand_isaac", Version: "0.1.1"},
-		{Name: "rand_jitter", Version: "0.1.4"},
-		{Name: "rand_os", Version: "0.1.3"},
-		{Name: "rand_pcg", Version: "0.1.2"},
-		{Name: "rand_xorshift", Version: "0.1.1"},
-		{Name: "rdrand", Version: "0.4.0"},
-		{Name: "redox_syscall", Version: "0.1.54"},
-		{Name: "regex", Version: "1.1.6"},
-		{Name: "regex-syntax", Version: "0.6.6"},
-		{Name: "ring", Version: "0.13.5"},
-		{Name: "rocket", Version: "0.4.0"},
-		{Name: "rocket_codegen", Version: "0.4.0"},
-		{Name: "rocket_http", Version: "0.4.0"},
-		{Name: "ryu", Version: "0.2.8"},
-		{Name: "safemem", Version: "0.3.0"},
-		{Name: "same-file", Version: "1.0.4"},
-		{Name: "serde", Version: "1.0.91"},
-		{Name: "serde_json", Version: "1.0.39"},
-		{Name: "sha-1", Version: "0.8.1"},
-		{Name: "smallvec", Version: "0.6.9"},
-		{Name: "state", Version: "0.4.1"},
-		{Name: "syn", Version: "0.15.34"},
-		{Name: "thread_local", Version: "0.3.6"},
-		{Name: "time", Version: "0.1.42"},
-		{Name: "toml", Version: "0.4.10"},
-		{Name: "traitobject", Version: "0.1.0"},
-		{Name: "typeable", Version: "0.1.2"},
-		{Name: "typenum", Version: "1.10.0"},
-		{Name: "ucd-trie", Version: "0.1.1"},
-		{Name: "ucd-util", Version: "0.1.3"},
-		{Name: "unicase", Version: "1.4.2"},
-		{Name: "unicode-bidi", Version: "0.3.4"},
-		{Name: "unicode-normalization", Version: "0.1.8"},
-		{Name: "unicode-xid", Version: "0.1.0"},
-		{Name: "untrusted", Version: "0.6.2"},
-		{Name: "url", Version: "1.7.2"},
-		{Name: "utf8-ranges", Version: "1.0.2"},
-		{Name: "version_check", Version: "0.1.5"},
-		{Name: "walkdir", Version: "2.2.7"},
-		{Name: "winapi", Version: "0.3.7"},
-		{Name: "winapi-i686-pc-windows-gnu", Version: "0.4.0"},
-		{Name: "winapi-util", Version: "0.1.2"},
-		{Name: "winapi-x86_64-pc-windows-gnu", Version: "0.4.0"},
-		{Name: "yansi", Version: "0.4.0"},
-		{Name: "yansi", Version: "0.5.0"},
+		{ID: "many@0.1.0", Name: "many", Version: "0.1.0", Locations: types.Locations{{StartLine: 252, EndLine: 266}}},
+		{ID: "aho-corasick@0.7.3", Name: "aho-corasick", Version: "0.7.3", Locations: types.Locations{{StartLine: 3, EndLine: 9}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:e6f484ae0c99fec2e858eb6134949117399f222608d84cadb3f58c1f97c2364c"}},
+		{ID: "autocfg@0.1.2", Name: "autocfg", Version: "0.1.2", Locations: types.Locations{{StartLine: 11, EndLine: 14}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:a6d640bee2da49f60a4068a7fae53acde8982514ab7bae8b8cea9e88cbcfd799"}},
+		{ID: "base64@0.10.1", Name: "base64", Version: "0.10.1", Locations: types.Locations{{StartLine: 25, EndLine: 31}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:0b25d992356d2eb0ed82172f5248873db5560c4721f564b13cb5193bda5e668e"}},
+		{ID: "base64@0.9.3", Name: "base64", Version: "0.9.3", Locations: types.Locations{{StartLine: 16, EndLine: 23}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:489d6c0ed21b11d038c31b6ceccca973e65d73ba3bd8ecb9a2babf5546164643"}},
+		{ID: "bitflags@1.0.4", Name: "bitflags", Version: "1.0.4", Locations: types.Locations{{StartLine: 33, EndLine: 36}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:228047a76f468627ca71776ecdebd732a3423081fcf5125585bcd7c49886ce12"}},
+		{ID: "block-buffer@0.7.3", Name: "block-buffer", Version: "0.7.3", Locations: types.Locations{{StartLine: 38, EndLine: 47}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c0940dc441f31689269e10ac70eb1002a3a1d3ad1390e030043662eb7fe4688b"}},
+		{ID: "block-padding@0.1.4", Name: "block-padding", Version: "0.1.4", Locations: types.Locations{{StartLine: 49, EndLine: 55}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:6d4dc3af3ee2e12f3e5d224e5e1e3d73668abbeb69e566d361f7d5563a4fdf09"}},
+		{ID: "byte-tools@0.3.1", Name: "byte-tools", Version: "0.3.1", Locations: types.Locations{{StartLine: 57, EndLine: 60}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:e3b5ca7a04898ad4bcd41c90c5285445ff5b791899bb1b0abdd2a2aa791211d7"}},
+		{ID: "byteorder@1.3.1", Name: "byteorder", Version: "1.3.1", Locations: types.Locations{{StartLine: 62, EndLine: 65}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:a019b10a2a7cdeb292db131fc8113e57ea2a908f6e7894b0c3c671893b65dbeb"}},
+		{ID: "cc@1.0.36", Name: "cc", Version: "1.0.36", Locations: types.Locations{{StartLine: 67, EndLine: 70}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:a0c56216487bb80eec9c4516337b2588a4f2a2290d72a1416d930e4dcdb0c90d"}},
+		{ID: "cfg-if@0.1.7", Name: "cfg-if", Version: "0.1.7", Locations: types.Locations{{StartLine: 72, EndLine: 75}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:11d43355396e872eefb45ce6342e4374ed7bc2b3a502d1b28e36d6e23c05d1f4"}},
+		{ID: "cloudabi@0.0.3", Name: "cloudabi", Version: "0.0.3", Locations: types.Locations{{StartLine: 77, EndLine: 83}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:ddfc5b9aa5d4507acaf872de71051dfd0e309860e88966e1051e462a077aac4f"}},
+		{ID: "cookie@0.11.1", Name: "cookie", Version: "0.11.1", Locations: types.Locations{{StartLine: 85, EndLine: 94}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:99be24cfcf40d56ed37fd11c2123be833959bbc5bddecb46e1c2e442e15fa3e0"}},
+		{ID: "devise@0.2.0", Name: "devise", Version: "0.2.0", Locations: types.Locations{{StartLine: 96, EndLine: 103}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:74e04ba2d03c5fa0d954c061fc8c9c288badadffc272ebb87679a89846de3ed3"}},
+		{ID: "devise_codegen@0.2.0", Name: "devise_codegen", Version: "0.2.0", Locations: types.Locations{{StartLine: 105, EndLine: 112}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:066ceb7928ca93a9bedc6d0e612a8a0424048b0ab1f75971b203d01420c055d7"}},
+		{ID: "devise_core@0.2.0", Name: "devise_core", Version: "0.2.0", Locations: types.Locations{{StartLine: 114, EndLine: 123}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:cf41c59b22b5e3ec0ea55c7847e5f358d340f3a8d6d53a5cf4f1564967f96487"}},
+		{ID: "digest@0.8.0", Name: "digest", Version: "0.8.0", Locations: types.Locations{{StartLine: 125, EndLine: 131}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:05f47366984d3ad862010e22c7ce81a7dbcaebbdfb37241a620f8b6596ee135c"}},
+		{ID: "fake-simd@0.1.2", Name: "fake-simd", Version: "0.1.2", Locations: types.Locations{{StartLine: 133, EndLine: 136}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:e88a8acf291dafb59c2d96e8f59828f3838bb1a70398823ade51a84de6a6deed"}},
+		{ID: "fuchsia-cprng@0.1.1", Name: "fuchsia-cprng", Version: "0.1.1", Locations: types.Locations{{StartLine: 138, EndLine: 141}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:a06f77d526c1a601b7c4cdd98f54b5eaabffc14d5f2f0296febdc7f357c6d3ba"}},
+		{ID: "generic-array@0.12.0", Name: "generic-array", Version: "0.12.0", Locations: types.Locations{{StartLine: 143, EndLine: 149}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:3c0f28c2f5bfb5960175af447a2da7c18900693738343dc896ffbcabd9839592"}},
+		{ID: "handlebars@1.1.0", Name: "handlebars", Version: "1.1.0", Locations: types.Locations{{StartLine: 151, EndLine: 165}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:d82e5750d8027a97b9640e3fefa66bbaf852a35228e1c90790efd13c4b09c166"}},
+		{ID: "httparse@1.3.3", Name: "httparse", Version: "1.3.3", Locations: types.Locations{{StartLine: 167, EndLine: 170}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:e8734b0cfd3bc3e101ec59100e101c2eecd19282202e87808b3037b442777a83"}},
+		{ID: "hyper@0.10.16", Name: "hyper", Version: "0.10.16", Locations: types.Locations{{StartLine: 172, EndLine: 188}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:0a0652d9a2609a968c14be1a9ea00bf4b1d64e2e1f53a1b51b6fff3a6e829273"}},
+		{ID: "idna@0.1.5", Name: "idna", Version: "0.1.5", Locations: types.Locations{{StartLine: 190, EndLine: 198}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:38f09e0f0b1fb55fdee1f17470ad800da77af5186a1a76c026b679358b7e844e"}},
+		{ID: "indexmap@1.0.2", Name: "indexmap", Version: "1.0.2", Locations: types.Locations{{StartLine: 200, EndLine: 203}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7e81a7c05f79578dbc15793d8b619db9ba32b4577003ef3af1a91c416798c58d"}},
+		{ID: "isatty@0.1.9", Name: "isatty", Version: "0.1.9", Locations: types.Locations{{StartLine: 205, EndLine: 214}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:e31a8281fc93ec9693494da65fbf28c0c2aa60a2eaec25dc58e2f31952e95edc"}},
+		{ID: "itoa@0.4.4", Name: "itoa", Version: "0.4.4", Locations: types.Locations{{StartLine: 216, EndLine: 219}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:501266b7edd0174f8530248f87f99c88fbe60ca4ef3dd486835b8d8d53136f7f"}},
+		{ID: "language-tags@0.2.2", Name: "language-tags", Version: "0.2.2", Locations: types.Locations{{StartLine: 221, EndLine: 224}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:a91d884b6667cd606bb5a69aa0c99ba811a115fc68915e7056ec08a46e93199a"}},
+		{ID: "lazy_static@1.3.0", Name: "lazy_static", Version: "1.3.0", Locations: types.Locations{{StartLine: 226, EndLine: 229}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:bc5729f27f159ddd61f4df6228e827e86643d4d3e7c32183cb30a1c08f604a14"}},
+		{ID: "libc@0.2.54", Name: "libc", Version: "0.2.54", Locations: types.Locations{{StartLine: 231, EndLine: 234}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c6785aa7dd976f5fbf3b71cfd9cd49d7f783c1ff565a858d71031c6c313aa5c6"}},
+		{ID: "log@0.3.9", Name: "log", Version: "0.3.9", Locations: types.Locations{{StartLine: 236, EndLine: 242}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:e19e8d5c34a3e0e2223db8e060f9e8264aeeb5c5fc64a4ee9965c062211c024b"}},
+		{ID: "log@0.4.6", Name: "log", Version: "0.4.6", Locations: types.Locations{{StartLine: 244, EndLine: 250}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c84ec4b527950aa83a329754b01dbe3f58361d1c5efacd1f6d68c494d08a17c6"}},
+		{ID: "maplit@1.0.1", Name: "maplit", Version: "1.0.1", Locations: types.Locations{{StartLine: 268, EndLine: 271}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:08cbb6b4fef96b6d77bfc40ec491b1690c779e77b05cd9f07f787ed376fd4c43"}},
+		{ID: "matches@0.1.8", Name: "matches", Version: "0.1.8", Locations: types.Locations{{StartLine: 273, EndLine: 276}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7ffc5c5338469d4d3ea17d269fa8ea3512ad247247c30bd2df69e68309ed0a08"}},
+		{ID: "memchr@2.2.0", Name: "memchr", Version: "2.2.0", Locations: types.Locations{{StartLine: 278, EndLine: 281}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:2efc7bc57c883d4a4d6e3246905283d8dae951bb3bd32f49d6ef297f546e1c39"}},
+		{ID: "mime@0.2.6", Name: "mime", Version: "0.2.6", Locations: types.Locations{{StartLine: 283, EndLine: 289}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:ba626b8a6de5da682e1caa06bdb42a335aee5a84db8e5046a3e8ab17ba0a3ae0"}},
+		{ID: "num_cpus@1.10.0", Name: "num_cpus", Version: "1.10.0", Locations: types.Locations{{StartLine: 291, EndLine: 297}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:1a23f0ed30a54abaa0c7e83b1d2d87ada7c3c23078d1d87815af3e3b6385fbba"}},
+		{ID: "opaque-debug@0.2.2", Name: "opaque-debug", Version: "0.2.2", Locations: types.Locations{{StartLine: 299, EndLine: 302}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:93f5bb2e8e8dec81642920ccff6b61f1eb94fa3020c5a325c9851ff604152409"}},
+		{ID: "pear@0.1.2", Name: "pear", Version: "0.1.2", Locations: types.Locations{{StartLine: 304, EndLine: 310}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c26d2b92e47063ffce70d3e3b1bd097af121a9e0db07ca38a6cc1cf0cc85ff25"}},
+		{ID: "pear_codegen@0.1.2", Name: "pear_codegen", Version: "0.1.2", Locations: types.Locations{{StartLine: 312, EndLine: 322}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:336db4a192cc7f54efeb0c4e11a9245394824cc3bcbd37ba3ff51240c35d7a6e"}},
+		{ID: "percent-encoding@1.0.1", Name: "percent-encoding", Version: "1.0.1", Locations: types.Locations{{StartLine: 324, EndLine: 327}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:31010dd2e1ac33d5b46a5b413495239882813e0369f8ed8a5e266f173602f831"}},
+		{ID: "pest@2.1.1", Name: "pest", Version: "2.1.1", Locations: types.Locations{{StartLine: 329, EndLine: 335}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:933085deae3f32071f135d799d75667b63c8dc1f4537159756e3d4ceab41868c"}},
+		{ID: "pest_derive@2.1.0", Name: "pest_derive", Version: "2.1.0", Locations: types.Locations{{StartLine: 337, EndLine: 344}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:833d1ae558dc601e9a60366421196a8d94bc0ac980476d0b67e1d0988d72b2d0"}},
+		{ID: "pest_generator@2.1.0", Name: "pest_generator", Version: "2.1.0", Locations: types.Locations{{StartLine: 346, EndLine: 356}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:63120576c4efd69615b5537d3d052257328a4ca82876771d6944424ccfd9f646"}},
+		{ID: "pest_meta@2.1.1", Name: "pest_meta", Version: "2.1.1", Locations: types.Locations{{StartLine: 358, EndLine: 366}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:f249ea6de7c7b7aba92b4ff4376a994c6dbd98fd2166c89d5c4947397ecb574d"}},
+		{ID: "proc-macro2@0.4.30", Name: "proc-macro2", Version: "0.4.30", Locations: types.Locations{{StartLine: 368, EndLine: 374}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:cf3d2011ab5c909338f7887f4fc896d35932e29146c12c8d01da6b22a80ba759"}},
+		{ID: "quick-error@1.2.2", Name: "quick-error", Version: "1.2.2", Locations: types.Locations{{StartLine: 376, EndLine: 379}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:9274b940887ce9addde99c4eee6b5c44cc494b182b97e73dc8ffdcb3397fd3f0"}},
+		{ID: "quote@0.6.12", Name: "quote", Version: "0.6.12", Locations: types.Locations{{StartLine: 381, EndLine: 387}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:faf4799c5d274f3868a4aae320a0a182cbd2baee377b378f080e16a23e9d80db"}},
+		{ID: "rand@0.6.5", Name: "rand", Version: "0.6.5", Locations: types.Locations{{StartLine: 389, EndLine: 405}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:6d71dacdc3c88c1fde3885a3be3fbab9f35724e6ce99467f7d9c5026132184ca"}},
+		{ID: "rand_chacha@0.1.1", Name: "rand_chacha", Version: "0.1.1", Locations: types.Locations{{StartLine: 407, EndLine: 414}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:556d3a1ca6600bfcbab7c7c91ccb085ac7fbbcd70e008a98742e7847f4f7bcef"}},
+		{ID: "rand_core@0.3.1", Name: "rand_core", Version: "0.3.1", Locations: types.Locations{{StartLine: 416, EndLine: 422}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7a6fdeb83b075e8266dcc8762c22776f6877a63111121f5f8c7411e5be7eed4b"}},
+		{ID: "rand_core@0.4.0", Name: "rand_core", Version: "0.4.0", Locations: types.Locations{{StartLine: 424, EndLine: 427}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:d0e7a549d590831370895ab7ba4ea0c1b6b011d106b5ff2da6eee112615e6dc0"}},
+		{ID: "rand_hc@0.1.0", Name: "rand_hc", Version: "0.1.0", Locations: types.Locations{{StartLine: 429, EndLine: 435}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7b40677c7be09ae76218dc623efbf7b18e34bced3f38883af07bb75630a21bc4"}},
+		{ID: "rand_isaac@0.1.1", Name: "rand_isaac", Version: "0.1.1", Locations: types.Locations{{StartLine: 437, EndLine: 443}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:ded997c9d5f13925be2a6fd7e66bf1872597f759fd9dd93513dd7e92e5a5ee08"}},
+		{ID: "rand_jitter@0.1.4", Name: "rand_jitter", Version: "0.1.4", Locations: types.Locations{{StartLine: 445, EndLine: 453}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:1166d5c91dc97b88d1decc3285bb0a99ed84b05cfd0bc2341bdf2d43fc41e39b"}},
+		{ID: "rand_os@0.1.3", Name: "rand_os", Version: "0.1.3", Locations: types.Locations{{StartLine: 455, EndLine: 466}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7b75f676a1e053fc562eafbb47838d67c84801e38fc1ba459e8f180deabd5071"}},
+		{ID: "rand_pcg@0.1.2", Name: "rand_pcg", Version: "0.1.2", Locations: types.Locations{{StartLine: 468, EndLine: 475}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:abf9b09b01790cfe0364f52bf32995ea3c39f4d2dd011eac241d2914146d0b44"}},
+		{ID: "rand_xorshift@0.1.1", Name: "rand_xorshift", Version: "0.1.1", Locations: types.Locations{{StartLine: 477, EndLine: 483}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:cbf7e9e623549b0e21f6e97cf8ecf247c1a8fd2e8a992ae265314300b2455d5c"}},
+		{ID: "rdrand@0.4.0", Name: "rdrand", Version: "0.4.0", Locations: types.Locations{{StartLine: 485, EndLine: 491}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:678054eb77286b51581ba43620cc911abf02758c91f93f479767aed0f90458b2"}},
+		{ID: "redox_syscall@0.1.54", Name: "redox_syscall", Version: "0.1.54", Locations: types.Locations{{StartLine: 493, EndLine: 496}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:12229c14a0f65c4f1cb046a3b52047cdd9da1f4b30f8a39c5063c8bae515e252"}},
+		{ID: "regex@1.1.6", Name: "regex", Version: "1.1.6", Locations: types.Locations{{StartLine: 498, EndLine: 508}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:8f0a0bcab2fd7d1d7c54fa9eae6f43eddeb9ce2e7352f8518a814a4f65d60c58"}},
+		{ID: "regex-syntax@0.6.6", Name: "regex-syntax", Version: "0.6.6", Locations: types.Locations{{StartLine: 510, EndLine: 516}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:dcfd8681eebe297b81d98498869d4aae052137651ad7b96822f09ceb690d0a96"}},
+		{ID: "ring@0.13.5", Name: "ring", Version: "0.13.5", Locations: types.Locations{{StartLine: 518, EndLine: 527}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:2c4db68a2e35f3497146b7e4563df7d4773a2433230c5e4b448328e31740458a"}},
+		{ID: "rocket@0.4.0", Name: "rocket", Version: "0.4.0", Locations: types.Locations{{StartLine: 529, EndLine: 547}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:242154377a85c2a9e036fc31ffc8c200b9e1f22a196e47baa3b57716606ca89d"}},
+		{ID: "rocket_codegen@0.4.0", Name: "rocket_codegen", Version: "0.4.0", Locations: types.Locations{{StartLine: 549, EndLine: 560}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7d907d6d458c859651c1cf4c8fa99b77685082bde0561db6a4600b365058f710"}},
+		{ID: "rocket_http@0.4.0", Name: "rocket_http", Version: "0.4.0", Locations: types.Locations{{StartLine: 562, EndLine: 576}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:ba9d4f2ce5bba6e1b6d3100493bbad63879e99bbf6b4365d61e6f781daab324d"}},
+		{ID: "ryu@0.2.8", Name: "ryu", Version: "0.2.8", Locations: types.Locations{{StartLine: 578, EndLine: 581}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:b96a9549dc8d48f2c283938303c4b5a77aa29bfbc5b54b084fb1630408899a8f"}},
+		{ID: "safemem@0.3.0", Name: "safemem", Version: "0.3.0", Locations: types.Locations{{StartLine: 583, EndLine: 586}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:8dca453248a96cb0749e36ccdfe2b0b4e54a61bfef89fb97ec621eb8e0a93dd9"}},
+		{ID: "same-file@1.0.4", Name: "same-file", Version: "1.0.4", Locations: types.Locations{{StartLine: 588, EndLine: 594}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:8f20c4be53a8a1ff4c1f1b2bd14570d2f634628709752f0702ecdd2b3f9a5267"}},
+		{ID: "serde@1.0.91", Name: "serde", Version: "1.0.91", Locations: types.Locations{{StartLine: 596, EndLine: 599}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:a72e9b96fa45ce22a4bc23da3858dfccfd60acd28a25bcd328a98fdd6bea43fd"}},
+		{ID: "serde_json@1.0.39", Name: "serde_json", Version: "1.0.39", Locations: types.Locations{{StartLine: 601, EndLine: 609}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:5a23aa71d4a4d43fdbfaac00eff68ba8a06a51759a89ac3304323e800c4dd40d"}},
+		{ID: "sha-1@0.8.1", Name: "sha-1", Version: "0.8.1", Locations: types.Locations{{StartLine: 611, EndLine: 620}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:23962131a91661d643c98940b20fcaffe62d776a823247be80a48fcb8b6fce68"}},
+		{ID: "smallvec@0.6.9", Name: "smallvec", Version: "0.6.9", Locations: types.Locations{{StartLine: 622, EndLine: 625}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c4488ae950c49d403731982257768f48fada354a5203fe81f9bb6f43ca9002be"}},
+		{ID: "state@0.4.1", Name: "state", Version: "0.4.1", Locations: types.Locations{{StartLine: 627, EndLine: 630}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7345c971d1ef21ffdbd103a75990a15eb03604fc8b8852ca8cb418ee1a099028"}},
+		{ID: "syn@0.15.34", Name: "syn", Version: "0.15.34", Locations: types.Locations{{StartLine: 632, EndLine: 640}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:a1393e4a97a19c01e900df2aec855a29f71cf02c402e2f443b8d2747c25c5dbe"}},
+		{ID: "thread_local@0.3.6", Name: "thread_local", Version: "0.3.6", Locations: types.Locations{{StartLine: 642, EndLine: 648}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c6b53e329000edc2b34dbe8545fd20e55a333362d0a321909685a19bd28c3f1b"}},
+		{ID: "time@0.1.42", Name: "time", Version: "0.1.42", Locations: types.Locations{{StartLine: 650, EndLine: 658}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:db8dcfca086c1143c9270ac42a2bbd8a7ee477b78ac8e45b19abfb0cbede4b6f"}},
+		{ID: "toml@0.4.10", Name: "toml", Version: "0.4.10", Locations: types.Locations{{StartLine: 660, EndLine: 666}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:758664fc71a3a69038656bee8b6be6477d2a6c315a6b81f7081f591bffa4111f"}},
+		{ID: "traitobject@0.1.0", Name: "traitobject", Version: "0.1.0", Locations: types.Locations{{StartLine: 668, EndLine: 671}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:efd1f82c56340fdf16f2a953d7bda4f8fdffba13d93b00844c25572110b26079"}},
+		{ID: "typeable@0.1.2", Name: "typeable", Version: "0.1.2", Locations: types.Locations{{StartLine: 673, EndLine: 676}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:1410f6f91f21d1612654e7cc69193b0334f909dcf2c790c4826254fbb86f8887"}},
+		{ID: "typenum@1.10.0", Name: "typenum", Version: "1.10.0", Locations: types.Locations{{StartLine: 678, EndLine: 681}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:612d636f949607bdf9b123b4a6f6d966dedf3ff669f7f045890d3a4a73948169"}},
+		{ID: "ucd-trie@0.1.1", Name: "ucd-trie", Version: "0.1.1", Locations: types.Locations{{StartLine: 683, EndLine: 686}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:71a9c5b1fe77426cf144cc30e49e955270f5086e31a6441dfa8b32efc09b9d77"}},
+		{ID: "ucd-util@0.1.3", Name: "ucd-util", Version: "0.1.3", Locations: types.Locations{{StartLine: 688, EndLine: 691}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:535c204ee4d8434478593480b8f86ab45ec9aae0e83c568ca81abf0fd0e88f86"}},
+		{ID: "unicase@1.4.2", Name: "unicase", Version: "1.4.2", Locations: types.Locations{{StartLine: 693, EndLine: 699}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7f4765f83163b74f957c797ad9253caf97f103fb064d3999aea9568d09fc8a33"}},
+		{ID: "unicode-bidi@0.3.4", Name: "unicode-bidi", Version: "0.3.4", Locations: types.Locations{{StartLine: 701, EndLine: 707}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:49f2bd0c6468a8230e1db229cff8029217cf623c767ea5d60bfbd42729ea54d5"}},
+		{ID: "unicode-normalization@0.1.8", Name: "unicode-normalization", Version: "0.1.8", Locations: types.Locations{{StartLine: 709, EndLine: 715}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:141339a08b982d942be2ca06ff8b076563cbe223d1befd5450716790d44e2426"}},
+		{ID: "unicode-xid@0.1.0", Name: "unicode-xid", Version: "0.1.0", Locations: types.Locations{{StartLine: 717, EndLine: 720}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:fc72304796d0818e357ead4e000d19c9c174ab23dc11093ac919054d20a6a7fc"}},
+		{ID: "untrusted@0.6.2", Name: "untrusted", Version: "0.6.2", Locations: types.Locations{{StartLine: 722, EndLine: 725}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:55cd1f4b4e96b46aeb8d4855db4a7a9bd96eeeb5c6a1ab54593328761642ce2f"}},
+		{ID: "url@1.7.2", Name: "url", Version: "1.7.2", Locations: types.Locations{{StartLine: 727, EndLine: 735}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:dd4e7c0d531266369519a4aa4f399d748bd37043b00bde1e4ff1f60a120b355a"}},
+		{ID: "utf8-ranges@1.0.2", Name: "utf8-ranges", Version: "1.0.2", Locations: types.Locations{{StartLine: 737, EndLine: 740}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:796f7e48bef87609f7ade7e06495a87d5cd06c7866e6a5cbfceffc558a243737"}},
+		{ID: "version_check@0.1.5", Name: "version_check", Version: "0.1.5", Locations: types.Locations{{StartLine: 742, EndLine: 745}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:914b1a6776c4c929a602fafd8bc742e06365d4bcbe48c30f9cca5824f70dc9dd"}},
+		{ID: "walkdir@2.2.7", Name: "walkdir", Version: "2.2.7", Locations: types.Locations{{StartLine: 747, EndLine: 755}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:9d9d7ed3431229a144296213105a390676cc49c9b6a72bd19f3176c98e129fa1"}},
+		{ID: "winapi@0.3.7", Name: "winapi", Version: "0.3.7", Locations: types.Locations{{StartLine: 757, EndLine: 764}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:f10e386af2b13e47c89e7236a7a14a086791a2b88ebad6df9bf42040195cf770"}},
+		{ID: "winapi-i686-pc-windows-gnu@0.4.0", Name: "winapi-i686-pc-windows-gnu", Version: "0.4.0", Locations: types.Locations{{StartLine: 766, EndLine: 769}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:ac3b87c63620426dd9b991e5ce0329eff545bccbbb34f3be09ff6fb6ab51b7b6"}},
+		{ID: "winapi-util@0.1.2", Name: "winapi-util", Version: "0.1.2", Locations: types.Locations{{StartLine: 771, EndLine: 777}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7168bab6e1daee33b4557efd0e95d5ca70a03706d39fa5f3fe7a236f584b03c9"}},
+		{ID: "winapi-x86_64-pc-windows-gnu@0.4.0", Name: "winapi-x86_64-pc-windows-gnu", Version: "0.4.0", Locations: types.Locations{{StartLine: 779, EndLine: 782}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:712e227841d057c1ee1cd2fb22fa7e5a5461ae8e48fa2ca79ec42cfc1931183f"}},
+		{ID: "yansi@0.4.0", Name: "yansi", Version: "0.4.0", Locations: types.Locations{{StartLine: 784, EndLine: 787}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:d60c3b48c9cdec42fb06b3b84b5b087405e1fa1c644a1af3930e4dfafe93de48"}},
+		{ID: "yansi@0.5.0", Name: "yansi", Version: "0.5.0", Locations: types.Locations{{StartLine: 789, EndLine: 792}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:9fc79f4a1e39857fc00c3f662cbf2651c771f00e9c15fe2abc341806bd46bd71"}},
 	}
 
 	// docker run --name cargo --rm -it rust:1.45 bash
@@ -135,53 +135,337 @@ var (
 	// cargo update
 	// cargo metadata  | jq -rc '.packages[] | "{\"\(.name)\", \"\(.version)\", \"\"},"'
 	cargoNickel = []types.Library{
-		{Name: "web", Version: "0.1.0"},
-		{Name: "aho-corasick", Version: "0.7.3"},
-		{Name: "base64", Version: "0.9.3"},
-		{Name: "byteorder", Version: "1.3.1"},
-		{Name: "cfg-if", Version: "0.1.7"},
-		{Name: "groupable", Version: "0.2.0"},
-		{Name: "httparse", Version: "1.3.3"},
-		{Name: "hyper", Version: "0.10.16"},
-		{Name: "idna", Version: "0.1.5"},
-		{Name: "itoa", Version: "0.4.4"},
-		{Name: "language-tags", Version: "0.2.2"},
-		{Name: "lazy_static", Version: "1.3.0"},
-		{Name: "libc", Version: "0.2.54"},
-		{Name: "log", Version: "0.3.9"},
-		{Name: "log", Version: "0.4.6"},
-		{Name: "matches", Version: "0.1.8"},
-		{Name: "memchr", Version: "2.2.0"},
-		{Name: "mime", Version: "0.2.6"},
-		{Name: "modifier", Version: "0.1.0"},
-		{Name: "mustache", Version: "0.9.0"},
-		{Name: "nickel", Version: "0.11.0"},
-		{Name: "num_cpus", Version: "1.10.0"},
-		{Name: "percent-encoding", Version: "1.0.1"},
-		{Name: "plugin", Version: "0.2.6"},
-		{Name: "redox_syscall", Version: "0.1.54"},
-		{Name: "regex", Version: "1.1.6"},
-		{Name: "regex-syntax", Version: "0.6.6"},
-		{Name: "ryu", Version: "0.2.8"},
-		{Name: "safemem", Version: "0.3.0"},
-		{Name: "serde", Version: "1.0.91"},
-		{Name: "serde_json", Version: "1.0.39"},
-		{Name: "smallvec", Version: "0.6.9"},
-		{Name: "thread_local", Version: "0.3.6"},
-		{Name: "time", Version: "0.1.42"},
-		{Name: "traitobject", Version: "0.1.0"},
-		{Name: "typeable", Version: "0.1.2"},
-		{Name: "typemap", Version: "0.3.3"},
-		{Name: "ucd-util", Version: "0.1.3"},
-		{Name: "unicase", Version: "1.4.2"},
-		{Name: "unicode-bidi", Version: "0.3.4"},
-		{Name: "unicode-normalization", Version: "0.1.8"},
-		{Name: "unsafe-any", Version: "0.4.2"},
-		{Name: "url", Version: "1.7.2"},
-		{Name: "utf8-ranges", Version: "1.0.2"},
-		{Name: "version_check", Version: "0.1.5"},
-		{Name: "winapi", Version: "0.3.7"},
-		{Name: "winapi-i686-pc-windows-gnu", Version: "0.4.0"},
-		{Name: "winapi-x86_64-pc-windows-gnu", Version: "0.4.0"},
+		{ID: "web@0.1.0", Name: "web", Version: "0.1.0", Locations: types.Locations{{StartLine: 136, EndLine: 141}}},
+		{ID: "aho-corasick@0.7.3", Name: "aho-corasick", Version: "0.7.3", Locations: types.Locations{{StartLine: 3, EndLine: 9}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:e6f484ae0c99fec2e858eb6134949117399f222608d84cadb3f58c1f97c2364c"}},
+		{ID: "base64@0.9.3", Name: "base64", Version: "0.9.3", Locations: types.Locations{{StartLine: 11, EndLine: 18}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:489d6c0ed21b11d038c31b6ceccca973e65d73ba3bd8ecb9a2babf5546164643"}},
+		{ID: "byteorder@1.3.1", Name: "byteorder", Version: "1.3.1", Locations: types.Locations{{StartLine: 20, EndLine: 23}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:a019b10a2a7cdeb292db131fc8113e57ea2a908f6e7894b0c3c671893b65dbeb"}},
+		{ID: "cfg-if@0.1.7", Name: "cfg-if", Version: "0.1.7", Locations: types.Locations{{StartLine: 25, EndLine: 28}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:11d43355396e872eefb45ce6342e4374ed7bc2b3a502d1b28e36d6e23c05d1f4"}},
+		{ID: "groupable@0.2.0", Name: "groupable", Version: "0.2.0", Locations: types.Locations{{StartLine: 30, EndLine: 33}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:32619942b8be646939eaf3db0602b39f5229b74575b67efc897811ded1db4e57"}},
+		{ID: "httparse@1.3.3", Name: "httparse", Version: "1.3.3", Locations: types.Locations{{StartLine: 35, EndLine: 38}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:e8734b0cfd3bc3e101ec59100e101c2eecd19282202e87808b3037b442777a83"}},
+		{ID: "hyper@0.10.16", Name: "hyper", Version: "0.10.16", Locations: types.Locations{{StartLine: 40, EndLine: 56}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:0a0652d9a2609a968c14be1a9ea00bf4b1d64e2e1f53a1b51b6fff3a6e829273"}},
+		{ID: "idna@0.1.5", Name: "idna", Version: "0.1.5", Locations: types.Locations{{StartLine: 58, EndLine: 66}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:38f09e0f0b1fb55fdee1f17470ad800da77af5186a1a76c026b679358b7e844e"}},
+		{ID: "itoa@0.4.4", Name: "itoa", Version: "0.4.4", Locations: types.Locations{{StartLine: 68, EndLine: 71}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:501266b7edd0174f8530248f87f99c88fbe60ca4ef3dd486835b8d8d53136f7f"}},
+		{ID: "language-tags@0.2.2", Name: "language-tags", Version: "0.2.2", Locations: types.Locations{{StartLine: 73, EndLine: 76}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:a91d884b6667cd606bb5a69aa0c99ba811a115fc68915e7056ec08a46e93199a"}},
+		{ID: "lazy_static@1.3.0", Name: "lazy_static", Version: "1.3.0", Locations: types.Locations{{StartLine: 78, EndLine: 81}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:bc5729f27f159ddd61f4df6228e827e86643d4d3e7c32183cb30a1c08f604a14"}},
+		{ID: "libc@0.2.54", Name: "libc", Version: "0.2.54", Locations: types.Locations{{StartLine: 83, EndLine: 86}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c6785aa7dd976f5fbf3b71cfd9cd49d7f783c1ff565a858d71031c6c313aa5c6"}},
+		{ID: "log@0.3.9", Name: "log", Version: "0.3.9", Locations: types.Locations{{StartLine: 88, EndLine: 94}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:e19e8d5c34a3e0e2223db8e060f9e8264aeeb5c5fc64a4ee9965c062211c024b"}},
+		{ID: "log@0.4.6", Name: "log", Version: "0.4.6", Locations: types.Locations{{StartLine: 96, EndLine: 102}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c84ec4b527950aa83a329754b01dbe3f58361d1c5efacd1f6d68c494d08a17c6"}},
+		{ID: "matches@0.1.8", Name: "matches", Version: "0.1.8", Locations: types.Locations{{StartLine: 104, EndLine: 107}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7ffc5c5338469d4d3ea17d269fa8ea3512ad247247c30bd2df69e68309ed0a08"}},
+		{ID: "memchr@2.2.0", Name: "memchr", Version: "2.2.0", Locations: types.Locations{{StartLine: 109, EndLine: 112}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:2efc7bc57c883d4a4d6e3246905283d8dae951bb3bd32f49d6ef297f546e1c39"}},
+		{ID: "mime@0.2.6", Name: "mime", Version: "0.2.6", Locations: types.Locations{{StartLine: 114, EndLine: 120}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:ba626b8a6de5da682e1caa06bdb42a335aee5a84db8e5046a3e8ab17ba0a3ae0"}},
+		{ID: "modifier@0.1.0", Name: "modifier", Version: "0.1.0", Locations: types.Locations{{StartLine: 122, EndLine: 125}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:41f5c9112cb662acd3b204077e0de5bc66305fa8df65c8019d5adb10e9ab6e58"}},
+		{ID: "mustache@0.9.0", Name: "mustache", Version: "0.9.0", Locations: types.Locations{{StartLine: 127, EndLine: 134}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:51956ef1c5d20a1384524d91e616fb44dfc7d8f249bf696d49c97dd3289ecab5"}},
+		{ID: "nickel@0.11.0", Name: "nickel", Version: "0.11.0", Locations: types.Locations{{StartLine: 143, EndLine: 161}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:e5061a832728db2dacb61cefe0ce303b58f85764ec680e71d9138229640a46d9"}},
+		{ID: "num_cpus@1.10.0", Name: "num_cpus", Version: "1.10.0", Locations: types.Locations{{StartLine: 163, EndLine: 169}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:1a23f0ed30a54abaa0c7e83b1d2d87ada7c3c23078d1d87815af3e3b6385fbba"}},
+		{ID: "percent-encoding@1.0.1", Name: "percent-encoding", Version: "1.0.1", Locations: types.Locations{{StartLine: 171, EndLine: 174}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:31010dd2e1ac33d5b46a5b413495239882813e0369f8ed8a5e266f173602f831"}},
+		{ID: "plugin@0.2.6", Name: "plugin", Version: "0.2.6", Locations: types.Locations{{StartLine: 176, EndLine: 182}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:1a6a0dc3910bc8db877ffed8e457763b317cf880df4ae19109b9f77d277cf6e0"}},
+		{ID: "redox_syscall@0.1.54", Name: "redox_syscall", Version: "0.1.54", Locations: types.Locations{{StartLine: 184, EndLine: 187}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:12229c14a0f65c4f1cb046a3b52047cdd9da1f4b30f8a39c5063c8bae515e252"}},
+		{ID: "regex@1.1.6", Name: "regex", Version: "1.1.6", Locations: types.Locations{{StartLine: 189, EndLine: 199}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:8f0a0bcab2fd7d1d7c54fa9eae6f43eddeb9ce2e7352f8518a814a4f65d60c58"}},
+		{ID: "regex-syntax@0.6.6", Name: "regex-syntax", Version: "0.6.6", Locations: types.Locations{{StartLine: 201, EndLine: 207}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:dcfd8681eebe297b81d98498869d4aae052137651ad7b96822f09ceb690d0a96"}},
+		{ID: "ryu@0.2.8", Name: "ryu", Version: "0.2.8", Locations: types.Locations{{StartLine: 209, EndLine: 212}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:b96a9549dc8d48f2c283938303c4b5a77aa29bfbc5b54b084fb1630408899a8f"}},
+		{ID: "safemem@0.3.0", Name: "safemem", Version: "0.3.0", Locations: types.Locations{{StartLine: 214, EndLine: 217}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:8dca453248a96cb0749e36ccdfe2b0b4e54a61bfef89fb97ec621eb8e0a93dd9"}},
+		{ID: "serde@1.0.91", Name: "serde", Version: "1.0.91", Locations: types.Locations{{StartLine: 219, EndLine: 222}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:a72e9b96fa45ce22a4bc23da3858dfccfd60acd28a25bcd328a98fdd6bea43fd"}},
+		{ID: "serde_json@1.0.39", Name: "serde_json", Version: "1.0.39", Locations: types.Locations{{StartLine: 224, EndLine: 232}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:5a23aa71d4a4d43fdbfaac00eff68ba8a06a51759a89ac3304323e800c4dd40d"}},
+		{ID: "smallvec@0.6.9", Name: "smallvec", Version: "0.6.9", Locations: types.Locations{{StartLine: 234, EndLine: 237}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c4488ae950c49d403731982257768f48fada354a5203fe81f9bb6f43ca9002be"}},
+		{ID: "thread_local@0.3.6", Name: "thread_local", Version: "0.3.6", Locations: types.Locations{{StartLine: 239, EndLine: 245}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:c6b53e329000edc2b34dbe8545fd20e55a333362d0a321909685a19bd28c3f1b"}},
+		{ID: "time@0.1.42", Name: "time", Version: "0.1.42", Locations: types.Locations{{StartLine: 247, EndLine: 255}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:db8dcfca086c1143c9270ac42a2bbd8a7ee477b78ac8e45b19abfb0cbede4b6f"}},
+		{ID: "traitobject@0.1.0", Name: "traitobject", Version: "0.1.0", Locations: types.Locations{{StartLine: 257, EndLine: 260}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:efd1f82c56340fdf16f2a953d7bda4f8fdffba13d93b00844c25572110b26079"}},
+		{ID: "typeable@0.1.2", Name: "typeable", Version: "0.1.2", Locations: types.Locations{{StartLine: 262, EndLine: 265}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:1410f6f91f21d1612654e7cc69193b0334f909dcf2c790c4826254fbb86f8887"}},
+		{ID: "typemap@0.3.3", Name: "typemap", Version: "0.3.3", Locations: types.Locations{{StartLine: 267, EndLine: 273}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:653be63c80a3296da5551e1bfd2cca35227e13cdd08c6668903ae2f4f77aa1f6"}},
+		{ID: "ucd-util@0.1.3", Name: "ucd-util", Version: "0.1.3", Locations: types.Locations{{StartLine: 275, EndLine: 278}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:535c204ee4d8434478593480b8f86ab45ec9aae0e83c568ca81abf0fd0e88f86"}},
+		{ID: "unicase@1.4.2", Name: "unicase", Version: "1.4.2", Locations: types.Locations{{StartLine: 280, EndLine: 286}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:7f4765f83163b74f957c797ad9253caf97f103fb064d3999aea9568d09fc8a33"}},
+		{ID: "unicode-bidi@0.3.4", Name: "unicode-bidi", Version: "0.3.4", Locations: types.Locations{{StartLine: 288, EndLine: 294}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:49f2bd0c6468a8230e1db229cff8029217cf623c767ea5d60bfbd42729ea54d5"}},
+		{ID: "unicode-normalization@0.1.8", Name: "unicode-normalization", Version: "0.1.8", Locations: types.Locations{{StartLine: 296, EndLine: 302}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:141339a08b982d942be2ca06ff8b076563cbe223d1befd5450716790d44e2426"}},
+		{ID: "unsafe-any@0.4.2", Name: "unsafe-any", Version: "0.4.2", Locations: types.Locations{{StartLine: 304, EndLine: 310}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:f30360d7979f5e9c6e6cea48af192ea8fab4afb3cf72597154b8f08935bc9c7f"}},
+		{ID: "url@1.7.2", Name: "url", Version: "1.7.2", Locations: types.Locations{{StartLine: 312, EndLine: 320}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:dd4e7c0d531266369519a4aa4f399d748bd37043b00bde1e4ff1f60a120b355a"}},
+		{ID: "utf8-ranges@1.0.2", Name: "utf8-ranges", Version: "1.0.2", Locations: types.Locations{{StartLine: 322, EndLine: 325}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:796f7e48bef87609f7ade7e06495a87d5cd06c7866e6a5cbfceffc558a243737"}},
+		{ID: "version_check@0.1.5", Name: "version_check", Version: "0.1.5", Locations: types.Locations{{StartLine: 327, EndLine: 330}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:914b1a6776c4c929a602fafd8bc742e06365d4bcbe48c30f9cca5824f70dc9dd"}},
+		{ID: "winapi@0.3.7", Name: "winapi", Version: "0.3.7", Locations: types.Locations{{StartLine: 332, EndLine: 339}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:f10e386af2b13e47c89e7236a7a14a086791a2b88ebad6df9bf42040195cf770"}},
+		{ID: "winapi-i686-pc-windows-gnu@0.4.0", Name: "winapi-i686-pc-windows-gnu", Version: "0.4.0", Locations: types.Locations{{StartLine: 341, EndLine: 344}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:ac3b87c63620426dd9b991e5ce0329eff545bccbbb34f3be09ff6fb6ab51b7b6"}},
+		{ID: "winapi-x86_64-pc-windows-gnu@0.4.0", Name: "winapi-x86_64-pc-windows-gnu", Version: "0.4.0", Locations: types.Locations{{StartLine: 346, EndLine: 349}}, Resolved: "registry+https://github.com/rust-lang/crates.io-index", Digests: []types.Digest{"sha256:712e227841d057c1ee1cd2fb22fa7e5a5461ae8e48fa2ca79ec42cfc1931183f"}},
+	}
+
+	cargoNormalDeps = []types.Dependency{
+		{ID: "normal@0.1.0", DependsOn: []string{"libc@0.2.54"}},
+	}
+
+	cargoManyDeps = []types.Dependency{
+		{ID: "aho-corasick@0.7.3", DependsOn: []string{"memchr@2.2.0"}},
+		{ID: "base64@0.10.1", DependsOn: []string{"byteorder@1.3.1"}},
+		{ID: "base64@0.9.3", DependsOn: []string{"byteorder@1.3.1", "safemem@0.3.0"}},
+		{
+			ID: "block-buffer@0.7.3",
+			DependsOn: []string{
+				"block-padding@0.1.4",
+				"byte-tools@0.3.1",
+				"byteorder@1.3.1",
+				"generic-array@0.12.0",
+			},
+		},
+		{ID: "block-padding@0.1.4", DependsOn: []string{"byte-tools@0.3.1"}},
+		{ID: "cloudabi@0.0.3", DependsOn: []string{"bitflags@1.0.4"}},
+		{ID: "cookie@0.11.1", DependsOn: []string{"base64@0.9.3", "ring@0.13.5", "time@0.1.42", "url@1.7.2"}},
+		{ID: "devise@0.2.0", DependsOn: []string{"devise_codegen@0.2.0", "devise_core@0.2.0"}},
+		{ID: "devise_codegen@0.2.0", DependsOn: []string{"devise_core@0.2.0", "quote@0.6.12"}},
+		{ID: "devise_core@0.2.0", DependsOn: []string{"bitflags@1.0.4", "proc-macro2@0.4.30", "quote@0.6.12", "syn@0.15.34"}},
+		{ID: "digest@0.8.0", DependsOn: []string{"generic-array@0.12.0"}},
+		{ID: "generic-array@0.12.0", DependsOn: []string{"typenum@1.10.0"}},
+		{
+			ID: "handlebars@1.1.0",
+			DependsOn: []string{
+				"lazy_static@1.3.0",
+				"log@0.4.6",
+				"pest@2.1.1",
+				"pest_derive@2.1.0",
+				"quick-error@1.2.2",
+				"regex@1.1.6",
+				"serde@1.0.91",
+				"serde_json@1.0.39",
+				"walkdir@2.2.7",
+			},
+		},
+		{
+			ID: "hyper@0.10.16",
+			DependsOn: []string{
+				"base64@0.9.3",
+				"httparse@1.3.3",
+				"language-tags@0.2.2",
+				"log@0.3.9",
+				"mime@0.2.6",
+				"num_cpus@1.10.0",
+				"time@0.1.42",
+				"traitobject@0.1.0",
+				"typeable@0.1.2",
+				"unicase@1.4.2",
+				"url@1.7.2",
+			},
+		},
+		{ID: "idna@0.1.5", DependsOn: []string{"matches@0.1.8", "unicode-bidi@0.3.4", "unicode-normalization@0.1.8"}},
+		{ID: "isatty@0.1.9", DependsOn: []string{"cfg-if@0.1.7", "libc@0.2.54", "redox_syscall@0.1.54", "winapi@0.3.7"}},
+		{ID: "log@0.3.9", DependsOn: []string{"log@0.4.6"}},
+		{ID: "log@0.4.6", DependsOn: []string{"cfg-if@0.1.7"}},
+		{
+			ID: "many@0.1.0",
+			DependsOn: []string{
+				"bitflags@1.0.4",
+				"handlebars@1.1.0",
+				"lazy_static@1.3.0",
+				"log@0.4.6",
+				"quote@0.6.12",
+				"rand@0.6.5",
+				"regex@1.1.6",
+				"rocket@0.4.0",
+				"serde@1.0.91",
+				"syn@0.15.34",
+			},
+		},
+		{ID: "mime@0.2.6", DependsOn: []string{"log@0.3.9"}},
+		{ID: "num_cpus@1.10.0", DependsOn: []string{"libc@0.2.54"}},
+		{ID: "pear@0.1.2", DependsOn: []string{"pear_codegen@0.1.2"}},
+		{
+			ID: "pear_codegen@0.1.2",
+			DependsOn: []string{
+				"proc-macro2@0.4.30",
+				"quote@0.6.12",
+				"syn@0.15.34",
+				"version_check@0.1.5",
+				"yansi@0.4.0",
+			},
+		},
+		{ID: "pest@2.1.1", DependsOn: []string{"ucd-trie@0.1.1"}},
+		{ID: "pest_derive@2.1.0", DependsOn: []string{"pest@2.1.1", "pest_generator@2.1.0"}},
+		{
+			ID: "pest_generator@2.1.0",
+			DependsOn: []string{
+				"pest@2.1.1",
+				"pest_meta@2.1.1",
+				"proc-macro2@0.4.30",
+				"quote@0.6.12",
+				"syn@0.15.34",
+			},
+		},
+		{ID: "pest_meta@2.1.1", DependsOn: []string{"maplit@1.0.1", "pest@2.1.1", "sha-1@0.8.1"}},
+		{ID: "proc-macro2@0.4.30", DependsOn: []string{"unicode-xid@0.1.0"}},
+		{ID: "quote@0.6.12", DependsOn: []string{"proc-macro2@0.4.30"}},
+		{
+			ID: "rand@0.6.5",
+			DependsOn: []string{
+				"autocfg@0.1.2",
+				"libc@0.2.54",
+				"rand_chacha@0.1.1",
+				"rand_core@0.4.0",
+				"rand_hc@0.1.0",
+				"rand_isaac@0.1.1",
+				"rand_jitter@0.1.4",
+				"rand_os@0.1.3",
+				"rand_pcg@0.1.2",
+				"rand_xorshift@0.1.1",
+				"winapi@0.3.7",
+			},
+		},
+		{ID: "rand_chacha@0.1.1", DependsOn: []string{"autocfg@0.1.2", "rand_core@0.3.1"}},
+		{ID: "rand_core@0.3.1", DependsOn: []string{"rand_core@0.4.0"}},
+		{ID: "rand_hc@0.1.0", DependsOn: []string{"rand_core@0.3.1"}},
+		{ID: "rand_isaac@0.1.1", DependsOn: []string{"rand_core@0.3.1"}},
+		{ID: "rand_jitter@0.1.4", DependsOn: []string{"libc@0.2.54", "rand_core@0.4.0", "winapi@0.3.7"}},
+		{
+			ID: "rand_os@0.1.3",
+			DependsOn: []string{
+				"cloudabi@0.0.3",
+				"fuchsia-cprng@0.1.1",
+				"libc@0.2.54",
+				"rand_core@0.4.0",
+				"rdrand@0.4.0",
+				"winapi@0.3.7",
+			},
+		},
+		{ID: "rand_pcg@0.1.2", DependsOn: []string{"autocfg@0.1.2", "rand_core@0.4.0"}},
+		{ID: "rand_xorshift@0.1.1", DependsOn: []string{"rand_core@0.3.1"}},
+		{ID: "rdrand@0.4.0", DependsOn: []string{"rand_core@0.3.1"}},
+		{ID: "regex-syntax@0.6.6", DependsOn: []string{"ucd-util@0.1.3"}},
+		{
+			ID: "regex@1.1.6",
+			DependsOn: []string{
+				"aho-corasick@0.7.3",
+				"memchr@2.2.0",
+				"regex-syntax@0.6.6",
+				"thread_local@0.3.6",
+				"utf8-ranges@1.0.2",
+			},
+		},
+		{ID: "ring@0.13.5", DependsOn: []string{"cc@1.0.36", "lazy_static@1.3.0", "libc@0.2.54", "untrusted@0.6.2"}},
+		{
+			ID: "rocket@0.4.0",
+			DependsOn: []string{
+				"base64@0.10.1",
+				"isatty@0.1.9",
+				"log@0.4.6",
+				"memchr@2.2.0",
+				"num_cpus@1.10.0",
+				"pear@0.1.2",
+				"rocket_codegen@0.4.0",
+				"rocket_http@0.4.0",
+				"state@0.4.1",
+				"time@0.1.42",
+				"toml@0.4.10",
+				"version_check@0.1.5",
+				"yansi@0.5.0",
+			},
+		},
+		{
+			ID: "rocket_codegen@0.4.0",
+			DependsOn: []string{
+				"devise@0.2.0",
+				"indexmap@1.0.2",
+				"quote@0.6.12",
+				"rocket_http@0.4.0",
+				"version_check@0.1.5",
+				"yansi@0.5.0",
+			},
+		},
+		{
+			ID: "rocket_http@0.4.0",
+			DependsOn: []string{
+				"cookie@0.11.1",
+				"hyper@0.10.16",
+				"indexmap@1.0.2",
+				"pear@0.1.2",
+				"percent-encoding@1.0.1",
+				"smallvec@0.6.9",
+				"state@0.4.1",
+				"time@0.1.42",
+				"unicode-xid@0.1.0",
+			},
+		},
+		{ID: "same-file@1.0.4", DependsOn: []string{"winapi-util@0.1.2"}},
+		{ID: "serde_json@1.0.39", DependsOn: []string{"itoa@0.4.4", "ryu@0.2.8", "serde@1.0.91"}},
+		{
+			ID: "sha-1@0.8.1",
+			DependsOn: []string{
+				"block-buffer@0.7.3",
+				"digest@0.8.0",
+				"fake-simd@0.1.2",
+				"opaque-debug@0.2.2",
+			},
+		},
+		{ID: "syn@0.15.34", DependsOn: []string{"proc-macro2@0.4.30", "quote@0.6.12", "unicode-xid@0.1.0"}},
+		{ID: "thread_local@0.3.6", DependsOn: []string{"lazy_static@1.3.0"}},
+		{ID: "time@0.1.42", DependsOn: []string{"libc@0.2.54", "redox_syscall@0.1.54", "winapi@0.3.7"}},
+		{ID: "toml@0.4.10", DependsOn: []string{"serde@1.0.91"}},
+		{ID: "unicase@1.4.2", DependsOn: []string{"version_check@0.1.5"}},
+		{ID: "unicode-bidi@0.3.4", DependsOn: []string{"matches@0.1.8"}},
+		{ID: "unicode-normalization@0.1.8", DependsOn: []string{"smallvec@0.6.9"}},
+		{ID: "url@1.7.2", DependsOn: []string{"idna@0.1.5", "matches@0.1.8", "percent-encoding@1.0.1"}},
+		{ID: "walkdir@2.2.7", DependsOn: []string{"same-file@1.0.4", "winapi-util@0.1.2", "winapi@0.3.7"}},
+		{ID: "winapi-util@0.1.2", DependsOn: []string{"winapi@0.3.7"}},
+		{ID: "winapi@0.3.7", DependsOn: []string{"winapi-i686-pc-windows-gnu@0.4.0", "winapi-x86_64-pc-windows-gnu@0.4.0"}},
+	}
+
+	cargoNickelDeps = []types.Dependency{
+		{ID: "aho-corasick@0.7.3", DependsOn: []string{"memchr@2.2.0"}},
+		{ID: "base64@0.9.3", DependsOn: []string{"byteorder@1.3.1", "safemem@0.3.0"}},
+		{
+			ID: "hyper@0.10.16",
+			DependsOn: []string{
+				"base64@0.9.3",
+				"httparse@1.3.3",
+				"language-tags@0.2.2",
+				"log@0.3.9",
+				"mime@0.2.6",
+				"num_cpus@1.10.0",
+				"time@0.1.42",
+				"traitobject@0.1.0",
+				"typeable@0.1.2",
+				"unicase@1.4.2",
+				"url@1.7.2",
+			},
+		},
+		{ID: "idna@0.1.5", DependsOn: []string{"matches@0.1.8", "unicode-bidi@0.3.4", "unicode-normalization@0.1.8"}},
+		{ID: "log@0.3.9", DependsOn: []string{"log@0.4.6"}},
+		{ID: "log@0.4.6", DependsOn: []string{"cfg-if@0.1.7"}},
+		{ID: "mime@0.2.6", DependsOn: []string{"log@0.3.9"}},
+		{ID: "mustache@0.9.0", DependsOn: []string{"log@0.3.9", "serde@1.0.91"}},
+		{
+			ID: "nickel@0.11.0",
+			DependsOn: []string{
+				"groupable@0.2.0",
+				"hyper@0.10.16",
+				"lazy_static@1.3.0",
+				"log@0.3.9",
+				"modifier@0.1.0",
+				"mustache@0.9.0",
+				"plugin@0.2.6",
+				"regex@1.1.6",
+				"serde@1.0.91",
+				"serde_json@1.0.39",
+				"time@0.1.42",
+				"typemap@0.3.3",
+				"url@1.7.2",
+			},
+		},
+		{ID: "num_cpus@1.10.0", DependsOn: []string{"libc@0.2.54"}},
+		{ID: "plugin@0.2.6", DependsOn: []string{"typemap@0.3.3"}},
+		{ID: "regex-syntax@0.6.6", DependsOn: []string{"ucd-util@0.1.3"}},
+		{
+			ID: "regex@1.1.6",
+			DependsOn: []string{
+				"aho-corasick@0.7.3",
+				"memchr@2.2.0",
+				"regex-syntax@0.6.6",
+				"thread_local@0.3.6",
+				"utf8-ranges@1.0.2",
+			},
+		},
+		{ID: "serde_json@1.0.39", DependsOn: []string{"itoa@0.4.4", "ryu@0.2.8", "serde@1.0.91"}},
+		{ID: "thread_local@0.3.6", DependsOn: []string{"lazy_static@1.3.0"}},
+		{ID: "time@0.1.42", DependsOn: []string{"libc@0.2.54", "redox_syscall@0.1.54", "winapi@0.3.7"}},
+		{ID: "typemap@0.3.3", DependsOn: []string{"unsafe-any@0.4.2"}},
+		{ID: "unicase@1.4.2", DependsOn: []string{"version_check@0.1.5"}},
+		{ID: "unicode-bidi@0.3.4", DependsOn: []string{"matches@0.1.8"}},
+		{ID: "unicode-normalization@0.1.8", DependsOn: []string{"smallvec@0.6.9"}},
+		{ID: "unsafe-any@0.4.2", DependsOn: []string{"traitobject@0.1.0"}},
+		{ID: "url@1.7.2", DependsOn: []string{"idna@0.1.5", "matches@0.1.8", "percent-encoding@1.0.1"}},
+		{ID: "web@0.1.0", DependsOn: []string{"nickel@0.11.0"}},
+		{ID: "winapi@0.3.7", DependsOn: []string{"winapi-i686-pc-windows-gnu@0.4.0", "winapi-x86_64-pc-windows-gnu@0.4.0"}},
 	}
 )
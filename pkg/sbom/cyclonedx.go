@@ -0,0 +1,108 @@
+package sbom
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/cyclonedx"
+)
+
+func encodeCycloneDXJSON(w io.Writer, g graph, opts options) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(newCycloneDX(g, opts))
+}
+
+func encodeCycloneDXXML(w io.Writer, g graph, opts options) error {
+	bom := newCycloneDX(g, opts)
+	bom.XMLNS = cyclonedx.Namespace
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	if err := e.Encode(bom); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func newCycloneDX(g graph, opts options) cyclonedx.BOM {
+	root := cyclonedx.Component{
+		Type:   cyclonedx.ComponentTypeApplication,
+		BOMRef: opts.name,
+		Name:   opts.name,
+	}
+	bom := cyclonedx.BOM{
+		BOMFormat:    cyclonedx.BOMFormat,
+		SpecVersion:  cyclonedx.SpecVersion,
+		SerialNumber: "urn:uuid:" + opts.uuid,
+		Version:      1,
+		Metadata: &cyclonedx.Metadata{
+			Timestamp: opts.timestamp.UTC().Format(time.RFC3339),
+			Tools: []cyclonedx.Tool{
+				{
+					Vendor: toolVendor,
+					Name:   toolName,
+				},
+			},
+			Component: &root,
+		},
+	}
+
+	rootDep := cyclonedx.Dependency{Ref: root.BOMRef}
+	var appDeps []cyclonedx.Dependency
+	for _, app := range g.apps {
+		bom.Components = append(bom.Components, cyclonedx.Component{
+			Type:   cyclonedx.ComponentTypeApplication,
+			BOMRef: app.ref,
+			Name:   app.ref,
+		})
+		rootDep.DependsOn = append(rootDep.DependsOn, app.ref)
+		appDeps = append(appDeps, cyclonedx.Dependency{
+			Ref:       app.ref,
+			DependsOn: app.refs,
+		})
+	}
+
+	var componentDeps []cyclonedx.Dependency
+	for _, c := range g.components {
+		bom.Components = append(bom.Components, cyclonedx.Component{
+			Type:     cyclonedx.ComponentTypeLibrary,
+			BOMRef:   c.ref,
+			Name:     c.lib.Name,
+			Version:  c.lib.Version,
+			Licenses: cycloneDXLicenses(c.lib.License),
+			PURL:     c.purl,
+		})
+		if c.hasGraph {
+			componentDeps = append(componentDeps, cyclonedx.Dependency{
+				Ref:       c.ref,
+				DependsOn: c.dependsOn,
+			})
+		}
+	}
+
+	bom.Dependencies = append([]cyclonedx.Dependency{rootDep}, appDeps...)
+	bom.Dependencies = append(bom.Dependencies, componentDeps...)
+	return bom
+}
+
+func cycloneDXLicenses(license string) cyclonedx.Licenses {
+	switch {
+	case license == "":
+		return nil
+	case !isLicenseExpression(license):
+		return cyclonedx.Licenses{{License: &cyclonedx.License{Name: license}}}
+	case strings.Contains(license, " "):
+		// Compound expressions such as "MIT OR Apache-2.0"
+		return cyclonedx.Licenses{{Expression: license}}
+	}
+	id, _ := spdxLicenseID(license)
+	return cyclonedx.Licenses{{License: &cyclonedx.License{ID: id}}}
+}
@@ -0,0 +1,202 @@
+package cyclonedx
+
+import (
+	"encoding/xml"
+)
+
+// CycloneDX 1.4
+// ref. https://cyclonedx.org/docs/1.4/json/
+const (
+	BOMFormat   = "CycloneDX"
+	SpecVersion = "1.4"
+	Namespace   = "http://cyclonedx.org/schema/bom/1.4"
+
+	ComponentTypeApplication = "application"
+	ComponentTypeFramework   = "framework"
+	ComponentTypeLibrary     = "library"
+)
+
+type BOM struct {
+	XMLName      xml.Name     `json:"-" xml:"bom"`
+	XMLNS        string       `json:"-" xml:"xmlns,attr,omitempty"`
+	BOMFormat    string       `json:"bomFormat" xml:"-"`
+	SpecVersion  string       `json:"specVersion" xml:"-"`
+	SerialNumber string       `json:"serialNumber,omitempty" xml:"serialNumber,attr,omitempty"`
+	Version      int          `json:"version" xml:"version,attr"`
+	Metadata     *Metadata    `json:"metadata,omitempty" xml:"metadata,omitempty"`
+	Components   Components   `json:"components,omitempty" xml:"components,omitempty"`
+	Dependencies []Dependency `json:"dependencies,omitempty" xml:"dependencies>dependency,omitempty"`
+}
+
+type Metadata struct {
+	Timestamp string     `json:"timestamp,omitempty" xml:"timestamp,omitempty"`
+	Tools     []Tool     `json:"tools,omitempty" xml:"tools>tool,omitempty"`
+	Component *Component `json:"component,omitempty" xml:"component,omitempty"`
+}
+
+type Tool struct {
+	Vendor  string `json:"vendor,omitempty" xml:"vendor,omitempty"`
+	Name    string `json:"name,omitempty" xml:"name,omitempty"`
+	Version string `json:"version,omitempty" xml:"version,omitempty"`
+}
+
+type Component struct {
+	Type     string   `json:"type" xml:"type,attr"`
+	BOMRef   string   `json:"bom-ref,omitempty" xml:"bom-ref,attr,omitempty"`
+	Group    string   `json:"group,omitempty" xml:"group,omitempty"`
+	Name     string   `json:"name" xml:"name"`
+	Version  string   `json:"version,omitempty" xml:"version,omitempty"`
+	Licenses Licenses `json:"licenses,omitempty" xml:"licenses,omitempty"`
+	PURL     string   `json:"purl,omitempty" xml:"purl,omitempty"`
+
+	// Components are nested components such as libraries bundled in a JAR file.
+	Components Components `json:"components,omitempty" xml:"components,omitempty"`
+}
+
+// Components implements xml.Marshaler since "components>component,omitempty"
+// doesn't omit the empty parent element.
+type Components []Component
+
+func (cs Components) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(cs) == 0 {
+		return nil
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, c := range cs {
+		if err := e.EncodeElement(c, xml.StartElement{Name: xml.Name{Local: "component"}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+func (cs *Components) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v struct {
+		Components []Component `xml:"component"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*cs = append(*cs, v.Components...)
+	return nil
+}
+
+// Licenses is a list of licenses or SPDX expressions.
+// e.g. [{"license": {"id": "MIT"}}, {"expression": "MIT OR Apache-2.0"}]
+type Licenses []LicenseChoice
+
+type LicenseChoice struct {
+	License    *License `json:"license,omitempty"`
+	Expression string   `json:"expression,omitempty"`
+}
+
+type License struct {
+	ID   string `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// MarshalXML encodes licenses as the XML schema requires.
+// e.g. <licenses><license><id>MIT</id></license><expression>MIT OR Apache-2.0</expression></licenses>
+func (l Licenses) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(l) == 0 {
+		return nil
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, choice := range l {
+		var err error
+		switch {
+		case choice.License != nil:
+			err = e.EncodeElement(choice.License, xml.StartElement{Name: xml.Name{Local: "license"}})
+		case choice.Expression != "":
+			err = e.EncodeElement(choice.Expression, xml.StartElement{Name: xml.Name{Local: "expression"}})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML decodes licenses written by MarshalXML.
+func (l *Licenses) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "license":
+				var license License
+				if err = d.DecodeElement(&license, &t); err != nil {
+					return err
+				}
+				*l = append(*l, LicenseChoice{License: &license})
+			case "expression":
+				var expression string
+				if err = d.DecodeElement(&expression, &t); err != nil {
+					return err
+				}
+				*l = append(*l, LicenseChoice{Expression: expression})
+			default:
+				if err = d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+type Dependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// MarshalXML encodes dependencies as nested elements.
+// e.g. <dependency ref="a"><dependency ref="b"></dependency></dependency>
+func (d Dependency) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "ref"}, Value: d.Ref})
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, ref := range d.DependsOn {
+		if err := e.EncodeElement(Dependency{Ref: ref}, xml.StartElement{Name: start.Name}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML decodes nested dependencies written by MarshalXML.
+func (d *Dependency) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "ref" {
+			d.Ref = attr.Value
+		}
+	}
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			var child Dependency
+			if err = dec.DecodeElement(&child, &t); err != nil {
+				return err
+			}
+			if child.Ref != "" {
+				d.DependsOn = append(d.DependsOn, child.Ref)
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package cyclonedx
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/internal/graph"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// Parse parses a CycloneDX JSON document.
+func Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	var bom BOM
+	if err := json.NewDecoder(r).Decode(&bom); err != nil {
+		return nil, nil, xerrors.Errorf("decode error: %w", err)
+	}
+	return parseBOM(bom)
+}
+
+// ParseXML parses a CycloneDX XML document.
+func ParseXML(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	var bom BOM
+	if err := xml.NewDecoder(r).Decode(&bom); err != nil {
+		return nil, nil, xerrors.Errorf("decode error: %w", err)
+	}
+	return parseBOM(bom)
+}
+
+// parseBOM returns the libraries in the same way as lock files.
+// The component which the BOM describes (metadata.component) and applications are not included.
+func parseBOM(bom BOM) ([]types.Library, []types.Dependency, error) {
+	if bom.BOMFormat != "" && bom.BOMFormat != BOMFormat {
+		return nil, nil, xerrors.Errorf("invalid bomFormat: %s", bom.BOMFormat)
+	}
+
+	g := graph.New()
+	for _, c := range flatten(bom.Components) {
+		if c.Type != ComponentTypeLibrary && c.Type != ComponentTypeFramework {
+			continue
+		}
+		if lib := toLibrary(c); lib.Name != "" {
+			g.AddLibrary(c.BOMRef, lib)
+		}
+	}
+	for _, dep := range bom.Dependencies {
+		for _, ref := range dep.DependsOn {
+			g.AddDependency(dep.Ref, ref)
+		}
+	}
+
+	libs, deps := g.Result()
+	return libs, deps, nil
+}
+
+func flatten(components []Component) []Component {
+	var flattened []Component
+	for _, c := range components {
+		flattened = append(flattened, c)
+		flattened = append(flattened, flatten(c.Components)...)
+	}
+	return flattened
+}
+
+// toLibrary prefers the purl so that the name is the same as parsers return.
+// e.g. pkg:maven/org.example/example-api@1.0.0 => org.example:example-api
+// The ecosystem is known only from the purl. Without the purl, the group is prepended as Maven does.
+func toLibrary(c Component) types.Library {
+	lib := types.Library{
+		Name:    c.Name,
+		Version: c.Version,
+	}
+	if c.Group != "" && c.Name != "" {
+		lib.Name = c.Group + ":" + c.Name
+	}
+	if c.PURL != "" {
+		if p, err := purl.Parse(c.PURL); err == nil {
+			lib = p.Library()
+			lib.Ecosystem, _ = p.Ecosystem()
+		}
+	}
+	lib.License = license(c.Licenses)
+	return lib
+}
+
+func license(licenses Licenses) string {
+	var ss []string
+	for _, l := range licenses {
+		switch {
+		case l.License != nil && l.License.ID != "":
+			ss = append(ss, l.License.ID)
+		case l.License != nil && l.License.Name != "":
+			ss = append(ss, l.License.Name)
+		case l.Expression != "":
+			ss = append(ss, l.Expression)
+		}
+	}
+	return strings.Join(ss, ", ")
+}
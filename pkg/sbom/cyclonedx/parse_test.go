@@ -0,0 +1,75 @@
+package cyclonedx
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		parse    func(io.Reader) ([]types.Library, []types.Dependency, error)
+		want     []types.Library
+		wantDeps []types.Dependency
+		wantErr  string
+	}{
+		{
+			name:     "JSON",
+			file:     "testdata/happy.json",
+			parse:    Parse,
+			want:     cycloneDXHappy,
+			wantDeps: cycloneDXHappyDeps,
+		},
+		{
+			name:     "XML",
+			file:     "testdata/happy.xml",
+			parse:    ParseXML,
+			want:     cycloneDXHappy,
+			wantDeps: cycloneDXHappyDeps,
+		},
+		{
+			name:     "nested components",
+			file:     "testdata/nested.json",
+			parse:    Parse,
+			want:     cycloneDXNested,
+			wantDeps: cycloneDXNestedDeps,
+		},
+		{
+			name:     "same name in several ecosystems",
+			file:     "testdata/ecosystems.json",
+			parse:    Parse,
+			want:     cycloneDXEcosystems,
+			wantDeps: cycloneDXEcosystemsDeps,
+		},
+		{
+			name:    "invalid bomFormat",
+			file:    "testdata/invalid.json",
+			parse:   Parse,
+			wantErr: "invalid bomFormat",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, gotDeps, err := tt.parse(f)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDeps, gotDeps)
+		})
+	}
+}
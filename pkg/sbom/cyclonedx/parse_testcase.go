@@ -0,0 +1,40 @@
+package cyclonedx
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// Generated by sbom.Encode
+	cycloneDXHappy = []types.Library{
+		{ID: "pkg:npm/%40babel/core@7.14.6", Name: "@babel/core", Version: "7.14.6", License: "MIT", Ecosystem: types.Npm},
+		{ID: "pkg:npm/debug@4.3.1", Name: "debug", Version: "4.3.1", License: "MIT", Ecosystem: types.Npm},
+		{ID: "pkg:npm/ms@2.1.2", Name: "ms", Version: "2.1.2", Ecosystem: types.Npm},
+		{ID: "pkg:pypi/click@8.0.0", Name: "click", Version: "8.0.0", License: "BSD-3-Clause OR MIT", Ecosystem: types.PyPI},
+		{ID: "pkg:pypi/flask-sqlalchemy@2.5.1", Name: "flask-sqlalchemy", Version: "2.5.1", License: "BSD License", Ecosystem: types.PyPI},
+		{ID: "wordpress@4.9.4", Name: "wordpress", Version: "4.9.4"},
+	}
+	cycloneDXHappyDeps = []types.Dependency{
+		{ID: "pkg:npm/%40babel/core@7.14.6", DependsOn: []string{"pkg:npm/debug@4.3.1"}},
+		{ID: "pkg:npm/debug@4.3.1", DependsOn: []string{"pkg:npm/ms@2.1.2"}},
+	}
+
+	cycloneDXNested = []types.Library{
+		{ID: "internal-lib@0.1.0", Name: "internal-lib", Version: "0.1.0"},
+		{ID: "pkg:maven/org.apache.logging.log4j/log4j-api@2.14.1", Name: "org.apache.logging.log4j:log4j-api", Version: "2.14.1", Ecosystem: types.Maven},
+		{ID: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1", License: "Apache-2.0", Ecosystem: types.Maven},
+	}
+	cycloneDXNestedDeps = []types.Dependency{
+		{ID: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", DependsOn: []string{"pkg:maven/org.apache.logging.log4j/log4j-api@2.14.1"}},
+	}
+
+	cycloneDXEcosystems = []types.Library{
+		{ID: "com.example:internal-api@0.2.0", Name: "com.example:internal-api", Version: "0.2.0"},
+		{ID: "pkg:npm/debug@1.0.0", Name: "debug", Version: "1.0.0", Ecosystem: types.Npm},
+		{ID: "pkg:npm/ms@2.0.0", Name: "ms", Version: "2.0.0", Ecosystem: types.Npm},
+		{ID: "pkg:pypi/debug@1.0.0", Name: "debug", Version: "1.0.0", Ecosystem: types.PyPI},
+		{ID: "pkg:pypi/six@1.0.0", Name: "six", Version: "1.0.0", Ecosystem: types.PyPI},
+	}
+	cycloneDXEcosystemsDeps = []types.Dependency{
+		{ID: "pkg:npm/debug@1.0.0", DependsOn: []string{"pkg:npm/ms@2.0.0"}},
+		{ID: "pkg:pypi/debug@1.0.0", DependsOn: []string{"pkg:pypi/six@1.0.0"}},
+	}
+)
@@ -0,0 +1,82 @@
+// Package graph builds the libraries and the dependencies in the same way as lock files from SBOM documents.
+package graph
+
+import (
+	"sort"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// Graph collects the libraries referenced by the element IDs in the document. e.g. bom-ref and SPDXID
+type Graph struct {
+	libs map[string]types.Library
+	// element ID => library ID
+	ids       map[string]string
+	dependsOn map[string][]string
+}
+
+func New() *Graph {
+	return &Graph{
+		libs:      map[string]types.Library{},
+		ids:       map[string]string{},
+		dependsOn: map[string][]string{},
+	}
+}
+
+// LibraryID returns the purl if the ecosystem is known, since the same name and version
+// may be used in several ecosystems. Otherwise, it returns "name@version".
+// e.g. pkg:npm/debug@1.0.0
+func LibraryID(lib types.Library) string {
+	if lib.Ecosystem != "" {
+		if p, err := purl.FromLibrary(lib.Ecosystem, lib); err == nil {
+			return p
+		}
+	}
+	return utils.PackageID(lib.Name, lib.Version)
+}
+
+// AddLibrary adds the library with the ID. The same library is returned once.
+// The element ID can be empty if the library is not referenced.
+func (g *Graph) AddLibrary(elementID string, lib types.Library) {
+	lib.ID = LibraryID(lib)
+	g.libs[lib.ID] = lib
+	if elementID != "" {
+		g.ids[elementID] = lib.ID
+	}
+}
+
+// AddDependency adds the dependency between the elements. Elements which are not libraries are ignored.
+func (g *Graph) AddDependency(from, to string) {
+	id, ok := g.ids[from]
+	if !ok {
+		return
+	}
+	if depID, ok := g.ids[to]; ok {
+		g.dependsOn[id] = append(g.dependsOn[id], depID)
+	}
+}
+
+// Result returns the libraries and the dependencies sorted by ID.
+func (g *Graph) Result() ([]types.Library, []types.Dependency) {
+	var deps []types.Dependency
+	for id, depIDs := range g.dependsOn {
+		sort.Strings(depIDs)
+		deps = append(deps, types.Dependency{
+			ID:        id,
+			DependsOn: utils.UniqueStrings(depIDs),
+		})
+	}
+	sort.Slice(deps, func(i, j int) bool {
+		return deps[i].ID < deps[j].ID
+	})
+
+	libs := maps.Values(g.libs)
+	sort.Slice(libs, func(i, j int) bool {
+		return libs[i].ID < libs[j].ID
+	})
+	return libs, deps
+}
@@ -0,0 +1,252 @@
+package sbom
+
+import (
+	"crypto/rand"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type Format string
+
+const (
+	FormatCycloneDXJSON Format = "cyclonedx-json"
+	FormatCycloneDXXML  Format = "cyclonedx-xml"
+	FormatSPDXJSON      Format = "spdx-json"
+	FormatSPDXTagValue  Format = "spdx-tv"
+
+	toolVendor = "aquasecurity"
+	toolName   = "go-dep-parser"
+)
+
+var ErrUnsupportedFormat = xerrors.New("unsupported format")
+
+// Application is the parser output of a file such as package-lock.json.
+type Application struct {
+	FilePath     string
+	Ecosystem    types.Ecosystem
+	Libraries    []types.Library
+	Dependencies []types.Dependency
+}
+
+type options struct {
+	name      string
+	timestamp time.Time
+	uuid      string
+}
+
+type Option func(*options)
+
+// WithName sets the name of the document, such as a repository name.
+func WithName(name string) Option {
+	return func(opts *options) {
+		opts.name = name
+	}
+}
+
+// WithTimestamp overrides the creation time of the document.
+func WithTimestamp(t time.Time) Option {
+	return func(opts *options) {
+		opts.timestamp = t
+	}
+}
+
+// WithUUID overrides the UUID used for the CycloneDX serial number and the SPDX document namespace.
+func WithUUID(uuid string) Option {
+	return func(opts *options) {
+		opts.uuid = uuid
+	}
+}
+
+// Encode writes the SBOM of the applications in the format.
+func Encode(w io.Writer, format Format, apps []Application, opts ...Option) error {
+	o := options{
+		name:      "unknown",
+		timestamp: time.Now(),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.uuid == "" {
+		uuid, err := newUUID()
+		if err != nil {
+			return xerrors.Errorf("uuid error: %w", err)
+		}
+		o.uuid = uuid
+	}
+
+	g := newGraph(apps)
+
+	var err error
+	switch format {
+	case FormatCycloneDXJSON:
+		err = encodeCycloneDXJSON(w, g, o)
+	case FormatCycloneDXXML:
+		err = encodeCycloneDXXML(w, g, o)
+	case FormatSPDXJSON:
+		err = encodeSPDXJSON(w, g, o)
+	case FormatSPDXTagValue:
+		err = encodeSPDXTagValue(w, g, o)
+	default:
+		return xerrors.Errorf("%s: %w", format, ErrUnsupportedFormat)
+	}
+	if err != nil {
+		return xerrors.Errorf("%s encode error: %w", format, err)
+	}
+	return nil
+}
+
+// graph is the format-independent representation of the applications.
+// Libraries found in several files are merged into one component.
+type graph struct {
+	apps       []appNode
+	components []*componentNode
+}
+
+type appNode struct {
+	ref  string
+	refs []string // components in the application
+}
+
+type componentNode struct {
+	ref       string
+	purl      string
+	lib       types.Library
+	dependsOn []string
+
+	// hasGraph is true when the parser provided the dependency graph.
+	// Otherwise, an empty "dependsOn" doesn't mean that the component has no dependencies.
+	hasGraph bool
+}
+
+func newGraph(apps []Application) graph {
+	var g graph
+	components := map[string]*componentNode{}
+	for i, app := range apps {
+		node := appNode{ref: app.FilePath}
+		if node.ref == "" {
+			node.ref = fmt.Sprintf("application-%d", i)
+		}
+
+		// Library ID => component ref
+		refs := map[string]string{}
+		for _, lib := range app.Libraries {
+			ref, p := componentRef(app.Ecosystem, lib)
+			c, ok := components[ref]
+			if !ok {
+				c = &componentNode{
+					ref:  ref,
+					purl: p,
+					lib:  lib,
+				}
+				components[ref] = c
+				g.components = append(g.components, c)
+			}
+			if len(app.Dependencies) > 0 {
+				c.hasGraph = true
+			}
+			if lib.ID != "" {
+				refs[lib.ID] = ref
+			}
+			node.refs = append(node.refs, ref)
+		}
+		node.refs = utils.UniqueStrings(node.refs)
+
+		for _, dep := range app.Dependencies {
+			ref, ok := refs[dep.ID]
+			if !ok {
+				continue
+			}
+			c := components[ref]
+			for _, id := range dep.DependsOn {
+				if r, ok := refs[id]; ok {
+					c.dependsOn = append(c.dependsOn, r)
+				}
+			}
+		}
+		g.apps = append(g.apps, node)
+	}
+
+	for _, c := range g.components {
+		c.dependsOn = utils.UniqueStrings(c.dependsOn)
+		sort.Strings(c.dependsOn)
+	}
+	return g
+}
+
+// componentRef returns the purl as the reference if available.
+// The ecosystem of the library is used for applications with several ecosystems such as SBOMs.
+func componentRef(ecosystem types.Ecosystem, lib types.Library) (string, string) {
+	if ecosystem == "" {
+		ecosystem = lib.Ecosystem
+	}
+	p, err := purl.FromLibrary(ecosystem, lib)
+	if err != nil {
+		return fmt.Sprintf("%s:%s", ecosystem, utils.PackageID(lib.Name, lib.Version)), ""
+	}
+	return p, p
+}
+
+// e.g. "MIT", "Apache-2.0 OR MIT" and "GPL-2.0-only WITH Classpath-exception-2.0"
+var licenseExpressionRegexp = regexp.MustCompile(`^[A-Za-z0-9.\-+]+( (AND|OR|WITH) [A-Za-z0-9.\-+]+)*$`)
+
+// isLicenseExpression reports whether the license is an SPDX license expression of known identifiers.
+// Free-form names such as "BSD License" and "GPLv2" are not.
+func isLicenseExpression(license string) bool {
+	if !licenseExpressionRegexp.MatchString(license) {
+		return false
+	}
+	// The identifiers and the operators alternate.
+	tokens := strings.Split(license, " ")
+	for i := 0; i < len(tokens); i += 2 {
+		if i > 0 && tokens[i-1] == "WITH" {
+			if _, ok := spdxExceptionIDs[strings.ToLower(tokens[i])]; !ok {
+				return false
+			}
+		} else if _, ok := spdxLicenseID(tokens[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// spdxID returns a valid SPDX identifier derived from the reference.
+func spdxID(prefix, ref string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(ref))
+	return fmt.Sprintf("SPDXRef-%s-%016x", prefix, h.Sum64())
+}
+
+// licenseRef returns a LicenseRef for a license that isn't an SPDX license expression.
+func licenseRef(license string) string {
+	var b strings.Builder
+	for _, r := range license {
+		if 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9' || r == '.' || r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('-')
+		}
+	}
+	return "LicenseRef-" + b.String()
+}
+
+// newUUID returns a random (version 4) UUID.
+func newUUID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
@@ -0,0 +1,117 @@
+package sbom_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var apps = []sbom.Application{
+	{
+		FilePath:  "app/package-lock.json",
+		Ecosystem: types.Npm,
+		Libraries: []types.Library{
+			{ID: "@babel/core@7.14.6", Name: "@babel/core", Version: "7.14.6", License: "MIT"},
+			{ID: "debug@4.3.1", Name: "debug", Version: "4.3.1", License: "MIT"},
+			{ID: "ms@2.1.2", Name: "ms", Version: "2.1.2"},
+		},
+		Dependencies: []types.Dependency{
+			{ID: "@babel/core@7.14.6", DependsOn: []string{"debug@4.3.1"}},
+			{ID: "debug@4.3.1", DependsOn: []string{"ms@2.1.2"}},
+		},
+	},
+	{
+		FilePath:  "requirements.txt",
+		Ecosystem: types.PyPI,
+		Libraries: []types.Library{
+			{Name: "Flask_SQLAlchemy", Version: "2.5.1", License: "BSD License"},
+			{Name: "click", Version: "8.0.0", License: "BSD-3-Clause OR MIT"},
+		},
+	},
+	{
+		// SBOMs have libraries of several ecosystems.
+		FilePath: "bom.json",
+		Libraries: []types.Library{
+			{Name: "six", Version: "1.16.0", License: "BSD", Ecosystem: types.PyPI},
+			{Name: "github.com/example/gpl", Version: "1.0.0", License: "GPLv2", Ecosystem: types.Go},
+			{Name: "org.example:example-api", Version: "1.7.30", License: "Apache-2.0 WITH LLVM-exception", Ecosystem: types.Maven},
+		},
+	},
+	{
+		FilePath:  "wordpress/wp-includes/version.php",
+		Ecosystem: types.WordPress,
+		Libraries: []types.Library{
+			{Name: "wordpress", Version: "4.9.4"},
+		},
+	},
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     sbom.Format
+		goldenFile string
+		wantErr    string
+	}{
+		{
+			name:       "CycloneDX JSON",
+			format:     sbom.FormatCycloneDXJSON,
+			goldenFile: "happy.cdx.json",
+		},
+		{
+			name:       "CycloneDX XML",
+			format:     sbom.FormatCycloneDXXML,
+			goldenFile: "happy.cdx.xml",
+		},
+		{
+			name:       "SPDX JSON",
+			format:     sbom.FormatSPDXJSON,
+			goldenFile: "happy.spdx.json",
+		},
+		{
+			name:       "SPDX tag-value",
+			format:     sbom.FormatSPDXTagValue,
+			goldenFile: "happy.spdx",
+		},
+		{
+			name:    "unsupported format",
+			format:  "unknown",
+			wantErr: "unsupported format",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := sbom.Encode(&buf, tt.format, apps,
+				sbom.WithName("go-dep-parser"),
+				sbom.WithTimestamp(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)),
+				sbom.WithUUID("3ff14136-e09f-4df9-80ea-000000000001"),
+			)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			goldenFile := filepath.Join("testdata", tt.goldenFile)
+			if *update {
+				require.NoError(t, os.WriteFile(goldenFile, buf.Bytes(), 0644))
+			}
+			want, err := os.ReadFile(goldenFile)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), buf.String())
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/spdx"
+)
+
+const documentNamespace = "https://aquasecurity.github.io/go-dep-parser/spdx"
+
+func encodeSPDXJSON(w io.Writer, g graph, opts options) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(newSPDX(g, opts))
+}
+
+func encodeSPDXTagValue(w io.Writer, g graph, opts options) error {
+	return spdx.EncodeTagValue(w, newSPDX(g, opts))
+}
+
+func newSPDX(g graph, opts options) spdx.Document {
+	doc := spdx.Document{
+		SPDXVersion:       spdx.Version,
+		DataLicense:       spdx.DataLicense,
+		SPDXID:            spdx.DocumentID,
+		Name:              opts.name,
+		DocumentNamespace: fmt.Sprintf("%s/%s-%s", documentNamespace, opts.name, opts.uuid),
+		CreationInfo: spdx.CreationInfo{
+			Creators: []string{fmt.Sprintf("Tool: %s", toolName)},
+			Created:  opts.timestamp.UTC().Format(time.RFC3339),
+		},
+	}
+
+	// Component ref => SPDX ID
+	ids := map[string]string{}
+	extracted := map[string]struct{}{}
+	var containsRels, dependsOnRels []spdx.Relationship
+	for _, c := range g.components {
+		id := spdxID("Package", c.ref)
+		ids[c.ref] = id
+
+		pkg := spdx.Package{
+			SPDXID:                id,
+			Name:                  c.lib.Name,
+			VersionInfo:           c.lib.Version,
+			DownloadLocation:      spdx.NoAssertion,
+			LicenseConcluded:      spdx.NoAssertion,
+			LicenseDeclared:       spdx.NoAssertion,
+			PrimaryPackagePurpose: spdx.PurposeLibrary,
+		}
+		if license := c.lib.License; license != "" {
+			if isLicenseExpression(license) {
+				pkg.LicenseDeclared = license
+			} else {
+				pkg.LicenseDeclared = licenseRef(license)
+				if _, ok := extracted[pkg.LicenseDeclared]; !ok {
+					extracted[pkg.LicenseDeclared] = struct{}{}
+					doc.ExtractedLicensingInfos = append(doc.ExtractedLicensingInfos, spdx.ExtractedLicensingInfo{
+						LicenseID:     pkg.LicenseDeclared,
+						ExtractedText: license,
+					})
+				}
+			}
+		}
+		if c.purl != "" {
+			pkg.ExternalRefs = []spdx.ExternalRef{
+				{
+					ReferenceCategory: spdx.CategoryPackageManager,
+					ReferenceType:     spdx.ExternalRefTypePURL,
+					ReferenceLocator:  c.purl,
+				},
+			}
+		}
+		doc.Packages = append(doc.Packages, pkg)
+	}
+
+	for _, c := range g.components {
+		for _, ref := range c.dependsOn {
+			dependsOnRels = append(dependsOnRels, spdx.Relationship{
+				SPDXElementID:      ids[c.ref],
+				RelationshipType:   spdx.RelationshipDependsOn,
+				RelatedSPDXElement: ids[ref],
+			})
+		}
+	}
+
+	var appPkgs []spdx.Package
+	var describesRels []spdx.Relationship
+	for _, app := range g.apps {
+		id := spdxID("Application", app.ref)
+		appPkgs = append(appPkgs, spdx.Package{
+			SPDXID:                id,
+			Name:                  app.ref,
+			DownloadLocation:      spdx.NoAssertion,
+			PrimaryPackagePurpose: spdx.PurposeApplication,
+		})
+		describesRels = append(describesRels, spdx.Relationship{
+			SPDXElementID:      spdx.DocumentID,
+			RelationshipType:   spdx.RelationshipDescribes,
+			RelatedSPDXElement: id,
+		})
+		for _, ref := range app.refs {
+			containsRels = append(containsRels, spdx.Relationship{
+				SPDXElementID:      id,
+				RelationshipType:   spdx.RelationshipContains,
+				RelatedSPDXElement: ids[ref],
+			})
+		}
+	}
+
+	doc.Packages = append(appPkgs, doc.Packages...)
+	doc.Relationships = append(describesRels, containsRels...)
+	doc.Relationships = append(doc.Relationships, dependsOnRels...)
+	return doc
+}
@@ -0,0 +1,113 @@
+package spdx
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/internal/graph"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// Parse parses an SPDX JSON document.
+func Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	var doc Document
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, nil, xerrors.Errorf("decode error: %w", err)
+	}
+	return parseDocument(doc)
+}
+
+// ParseTagValue parses an SPDX tag-value document.
+func ParseTagValue(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	doc, err := DecodeTagValue(r)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("decode error: %w", err)
+	}
+	return parseDocument(doc)
+}
+
+// parseDocument returns the libraries in the same way as lock files.
+// Packages which the document describes and applications are not included.
+func parseDocument(doc Document) ([]types.Library, []types.Dependency, error) {
+	if doc.SPDXVersion != "" && !strings.HasPrefix(doc.SPDXVersion, "SPDX-") {
+		return nil, nil, xerrors.Errorf("invalid spdxVersion: %s", doc.SPDXVersion)
+	}
+
+	described := map[string]struct{}{}
+	for _, id := range doc.DocumentDescribes {
+		described[id] = struct{}{}
+	}
+	for _, rel := range doc.Relationships {
+		if rel.SPDXElementID == doc.SPDXID && rel.RelationshipType == RelationshipDescribes {
+			described[rel.RelatedSPDXElement] = struct{}{}
+		}
+	}
+
+	// LicenseRef-xxx => the original text
+	extracted := map[string]string{}
+	for _, info := range doc.ExtractedLicensingInfos {
+		extracted[info.LicenseID] = info.ExtractedText
+	}
+
+	g := graph.New()
+	for _, pkg := range doc.Packages {
+		if _, ok := described[pkg.SPDXID]; ok || pkg.PrimaryPackagePurpose == PurposeApplication {
+			continue
+		}
+		if lib := toLibrary(pkg, extracted); lib.Name != "" {
+			g.AddLibrary(pkg.SPDXID, lib)
+		}
+	}
+	for _, rel := range doc.Relationships {
+		switch rel.RelationshipType {
+		case RelationshipDependsOn:
+			g.AddDependency(rel.SPDXElementID, rel.RelatedSPDXElement)
+		case RelationshipDependencyOf:
+			g.AddDependency(rel.RelatedSPDXElement, rel.SPDXElementID)
+		}
+	}
+
+	libs, deps := g.Result()
+	return libs, deps, nil
+}
+
+// toLibrary prefers the purl so that the name is the same as parsers return.
+// e.g. pkg:maven/org.example/example-api@1.0.0 => org.example:example-api
+// The ecosystem is known only from the purl.
+func toLibrary(pkg Package, extracted map[string]string) types.Library {
+	lib := types.Library{
+		Name:    pkg.Name,
+		Version: pkg.VersionInfo,
+	}
+	for _, ref := range pkg.ExternalRefs {
+		if ref.ReferenceType != ExternalRefTypePURL {
+			continue
+		}
+		if p, err := purl.Parse(ref.ReferenceLocator); err == nil {
+			lib = p.Library()
+			lib.Ecosystem, _ = p.Ecosystem()
+			break
+		}
+	}
+	lib.License = license(pkg, extracted)
+	return lib
+}
+
+// license prefers the declared license to the concluded one.
+func license(pkg Package, extracted map[string]string) string {
+	for _, l := range []string{pkg.LicenseDeclared, pkg.LicenseConcluded} {
+		switch l {
+		case "", NoAssertion, None:
+			continue
+		}
+		if text, ok := extracted[l]; ok {
+			return text
+		}
+		return l
+	}
+	return ""
+}
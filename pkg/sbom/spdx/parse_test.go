@@ -0,0 +1,75 @@
+package spdx
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		parse    func(io.Reader) ([]types.Library, []types.Dependency, error)
+		want     []types.Library
+		wantDeps []types.Dependency
+		wantErr  string
+	}{
+		{
+			name:     "JSON",
+			file:     "testdata/happy.json",
+			parse:    Parse,
+			want:     spdxHappy,
+			wantDeps: spdxHappyDeps,
+		},
+		{
+			name:     "tag-value",
+			file:     "testdata/happy.spdx",
+			parse:    ParseTagValue,
+			want:     spdxHappy,
+			wantDeps: spdxHappyDeps,
+		},
+		{
+			name:     "DEPENDENCY_OF and multi-line text",
+			file:     "testdata/dependency-of.spdx",
+			parse:    ParseTagValue,
+			want:     spdxDependencyOf,
+			wantDeps: spdxDependencyOfDeps,
+		},
+		{
+			name:     "same name in several ecosystems",
+			file:     "testdata/ecosystems.json",
+			parse:    Parse,
+			want:     spdxEcosystems,
+			wantDeps: spdxEcosystemsDeps,
+		},
+		{
+			name:    "invalid relationship",
+			file:    "testdata/invalid.spdx",
+			parse:   ParseTagValue,
+			wantErr: "invalid relationship",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, gotDeps, err := tt.parse(f)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDeps, gotDeps)
+		})
+	}
+}
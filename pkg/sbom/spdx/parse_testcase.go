@@ -0,0 +1,38 @@
+package spdx
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// Generated by sbom.Encode
+	spdxHappy = []types.Library{
+		{ID: "pkg:npm/%40babel/core@7.14.6", Name: "@babel/core", Version: "7.14.6", License: "MIT", Ecosystem: types.Npm},
+		{ID: "pkg:npm/debug@4.3.1", Name: "debug", Version: "4.3.1", License: "MIT", Ecosystem: types.Npm},
+		{ID: "pkg:npm/ms@2.1.2", Name: "ms", Version: "2.1.2", Ecosystem: types.Npm},
+		{ID: "pkg:pypi/click@8.0.0", Name: "click", Version: "8.0.0", License: "BSD-3-Clause OR MIT", Ecosystem: types.PyPI},
+		{ID: "pkg:pypi/flask-sqlalchemy@2.5.1", Name: "flask-sqlalchemy", Version: "2.5.1", License: "BSD License", Ecosystem: types.PyPI},
+		{ID: "wordpress@4.9.4", Name: "wordpress", Version: "4.9.4"},
+	}
+	spdxHappyDeps = []types.Dependency{
+		{ID: "pkg:npm/%40babel/core@7.14.6", DependsOn: []string{"pkg:npm/debug@4.3.1"}},
+		{ID: "pkg:npm/debug@4.3.1", DependsOn: []string{"pkg:npm/ms@2.1.2"}},
+	}
+
+	spdxDependencyOf = []types.Library{
+		{ID: "pkg:gem/actionpack@6.1.4", Name: "actionpack", Version: "6.1.4", License: "Custom License\nCopyright (c) Example", Ecosystem: types.RubyGems},
+		{ID: "pkg:gem/rails@6.1.4", Name: "rails", Version: "6.1.4", License: "MIT", Ecosystem: types.RubyGems},
+	}
+	spdxDependencyOfDeps = []types.Dependency{
+		{ID: "pkg:gem/rails@6.1.4", DependsOn: []string{"pkg:gem/actionpack@6.1.4"}},
+	}
+
+	spdxEcosystems = []types.Library{
+		{ID: "pkg:npm/debug@1.0.0", Name: "debug", Version: "1.0.0", Ecosystem: types.Npm},
+		{ID: "pkg:npm/ms@2.0.0", Name: "ms", Version: "2.0.0", Ecosystem: types.Npm},
+		{ID: "pkg:pypi/debug@1.0.0", Name: "debug", Version: "1.0.0", Ecosystem: types.PyPI},
+		{ID: "pkg:pypi/six@1.0.0", Name: "six", Version: "1.0.0", Ecosystem: types.PyPI},
+	}
+	spdxEcosystemsDeps = []types.Dependency{
+		{ID: "pkg:npm/debug@1.0.0", DependsOn: []string{"pkg:npm/ms@2.0.0"}},
+		{ID: "pkg:pypi/debug@1.0.0", DependsOn: []string{"pkg:pypi/six@1.0.0"}},
+	}
+)
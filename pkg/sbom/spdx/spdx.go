@@ -0,0 +1,283 @@
+package spdx
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// SPDX 2.3
+// ref. https://spdx.github.io/spdx-spec/v2.3/
+const (
+	Version     = "SPDX-2.3"
+	DataLicense = "CC0-1.0"
+	DocumentID  = "SPDXRef-DOCUMENT"
+
+	NoAssertion = "NOASSERTION"
+	None        = "NONE"
+
+	RelationshipDescribes    = "DESCRIBES"
+	RelationshipContains     = "CONTAINS"
+	RelationshipDependsOn    = "DEPENDS_ON"
+	RelationshipDependencyOf = "DEPENDENCY_OF"
+
+	PurposeApplication = "APPLICATION"
+	PurposeLibrary     = "LIBRARY"
+
+	CategoryPackageManager = "PACKAGE-MANAGER"
+	ExternalRefTypePURL    = "purl"
+)
+
+type Document struct {
+	SPDXVersion             string                   `json:"spdxVersion"`
+	DataLicense             string                   `json:"dataLicense"`
+	SPDXID                  string                   `json:"SPDXID"`
+	Name                    string                   `json:"name"`
+	DocumentNamespace       string                   `json:"documentNamespace"`
+	CreationInfo            CreationInfo             `json:"creationInfo"`
+	DocumentDescribes       []string                 `json:"documentDescribes,omitempty"`
+	Packages                []Package                `json:"packages,omitempty"`
+	Relationships           []Relationship           `json:"relationships,omitempty"`
+	ExtractedLicensingInfos []ExtractedLicensingInfo `json:"hasExtractedLicensingInfos,omitempty"`
+}
+
+type CreationInfo struct {
+	Creators []string `json:"creators"`
+	Created  string   `json:"created"`
+}
+
+type Package struct {
+	SPDXID                string        `json:"SPDXID"`
+	Name                  string        `json:"name"`
+	VersionInfo           string        `json:"versionInfo,omitempty"`
+	DownloadLocation      string        `json:"downloadLocation"`
+	FilesAnalyzed         bool          `json:"filesAnalyzed"`
+	LicenseConcluded      string        `json:"licenseConcluded,omitempty"`
+	LicenseDeclared       string        `json:"licenseDeclared,omitempty"`
+	PrimaryPackagePurpose string        `json:"primaryPackagePurpose,omitempty"`
+	ExternalRefs          []ExternalRef `json:"externalRefs,omitempty"`
+}
+
+type ExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type Relationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+// ExtractedLicensingInfo holds licenses which are not on the SPDX license list.
+type ExtractedLicensingInfo struct {
+	LicenseID     string `json:"licenseId"`
+	ExtractedText string `json:"extractedText"`
+}
+
+// EncodeTagValue writes the document in the tag-value format.
+func EncodeTagValue(w io.Writer, doc Document) error {
+	bw := bufio.NewWriter(w)
+	tv := tagValueWriter{w: bw}
+
+	tv.write("SPDXVersion", doc.SPDXVersion)
+	tv.write("DataLicense", doc.DataLicense)
+	tv.write("SPDXID", doc.SPDXID)
+	tv.write("DocumentName", doc.Name)
+	tv.write("DocumentNamespace", doc.DocumentNamespace)
+	for _, creator := range doc.CreationInfo.Creators {
+		tv.write("Creator", creator)
+	}
+	tv.write("Created", doc.CreationInfo.Created)
+
+	for _, pkg := range doc.Packages {
+		tv.newline()
+		tv.comment("Package: " + pkg.Name)
+		tv.newline()
+		tv.write("PackageName", pkg.Name)
+		tv.write("SPDXID", pkg.SPDXID)
+		tv.write("PackageVersion", pkg.VersionInfo)
+		tv.write("PackageDownloadLocation", pkg.DownloadLocation)
+		tv.write("FilesAnalyzed", fmt.Sprint(pkg.FilesAnalyzed))
+		tv.write("PackageLicenseConcluded", pkg.LicenseConcluded)
+		tv.write("PackageLicenseDeclared", pkg.LicenseDeclared)
+		tv.write("PrimaryPackagePurpose", pkg.PrimaryPackagePurpose)
+		for _, ref := range pkg.ExternalRefs {
+			tv.write("ExternalRef", strings.Join([]string{ref.ReferenceCategory, ref.ReferenceType, ref.ReferenceLocator}, " "))
+		}
+	}
+
+	if len(doc.ExtractedLicensingInfos) > 0 {
+		tv.newline()
+		tv.comment("Other Licenses")
+		for _, info := range doc.ExtractedLicensingInfos {
+			tv.newline()
+			tv.write("LicenseID", info.LicenseID)
+			tv.write("ExtractedText", "<text>"+info.ExtractedText+"</text>")
+		}
+	}
+
+	if len(doc.Relationships) > 0 {
+		tv.newline()
+		tv.comment("Relationships")
+		tv.newline()
+		for _, rel := range doc.Relationships {
+			tv.write("Relationship", strings.Join([]string{rel.SPDXElementID, rel.RelationshipType, rel.RelatedSPDXElement}, " "))
+		}
+	}
+
+	if tv.err != nil {
+		return tv.err
+	}
+	return bw.Flush()
+}
+
+// DecodeTagValue reads a document in the tag-value format.
+// Only the fields of Document are read, and files and snippets are ignored.
+func DecodeTagValue(r io.Reader) (Document, error) {
+	var doc Document
+	var pkg *Package
+	var info *ExtractedLicensingInfo
+
+	// The section which the following tags belong to.
+	// e.g. "SPDXID" appears in both the document and packages.
+	section := "document"
+
+	scanner := bufio.NewScanner(r)
+	var lineNumber int
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		tag, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return Document{}, xerrors.Errorf("invalid line %d: %s", lineNumber, line)
+		}
+		value = strings.TrimSpace(value)
+
+		// Multi-line text
+		// e.g. <text>The MIT License
+		//      ...</text>
+		if strings.HasPrefix(value, "<text>") {
+			text := strings.TrimPrefix(value, "<text>")
+			for !strings.Contains(text, "</text>") && scanner.Scan() {
+				lineNumber++
+				text += "\n" + scanner.Text()
+			}
+			value, _, _ = strings.Cut(text, "</text>")
+		}
+
+		switch tag {
+		case "PackageName":
+			doc.Packages = append(doc.Packages, Package{Name: value})
+			pkg = &doc.Packages[len(doc.Packages)-1]
+			section = "package"
+		case "LicenseID":
+			doc.ExtractedLicensingInfos = append(doc.ExtractedLicensingInfos, ExtractedLicensingInfo{LicenseID: value})
+			info = &doc.ExtractedLicensingInfos[len(doc.ExtractedLicensingInfos)-1]
+			section = "license"
+		case "FileName", "SnippetSPDXID":
+			section = "other"
+		case "SPDXVersion":
+			doc.SPDXVersion = value
+		case "DataLicense":
+			doc.DataLicense = value
+		case "DocumentName":
+			doc.Name = value
+		case "DocumentNamespace":
+			doc.DocumentNamespace = value
+		case "Creator":
+			doc.CreationInfo.Creators = append(doc.CreationInfo.Creators, value)
+		case "Created":
+			doc.CreationInfo.Created = value
+		case "Relationship":
+			fields := strings.Fields(value)
+			if len(fields) != 3 {
+				return Document{}, xerrors.Errorf("invalid relationship at line %d: %s", lineNumber, value)
+			}
+			doc.Relationships = append(doc.Relationships, Relationship{
+				SPDXElementID:      fields[0],
+				RelationshipType:   fields[1],
+				RelatedSPDXElement: fields[2],
+			})
+		case "SPDXID":
+			switch section {
+			case "document":
+				doc.SPDXID = value
+			case "package":
+				pkg.SPDXID = value
+			}
+		case "ExtractedText":
+			if section == "license" {
+				info.ExtractedText = value
+			}
+		}
+
+		if section != "package" {
+			continue
+		}
+		switch tag {
+		case "PackageVersion":
+			pkg.VersionInfo = value
+		case "PackageDownloadLocation":
+			pkg.DownloadLocation = value
+		case "FilesAnalyzed":
+			pkg.FilesAnalyzed = value == "true"
+		case "PackageLicenseConcluded":
+			pkg.LicenseConcluded = value
+		case "PackageLicenseDeclared":
+			pkg.LicenseDeclared = value
+		case "PrimaryPackagePurpose":
+			pkg.PrimaryPackagePurpose = value
+		case "ExternalRef":
+			fields := strings.Fields(value)
+			if len(fields) < 3 {
+				return Document{}, xerrors.Errorf("invalid external reference at line %d: %s", lineNumber, value)
+			}
+			pkg.ExternalRefs = append(pkg.ExternalRefs, ExternalRef{
+				ReferenceCategory: fields[0],
+				ReferenceType:     fields[1],
+				ReferenceLocator:  fields[2],
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Document{}, xerrors.Errorf("scan error: %w", err)
+	}
+	return doc, nil
+}
+
+// tagValueWriter keeps the first error so that callers can write lines without checking errors.
+type tagValueWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (tv *tagValueWriter) write(tag, value string) {
+	if value == "" {
+		return
+	}
+	tv.printf("%s: %s\n", tag, value)
+}
+
+func (tv *tagValueWriter) comment(s string) {
+	tv.printf("##### %s\n", s)
+}
+
+func (tv *tagValueWriter) newline() {
+	tv.printf("\n")
+}
+
+func (tv *tagValueWriter) printf(format string, a ...interface{}) {
+	if tv.err != nil {
+		return
+	}
+	_, tv.err = fmt.Fprintf(tv.w, format, a...)
+}
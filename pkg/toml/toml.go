@@ -0,0 +1,52 @@
+package toml
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// ArrayTableLocations returns the line ranges of each element in the array of tables.
+// The TOML decoder doesn't expose positions, so the headers are scanned instead.
+// The order is the same as the decoded array.
+// e.g. ArrayTableLocations(data, "package") for [[package]] in Cargo.lock
+func ArrayTableLocations(data []byte, name string) []types.Location {
+	var locs []types.Location
+	var current *types.Location
+
+	closeTable := func() {
+		if current != nil {
+			locs = append(locs, *current)
+			current = nil
+		}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	var lineNumber int
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case line == "[["+name+"]]":
+			closeTable()
+			current = &types.Location{StartLine: lineNumber}
+		case strings.HasPrefix(line, "["+name+".") || strings.HasPrefix(line, "[["+name+"."):
+			// Sub-tables belong to the current element.
+			// e.g. [package.dependencies]
+		case strings.HasPrefix(line, "["):
+			closeTable()
+			continue
+		}
+
+		if current != nil {
+			current.EndLine = lineNumber
+		}
+	}
+	closeTable()
+
+	return locs
+}
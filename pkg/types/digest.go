@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// Digest is a hash of a package in the form of "<algorithm>:<hex>".
+// Hashes in other encodings, such as base64 in npm and NuGet, are converted to hex
+// so that the same hashes are comparable across ecosystems.
+// e.g. "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+type Digest string
+
+type DigestAlgorithm string
+
+const (
+	SHA1   DigestAlgorithm = "sha1"
+	SHA256 DigestAlgorithm = "sha256"
+	SHA384 DigestAlgorithm = "sha384"
+	SHA512 DigestAlgorithm = "sha512"
+
+	// H1 is the hash of Go modules in go.sum. It is SHA-256 of the file list with the SHA-256 of each file.
+	// ref. https://pkg.go.dev/golang.org/x/mod/sumdb/dirhash#Hash1
+	H1 DigestAlgorithm = "h1"
+
+	// YarnChecksum is the checksum in yarn.lock v2+. It is SHA-512 of the zip archive in the Yarn cache,
+	// not of the package tarball, so it is not comparable with SHA-512 in other ecosystems.
+	YarnChecksum DigestAlgorithm = "yarn-checksum"
+)
+
+var digestSizes = map[DigestAlgorithm]int{
+	SHA1:   20,
+	SHA256: 32,
+	SHA384: 48,
+	SHA512: 64,
+	H1:     32,
+
+	YarnChecksum: 64,
+}
+
+// NewDigest returns the digest of the raw hash.
+func NewDigest(algorithm DigestAlgorithm, sum []byte) (Digest, error) {
+	size, ok := digestSizes[algorithm]
+	if !ok {
+		return "", xerrors.Errorf("unsupported algorithm: %s", algorithm)
+	} else if len(sum) != size {
+		return "", xerrors.Errorf("invalid %s size: %d", algorithm, len(sum))
+	}
+	return Digest(string(algorithm) + ":" + hex.EncodeToString(sum)), nil
+}
+
+// NewDigestFromHex returns the digest of the hex-encoded hash.
+func NewDigestFromHex(algorithm DigestAlgorithm, s string) (Digest, error) {
+	sum, err := hex.DecodeString(s)
+	if err != nil {
+		return "", xerrors.Errorf("hex decode error: %w", err)
+	}
+	return NewDigest(algorithm, sum)
+}
+
+// NewDigestFromBase64 returns the digest of the base64-encoded hash.
+func NewDigestFromBase64(algorithm DigestAlgorithm, s string) (Digest, error) {
+	sum, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", xerrors.Errorf("base64 decode error: %w", err)
+	}
+	return NewDigest(algorithm, sum)
+}
+
+// ParseIntegrity parses Subresource Integrity used in npm, yarn and pnpm.
+// It may have several hashes separated by spaces.
+// e.g. "sha512-OfC2uemaknXr87bdLUkWog7nYuliM9Ij5HUcajsVcMCpQrcLmtxRbVFTIqmcSkSeYRBFBRxs2FiUqFJDLdiebA=="
+// ref. https://www.w3.org/TR/SRI/#the-integrity-attribute
+func ParseIntegrity(integrity string) ([]Digest, error) {
+	var digests []Digest
+	for _, field := range strings.Fields(integrity) {
+		algorithm, value, ok := strings.Cut(field, "-")
+		if !ok {
+			return nil, xerrors.Errorf("invalid integrity: %s", field)
+		}
+		// Options are not used. e.g. sha512-xxx?foo
+		value, _, _ = strings.Cut(value, "?")
+		d, err := NewDigestFromBase64(DigestAlgorithm(algorithm), value)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid integrity %s: %w", field, err)
+		}
+		digests = append(digests, d)
+	}
+	return digests, nil
+}
+
+// Algorithm returns the algorithm of the digest. e.g. "sha256"
+func (d Digest) Algorithm() DigestAlgorithm {
+	algorithm, _, _ := strings.Cut(string(d), ":")
+	return DigestAlgorithm(algorithm)
+}
+
+// Encoded returns the hex-encoded hash.
+func (d Digest) Encoded() string {
+	_, encoded, _ := strings.Cut(string(d), ":")
+	return encoded
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParseIntegrity(t *testing.T) {
+	tests := []struct {
+		name      string
+		integrity string
+		want      []types.Digest
+		wantErr   string
+	}{
+		{
+			name:      "sha1",
+			integrity: "sha1-5QNHYR1+aQlDIIu9r+vLwvuGbUY=",
+			want:      []types.Digest{"sha1:e50347611d7e690943208bbdafebcbc2fb866d46"},
+		},
+		{
+			name:      "multiple hashes with options",
+			integrity: "sha1-5QNHYR1+aQlDIIu9r+vLwvuGbUY= sha256-LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=?foo",
+			want: []types.Digest{
+				"sha1:e50347611d7e690943208bbdafebcbc2fb866d46",
+				"sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+			},
+		},
+		{
+			name:      "empty",
+			integrity: "",
+		},
+		{
+			name:      "unsupported algorithm",
+			integrity: "md5-XUFAKrxLKna5cZ2REBfFkg==",
+			wantErr:   "unsupported algorithm: md5",
+		},
+		{
+			name:      "invalid size",
+			integrity: "sha512-5QNHYR1+aQlDIIu9r+vLwvuGbUY=",
+			wantErr:   "invalid sha512 size: 20",
+		},
+		{
+			name:      "no algorithm",
+			integrity: "5QNHYR1+aQlDIIu9r+vLwvuGbUY=",
+			wantErr:   "invalid integrity",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := types.ParseIntegrity(tt.integrity)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDigest(t *testing.T) {
+	d, err := types.NewDigestFromHex(types.SHA256, "2CF24DBA5FB0A30E26E83B2AC5B9E29E1B161E5C1FA7425E73043362938B9824")
+	require.NoError(t, err)
+	assert.Equal(t, types.Digest("sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"), d)
+	assert.Equal(t, types.SHA256, d.Algorithm())
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", d.Encoded())
+}
@@ -1,8 +1,63 @@
 package types
 
+import dio "github.com/aquasecurity/go-dep-parser/pkg/io"
+
 type Library struct {
-	Name     string
-	Version  string
-	Indirect bool   `json:",omitempty"`
-	License  string `json:",omitempty"`
+	ID        string `json:",omitempty"`
+	Name      string
+	Version   string
+	Indirect  bool      `json:",omitempty"`
+	License   string    `json:",omitempty"`
+	Locations Locations `json:",omitempty"`
+
+	// Scope is the effective scope of the library in ecosystems with dependency scopes.
+	// e.g. "compile", "runtime" and "test" in Maven
+	Scope string `json:",omitempty"`
+
+	// Resolved is where the package is fetched from.
+	// e.g. a tarball URL, a git repository or a registry such as "registry+https://github.com/rust-lang/crates.io-index"
+	Resolved string `json:",omitempty"`
+	// Digests are the hashes of the package recorded in the file.
+	Digests []Digest `json:",omitempty"`
+
+	// Ecosystem is set only by parsers of files with libraries of several ecosystems. e.g. SBOMs
+	Ecosystem Ecosystem `json:",omitempty"`
+}
+
+// Locations holds the places where a library is declared.
+// A library may appear more than once in the same file.
+// e.g. nested node_modules in package-lock.json
+type Locations []Location
+
+// Location represents a range of lines in the parsed file. Line numbers start at 1.
+type Location struct {
+	StartLine int `json:",omitempty"`
+	EndLine   int `json:",omitempty"`
 }
+
+// Dependency represents edges from a library to the libraries it depends on.
+// Both ID and DependsOn refer to Library.ID.
+type Dependency struct {
+	ID        string
+	DependsOn []string
+}
+
+// Parser is the common interface of dependency file parsers.
+type Parser interface {
+	Parse(r dio.ReadSeekerAt) ([]Library, []Dependency, error)
+}
+
+// Ecosystem represents the package ecosystem which a library belongs to.
+type Ecosystem string
+
+const (
+	Npm       Ecosystem = "npm"
+	PyPI      Ecosystem = "pypi"
+	RubyGems  Ecosystem = "rubygems"
+	Cargo     Ecosystem = "cargo"
+	Go        Ecosystem = "go"
+	Maven     Ecosystem = "maven"
+	NuGet     Ecosystem = "nuget"
+	Composer  Ecosystem = "composer"
+	WordPress Ecosystem = "wordpress"
+)
@@ -1,5 +1,7 @@
 package utils
 
+import "fmt"
+
 func UniqueStrings(ss []string) []string {
 	var results []string
 	uniq := map[string]struct{}{}
@@ -22,3 +24,9 @@ func MergeMaps(parent, child map[string]string) map[string]string {
 	}
 	return parent
 }
+
+// PackageID returns the identifier used to link libraries and dependencies.
+// e.g. lodash@4.17.21
+func PackageID(name, version string) string {
+	return fmt.Sprintf("%s@%s", name, version)
+}
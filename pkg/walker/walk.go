@@ -0,0 +1,314 @@
+package walker
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/golang/binary"
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/registry"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+const defaultMaxFileSize = 100 << 20 // 100MB
+
+var defaultSkipDirs = []string{".git", "node_modules", "vendor"}
+
+// Result is the outcome of parsing a file found during the walk.
+type Result struct {
+	// FilePath is the slash-separated path relative to the root.
+	FilePath     string
+	Type         registry.FileType
+	Ecosystem    types.Ecosystem
+	Libraries    []types.Library
+	Dependencies []types.Dependency
+
+	// Err is set when the file was detected but couldn't be parsed.
+	// A broken file shouldn't stop the walk.
+	Err error
+}
+
+type options struct {
+	skipDirs       []string
+	maxFileSize    int64
+	followSymlinks bool
+	goBinaries     bool
+	offline        bool
+}
+
+type Option func(*options)
+
+// WithSkipDirs overrides the directories to be skipped.
+// Each pattern is matched against the directory name with path.Match.
+func WithSkipDirs(dirs []string) Option {
+	return func(opts *options) {
+		opts.skipDirs = dirs
+	}
+}
+
+// WithMaxFileSize skips files larger than the size. Zero means no limit.
+func WithMaxFileSize(size int64) Option {
+	return func(opts *options) {
+		opts.maxFileSize = size
+	}
+}
+
+// WithFollowSymlinks follows symbolic links to files and directories.
+func WithFollowSymlinks(follow bool) Option {
+	return func(opts *options) {
+		opts.followSymlinks = follow
+	}
+}
+
+// WithGoBinaries tries to parse executable files as Go binaries.
+func WithGoBinaries(enabled bool) Option {
+	return func(opts *options) {
+		opts.goBinaries = enabled
+	}
+}
+
+// WithOffline disables network access of parsers. e.g. Maven Central
+func WithOffline(offline bool) Option {
+	return func(opts *options) {
+		opts.offline = offline
+	}
+}
+
+type Walker struct {
+	opts options
+}
+
+func NewWalker(opts ...Option) Walker {
+	o := options{
+		skipDirs:    defaultSkipDirs,
+		maxFileSize: defaultMaxFileSize,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return Walker{opts: o}
+}
+
+// Walk walks the directory on the local file system.
+func (w Walker) Walk(root string) ([]Result, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, xerrors.Errorf("stat error: %w", err)
+	} else if !info.IsDir() {
+		return nil, xerrors.Errorf("%s is not a directory", root)
+	}
+	return w.walkFS(os.DirFS(root), root)
+}
+
+// WalkFS finds and parses all supported files in the file system.
+func (w Walker) WalkFS(fsys fs.FS) ([]Result, error) {
+	return w.walkFS(fsys, "")
+}
+
+// walkFS walks the file system. Parsers which open related files by themselves,
+// such as the POM parser for parent POMs, are given paths under "base" if it is not empty.
+// Otherwise, they read the related files from fsys.
+func (w Walker) walkFS(fsys fs.FS, base string) ([]Result, error) {
+	rootInfo, err := fs.Stat(fsys, ".")
+	if err != nil {
+		return nil, xerrors.Errorf("stat error: %w", err)
+	}
+
+	s := &walkState{
+		fsys: fsys,
+		base: base,
+	}
+	if err = w.walk(s, ".", []fs.FileInfo{rootInfo}); err != nil {
+		return nil, xerrors.Errorf("walk error: %w", err)
+	}
+	return s.results, nil
+}
+
+type walkState struct {
+	fsys    fs.FS
+	base    string
+	results []Result
+}
+
+// walk traverses the tree under root. "ancestors" holds the directories followed via symlinks
+// so that cyclic links are not followed endlessly.
+func (w Walker) walk(s *walkState, root string, ancestors []fs.FileInfo) error {
+	return fs.WalkDir(s.fsys, root, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if filePath == root {
+				return err
+			}
+			log.Logger.Debugf("Walk error (%s): %s", filePath, err)
+			return nil
+		}
+
+		if d.IsDir() {
+			if filePath != root && w.skipDir(d.Name()) {
+				w.walkSkippedDir(s, filePath)
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			return w.walkSymlink(s, filePath, ancestors)
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			log.Logger.Debugf("File info error (%s): %s", filePath, err)
+			return nil
+		}
+		if r, ok := w.parse(s, filePath, info); ok {
+			s.results = append(s.results, r)
+		}
+		return nil
+	})
+}
+
+func (w Walker) walkSymlink(s *walkState, filePath string, ancestors []fs.FileInfo) error {
+	if !w.opts.followSymlinks {
+		return nil
+	}
+
+	// fs.Stat follows the link
+	info, err := fs.Stat(s.fsys, filePath)
+	if err != nil {
+		log.Logger.Debugf("Broken symlink (%s): %s", filePath, err)
+		return nil
+	}
+
+	switch {
+	case info.IsDir():
+		if w.skipDir(path.Base(filePath)) {
+			return nil
+		}
+		for _, a := range ancestors {
+			if os.SameFile(a, info) {
+				log.Logger.Debugf("Cyclic symlink (%s)", filePath)
+				return nil
+			}
+		}
+		return w.walk(s, filePath, append(ancestors, info))
+	case info.Mode().IsRegular():
+		if r, ok := w.parse(s, filePath, info); ok {
+			s.results = append(s.results, r)
+		}
+	}
+	return nil
+}
+
+// walkSkippedDir parses files directly under the skipped directory only if they are detected
+// with the directory name. e.g. vendor/modules.txt
+func (w Walker) walkSkippedDir(s *walkState, dirPath string) {
+	entries, err := fs.ReadDir(s.fsys, dirPath)
+	if err != nil {
+		log.Logger.Debugf("Read dir error (%s): %s", dirPath, err)
+		return
+	}
+	for _, e := range entries {
+		if !e.Type().IsRegular() {
+			continue
+		}
+		filePath := path.Join(dirPath, e.Name())
+		if entry, ok := registry.Lookup(filePath); !ok || !hasDirPattern(entry, path.Base(dirPath)) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			log.Logger.Debugf("File info error (%s): %s", filePath, err)
+			continue
+		}
+		if r, ok := w.parse(s, filePath, info); ok {
+			s.results = append(s.results, r)
+		}
+	}
+}
+
+// hasDirPattern returns true if the entry has a pattern starting with the directory.
+func hasDirPattern(entry registry.Entry, dir string) bool {
+	for _, pattern := range entry.Patterns {
+		if strings.HasPrefix(pattern, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (w Walker) skipDir(name string) bool {
+	for _, pattern := range w.opts.skipDirs {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (w Walker) parse(s *walkState, filePath string, info fs.FileInfo) (Result, bool) {
+	entry, ok := registry.Lookup(filePath)
+	if !ok {
+		// Go binaries can't be detected by the file name
+		if !w.opts.goBinaries || info.Mode().Perm()&0111 == 0 {
+			return Result{}, false
+		}
+		entry, _ = registry.Get(registry.GoBinary)
+	}
+
+	if w.opts.maxFileSize > 0 && info.Size() > w.opts.maxFileSize {
+		log.Logger.Debugf("Skipping a large file (%s): %d bytes", filePath, info.Size())
+		return Result{}, false
+	}
+
+	result := Result{
+		FilePath:  filePath,
+		Type:      entry.Type,
+		Ecosystem: entry.Ecosystem,
+	}
+
+	content, err := readFile(s.fsys, filePath)
+	if err != nil {
+		result.Err = xerrors.Errorf("read error: %w", err)
+		return result, true
+	}
+
+	parserPath := filePath
+	opts := registry.Options{Offline: w.opts.offline}
+	if s.base != "" {
+		parserPath = filepath.Join(s.base, filepath.FromSlash(filePath))
+	} else {
+		opts.FS = s.fsys
+	}
+	libs, deps, err := entry.NewParser(parserPath, opts).Parse(bytes.NewReader(content))
+	if err != nil {
+		if entry.Type == registry.GoBinary &&
+			(xerrors.Is(err, binary.ErrUnrecognizedExe) || xerrors.Is(err, binary.ErrNonGoBinary)) {
+			return Result{}, false
+		}
+		result.Err = xerrors.Errorf("%s parse error: %w", entry.Type, err)
+		return result, true
+	}
+
+	result.Libraries = libs
+	result.Dependencies = deps
+	return result, true
+}
+
+func readFile(fsys fs.FS, filePath string) ([]byte, error) {
+	f, err := fsys.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
@@ -0,0 +1,217 @@
+package walker_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/registry"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/walker"
+)
+
+type summary struct {
+	FilePath  string
+	Type      registry.FileType
+	Ecosystem types.Ecosystem
+	Libraries int
+	Err       bool
+}
+
+func summarize(results []walker.Result) []summary {
+	var got []summary
+	for _, r := range results {
+		got = append(got, summary{
+			FilePath:  r.FilePath,
+			Type:      r.Type,
+			Ecosystem: r.Ecosystem,
+			Libraries: len(r.Libraries),
+			Err:       r.Err != nil,
+		})
+	}
+	return got
+}
+
+func TestWalker_Walk(t *testing.T) {
+	tests := []struct {
+		name string
+		root string
+		opts []walker.Option
+		want []summary
+	}{
+		{
+			name: "happy path",
+			root: filepath.Join("testdata", "happy"),
+			want: []summary{
+				{FilePath: "app/package-lock.json", Type: registry.Npm, Ecosystem: types.Npm, Err: true},
+				{FilePath: "app/yarn.lock", Type: registry.Yarn, Ecosystem: types.Npm, Libraries: 3},
+				{FilePath: "requirements.txt", Type: registry.Pip, Ecosystem: types.PyPI, Libraries: 1},
+				{FilePath: "rust/Cargo.lock", Type: registry.Cargo, Ecosystem: types.Cargo, Libraries: 2},
+			},
+		},
+		{
+			name: "custom skip dirs",
+			root: filepath.Join("testdata", "happy"),
+			opts: []walker.Option{walker.WithSkipDirs([]string{"app", "rust"})},
+			want: []summary{
+				{FilePath: "node_modules/foo/package.json", Type: registry.PackageJSON, Ecosystem: types.Npm, Libraries: 1},
+				{FilePath: "requirements.txt", Type: registry.Pip, Ecosystem: types.PyPI, Libraries: 1},
+				{FilePath: "vendor/x/requirements.txt", Type: registry.Pip, Ecosystem: types.PyPI, Libraries: 1},
+			},
+		},
+		{
+			name: "max file size",
+			root: filepath.Join("testdata", "happy"),
+			opts: []walker.Option{walker.WithMaxFileSize(100)},
+			want: []summary{
+				{FilePath: "app/package-lock.json", Type: registry.Npm, Ecosystem: types.Npm, Err: true},
+				{FilePath: "requirements.txt", Type: registry.Pip, Ecosystem: types.PyPI, Libraries: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := walker.NewWalker(tt.opts...).Walk(tt.root)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, summarize(got))
+		})
+	}
+}
+
+func TestWalker_WalkFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"Gemfile.lock": &fstest.MapFile{
+			Data: []byte("GEM\n  remote: https://rubygems.org/\n  specs:\n    dotenv (2.7.2)\n"),
+		},
+		".git/packages.config": &fstest.MapFile{
+			Data: []byte(`<packages><package id="Newtonsoft.Json" version="6.0.4" /></packages>`),
+		},
+		"wordpress/wp-includes/version.php": &fstest.MapFile{
+			Data: []byte("<?php\n$wp_version = '4.9.4';\n"),
+		},
+	}
+
+	got, err := walker.NewWalker().WalkFS(fsys)
+	require.NoError(t, err)
+
+	want := []walker.Result{
+		{
+			FilePath:  "Gemfile.lock",
+			Type:      registry.Bundler,
+			Ecosystem: types.RubyGems,
+			Libraries: []types.Library{
+				{
+					ID:        "dotenv@2.7.2",
+					Name:      "dotenv",
+					Version:   "2.7.2",
+					Locations: types.Locations{{StartLine: 4, EndLine: 4}},
+				},
+			},
+		},
+		{
+			FilePath:  "wordpress/wp-includes/version.php",
+			Type:      registry.WordPress,
+			Ecosystem: types.WordPress,
+			Libraries: []types.Library{
+				{
+					Name:    "wordpress",
+					Version: "4.9.4",
+				},
+			},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestWalker_WalkFS_RelatedFiles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"mod/go.mod": &fstest.MapFile{
+			Data: []byte("module example.com/mod\n\ngo 1.16\n\nrequire github.com/pkg/errors v0.9.1\n"),
+		},
+		"mod/go.sum": &fstest.MapFile{
+			Data: []byte("github.com/pkg/errors v0.9.1 h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=\n" +
+				"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 h1:go1bK/D/BFZV2I8cIQd1NKEZ+0owSTG1fDTci4IqFcE=\n"),
+		},
+		"work/go.work": &fstest.MapFile{
+			Data: []byte("go 1.18\n\nuse ./app\n"),
+		},
+		"work/app/go.mod": &fstest.MapFile{
+			Data: []byte("module example.com/app\n\ngo 1.18\n\nrequire github.com/pkg/errors v0.9.1\n"),
+		},
+		"vendored/go.mod": &fstest.MapFile{
+			Data: []byte("module example.com/vendored\n\ngo 1.17\n\nrequire github.com/pkg/errors v0.9.1\n"),
+		},
+		"vendored/vendor/modules.txt": &fstest.MapFile{
+			Data: []byte("# github.com/pkg/errors v0.9.1\n## explicit\ngithub.com/pkg/errors\n"),
+		},
+		"vendored/vendor/github.com/pkg/errors/go.mod": &fstest.MapFile{
+			Data: []byte("module github.com/pkg/errors\n"),
+		},
+		"java/parent.xml": &fstest.MapFile{
+			Data: []byte(`<project><groupId>com.example</groupId><artifactId>parent</artifactId><version>1.0.0</version>
+<dependencies><dependency><groupId>org.example</groupId><artifactId>example-api</artifactId><version>1.7.30</version></dependency></dependencies>
+</project>`),
+		},
+		"java/child/pom.xml": &fstest.MapFile{
+			Data: []byte(`<project><artifactId>child</artifactId>
+<parent><groupId>com.example</groupId><artifactId>parent</artifactId><version>1.0.0</version><relativePath>../parent.xml</relativePath></parent>
+</project>`),
+		},
+	}
+
+	got, err := walker.NewWalker(walker.WithOffline(true)).WalkFS(fsys)
+	require.NoError(t, err)
+
+	want := []summary{
+		// The dependency is inherited from the parent POM in the walked file system.
+		{FilePath: "java/child/pom.xml", Type: registry.Pom, Ecosystem: types.Maven, Libraries: 2},
+		// The indirect dependency comes from go.sum in the walked file system.
+		{FilePath: "mod/go.mod", Type: registry.GoMod, Ecosystem: types.Go, Libraries: 2},
+		{FilePath: "mod/go.sum", Type: registry.GoSum, Ecosystem: types.Go, Libraries: 2},
+		{FilePath: "vendored/go.mod", Type: registry.GoMod, Ecosystem: types.Go, Libraries: 1},
+		// modules.txt is found even though "vendor" is skipped.
+		{FilePath: "vendored/vendor/modules.txt", Type: registry.GoVendor, Ecosystem: types.Go, Libraries: 1},
+		{FilePath: "work/app/go.mod", Type: registry.GoMod, Ecosystem: types.Go, Libraries: 1},
+		// The module in "use" is read from the walked file system.
+		{FilePath: "work/go.work", Type: registry.GoWork, Ecosystem: types.Go, Libraries: 1},
+	}
+	assert.Equal(t, want, summarize(got))
+}
+
+func TestWalker_Symlink(t *testing.T) {
+	root := t.TempDir()
+	target := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(target, "requirements.txt"), []byte("click==8.0.0\n"), 0644))
+	require.NoError(t, os.Symlink(target, filepath.Join(root, "linked")))
+	// Cyclic link
+	require.NoError(t, os.Symlink(root, filepath.Join(root, "self")))
+
+	tests := []struct {
+		name   string
+		follow bool
+		want   []summary
+	}{
+		{
+			name:   "follow",
+			follow: true,
+			want: []summary{
+				{FilePath: "linked/requirements.txt", Type: registry.Pip, Ecosystem: types.PyPI, Libraries: 1},
+			},
+		},
+		{
+			name:   "don't follow",
+			follow: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := walker.NewWalker(walker.WithFollowSymlinks(tt.follow)).Walk(root)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, summarize(got))
+		})
+	}
+}